@@ -40,9 +40,15 @@ const (
 	// AttestationServicePacketAttestationProcedure is the fully-qualified name of the
 	// AttestationService's PacketAttestation RPC.
 	AttestationServicePacketAttestationProcedure = "/ibc.v2.attestor.AttestationService/PacketAttestation"
+	// AttestationServiceAttestBatchProcedure is the fully-qualified name of the AttestationService's
+	// AttestBatch RPC.
+	AttestationServiceAttestBatchProcedure = "/ibc.v2.attestor.AttestationService/AttestBatch"
 	// AttestationServiceLatestHeightProcedure is the fully-qualified name of the AttestationService's
 	// LatestHeight RPC.
 	AttestationServiceLatestHeightProcedure = "/ibc.v2.attestor.AttestationService/LatestHeight"
+	// AttestationServiceSubscribeLatestHeightProcedure is the fully-qualified name of the
+	// AttestationService's SubscribeLatestHeight RPC.
+	AttestationServiceSubscribeLatestHeightProcedure = "/ibc.v2.attestor.AttestationService/SubscribeLatestHeight"
 	// AttestationServiceInfoProcedure is the fully-qualified name of the AttestationService's Info RPC.
 	AttestationServiceInfoProcedure = "/ibc.v2.attestor.AttestationService/Info"
 )
@@ -53,8 +59,14 @@ type AttestationServiceClient interface {
 	StateAttestation(context.Context, *connect.Request[StateAttestationRequest]) (*connect.Response[StateAttestationResponse], error)
 	// Retrieves an attestation for a set of packets.
 	PacketAttestation(context.Context, *connect.Request[PacketAttestationRequest]) (*connect.Response[PacketAttestationResponse], error)
+	// Retrieves a state attestation and a packet attestation at the same
+	// height in one call.
+	AttestBatch(context.Context, *connect.Request[AttestBatchRequest]) (*connect.Response[AttestBatchResponse], error)
 	// Returns the latest height the attestor will generate attestations for.
 	LatestHeight(context.Context, *connect.Request[LatestHeightRequest]) (*connect.Response[LatestHeightResponse], error)
+	// Streams the latest height the attestor will generate attestations for,
+	// sending the current height first and then every time it advances.
+	SubscribeLatestHeight(context.Context, *connect.Request[SubscribeLatestHeightRequest]) (*connect.ServerStreamForClient[SubscribeLatestHeightResponse], error)
 	// Returns identity information about a configured attestor.
 	Info(context.Context, *connect.Request[InfoRequest]) (*connect.Response[InfoResponse], error)
 }
@@ -82,12 +94,24 @@ func NewAttestationServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(attestationServiceMethods.ByName("PacketAttestation")),
 			connect.WithClientOptions(opts...),
 		),
+		attestBatch: connect.NewClient[AttestBatchRequest, AttestBatchResponse](
+			httpClient,
+			baseURL+AttestationServiceAttestBatchProcedure,
+			connect.WithSchema(attestationServiceMethods.ByName("AttestBatch")),
+			connect.WithClientOptions(opts...),
+		),
 		latestHeight: connect.NewClient[LatestHeightRequest, LatestHeightResponse](
 			httpClient,
 			baseURL+AttestationServiceLatestHeightProcedure,
 			connect.WithSchema(attestationServiceMethods.ByName("LatestHeight")),
 			connect.WithClientOptions(opts...),
 		),
+		subscribeLatestHeight: connect.NewClient[SubscribeLatestHeightRequest, SubscribeLatestHeightResponse](
+			httpClient,
+			baseURL+AttestationServiceSubscribeLatestHeightProcedure,
+			connect.WithSchema(attestationServiceMethods.ByName("SubscribeLatestHeight")),
+			connect.WithClientOptions(opts...),
+		),
 		info: connect.NewClient[InfoRequest, InfoResponse](
 			httpClient,
 			baseURL+AttestationServiceInfoProcedure,
@@ -99,10 +123,12 @@ func NewAttestationServiceClient(httpClient connect.HTTPClient, baseURL string,
 
 // attestationServiceClient implements AttestationServiceClient.
 type attestationServiceClient struct {
-	stateAttestation  *connect.Client[StateAttestationRequest, StateAttestationResponse]
-	packetAttestation *connect.Client[PacketAttestationRequest, PacketAttestationResponse]
-	latestHeight      *connect.Client[LatestHeightRequest, LatestHeightResponse]
-	info              *connect.Client[InfoRequest, InfoResponse]
+	stateAttestation      *connect.Client[StateAttestationRequest, StateAttestationResponse]
+	packetAttestation     *connect.Client[PacketAttestationRequest, PacketAttestationResponse]
+	attestBatch           *connect.Client[AttestBatchRequest, AttestBatchResponse]
+	latestHeight          *connect.Client[LatestHeightRequest, LatestHeightResponse]
+	subscribeLatestHeight *connect.Client[SubscribeLatestHeightRequest, SubscribeLatestHeightResponse]
+	info                  *connect.Client[InfoRequest, InfoResponse]
 }
 
 // StateAttestation calls ibc.v2.attestor.AttestationService.StateAttestation.
@@ -115,11 +141,21 @@ func (c *attestationServiceClient) PacketAttestation(ctx context.Context, req *c
 	return c.packetAttestation.CallUnary(ctx, req)
 }
 
+// AttestBatch calls ibc.v2.attestor.AttestationService.AttestBatch.
+func (c *attestationServiceClient) AttestBatch(ctx context.Context, req *connect.Request[AttestBatchRequest]) (*connect.Response[AttestBatchResponse], error) {
+	return c.attestBatch.CallUnary(ctx, req)
+}
+
 // LatestHeight calls ibc.v2.attestor.AttestationService.LatestHeight.
 func (c *attestationServiceClient) LatestHeight(ctx context.Context, req *connect.Request[LatestHeightRequest]) (*connect.Response[LatestHeightResponse], error) {
 	return c.latestHeight.CallUnary(ctx, req)
 }
 
+// SubscribeLatestHeight calls ibc.v2.attestor.AttestationService.SubscribeLatestHeight.
+func (c *attestationServiceClient) SubscribeLatestHeight(ctx context.Context, req *connect.Request[SubscribeLatestHeightRequest]) (*connect.ServerStreamForClient[SubscribeLatestHeightResponse], error) {
+	return c.subscribeLatestHeight.CallServerStream(ctx, req)
+}
+
 // Info calls ibc.v2.attestor.AttestationService.Info.
 func (c *attestationServiceClient) Info(ctx context.Context, req *connect.Request[InfoRequest]) (*connect.Response[InfoResponse], error) {
 	return c.info.CallUnary(ctx, req)
@@ -131,8 +167,14 @@ type AttestationServiceHandler interface {
 	StateAttestation(context.Context, *connect.Request[StateAttestationRequest]) (*connect.Response[StateAttestationResponse], error)
 	// Retrieves an attestation for a set of packets.
 	PacketAttestation(context.Context, *connect.Request[PacketAttestationRequest]) (*connect.Response[PacketAttestationResponse], error)
+	// Retrieves a state attestation and a packet attestation at the same
+	// height in one call.
+	AttestBatch(context.Context, *connect.Request[AttestBatchRequest]) (*connect.Response[AttestBatchResponse], error)
 	// Returns the latest height the attestor will generate attestations for.
 	LatestHeight(context.Context, *connect.Request[LatestHeightRequest]) (*connect.Response[LatestHeightResponse], error)
+	// Streams the latest height the attestor will generate attestations for,
+	// sending the current height first and then every time it advances.
+	SubscribeLatestHeight(context.Context, *connect.Request[SubscribeLatestHeightRequest], *connect.ServerStream[SubscribeLatestHeightResponse]) error
 	// Returns identity information about a configured attestor.
 	Info(context.Context, *connect.Request[InfoRequest]) (*connect.Response[InfoResponse], error)
 }
@@ -156,12 +198,24 @@ func NewAttestationServiceHandler(svc AttestationServiceHandler, opts ...connect
 		connect.WithSchema(attestationServiceMethods.ByName("PacketAttestation")),
 		connect.WithHandlerOptions(opts...),
 	)
+	attestationServiceAttestBatchHandler := connect.NewUnaryHandler(
+		AttestationServiceAttestBatchProcedure,
+		svc.AttestBatch,
+		connect.WithSchema(attestationServiceMethods.ByName("AttestBatch")),
+		connect.WithHandlerOptions(opts...),
+	)
 	attestationServiceLatestHeightHandler := connect.NewUnaryHandler(
 		AttestationServiceLatestHeightProcedure,
 		svc.LatestHeight,
 		connect.WithSchema(attestationServiceMethods.ByName("LatestHeight")),
 		connect.WithHandlerOptions(opts...),
 	)
+	attestationServiceSubscribeLatestHeightHandler := connect.NewServerStreamHandler(
+		AttestationServiceSubscribeLatestHeightProcedure,
+		svc.SubscribeLatestHeight,
+		connect.WithSchema(attestationServiceMethods.ByName("SubscribeLatestHeight")),
+		connect.WithHandlerOptions(opts...),
+	)
 	attestationServiceInfoHandler := connect.NewUnaryHandler(
 		AttestationServiceInfoProcedure,
 		svc.Info,
@@ -174,8 +228,12 @@ func NewAttestationServiceHandler(svc AttestationServiceHandler, opts ...connect
 			attestationServiceStateAttestationHandler.ServeHTTP(w, r)
 		case AttestationServicePacketAttestationProcedure:
 			attestationServicePacketAttestationHandler.ServeHTTP(w, r)
+		case AttestationServiceAttestBatchProcedure:
+			attestationServiceAttestBatchHandler.ServeHTTP(w, r)
 		case AttestationServiceLatestHeightProcedure:
 			attestationServiceLatestHeightHandler.ServeHTTP(w, r)
+		case AttestationServiceSubscribeLatestHeightProcedure:
+			attestationServiceSubscribeLatestHeightHandler.ServeHTTP(w, r)
 		case AttestationServiceInfoProcedure:
 			attestationServiceInfoHandler.ServeHTTP(w, r)
 		default:
@@ -195,10 +253,18 @@ func (UnimplementedAttestationServiceHandler) PacketAttestation(context.Context,
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ibc.v2.attestor.AttestationService.PacketAttestation is not implemented"))
 }
 
+func (UnimplementedAttestationServiceHandler) AttestBatch(context.Context, *connect.Request[AttestBatchRequest]) (*connect.Response[AttestBatchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ibc.v2.attestor.AttestationService.AttestBatch is not implemented"))
+}
+
 func (UnimplementedAttestationServiceHandler) LatestHeight(context.Context, *connect.Request[LatestHeightRequest]) (*connect.Response[LatestHeightResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ibc.v2.attestor.AttestationService.LatestHeight is not implemented"))
 }
 
+func (UnimplementedAttestationServiceHandler) SubscribeLatestHeight(context.Context, *connect.Request[SubscribeLatestHeightRequest], *connect.ServerStream[SubscribeLatestHeightResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("ibc.v2.attestor.AttestationService.SubscribeLatestHeight is not implemented"))
+}
+
 func (UnimplementedAttestationServiceHandler) Info(context.Context, *connect.Request[InfoRequest]) (*connect.Response[InfoResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ibc.v2.attestor.AttestationService.Info is not implemented"))
 }
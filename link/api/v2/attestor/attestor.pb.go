@@ -294,6 +294,134 @@ func (x *PacketAttestationResponse) GetAttestation() *Attestation {
 	return nil
 }
 
+// Request message for attesting a relay batch: the counterparty state and
+// the batch's packets at one height.
+type AttestBatchRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Attestor string                 `protobuf:"bytes,1,opt,name=attestor,proto3" json:"attestor,omitempty"`
+	// The height to attest at. Zero lets the attestor pick its latest
+	// attestable height.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// The packets to attest to
+	Packets [][]byte `protobuf:"bytes,3,rep,name=packets,proto3" json:"packets,omitempty"`
+	// The type of commitment to attest
+	CommitmentType CommitmentType `protobuf:"varint,4,opt,name=commitment_type,json=commitmentType,proto3,enum=ibc.v2.attestor.CommitmentType" json:"commitment_type,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AttestBatchRequest) Reset() {
+	*x = AttestBatchRequest{}
+	mi := &file_attestor_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttestBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttestBatchRequest) ProtoMessage() {}
+
+func (x *AttestBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_attestor_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttestBatchRequest.ProtoReflect.Descriptor instead.
+func (*AttestBatchRequest) Descriptor() ([]byte, []int) {
+	return file_attestor_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AttestBatchRequest) GetAttestor() string {
+	if x != nil {
+		return x.Attestor
+	}
+	return ""
+}
+
+func (x *AttestBatchRequest) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *AttestBatchRequest) GetPackets() [][]byte {
+	if x != nil {
+		return x.Packets
+	}
+	return nil
+}
+
+func (x *AttestBatchRequest) GetCommitmentType() CommitmentType {
+	if x != nil {
+		return x.CommitmentType
+	}
+	return CommitmentType_COMMITMENT_TYPE_PACKET
+}
+
+// Response message for attesting a relay batch. Both attestations share the
+// same height.
+type AttestBatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         *Attestation           `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Packets       *Attestation           `protobuf:"bytes,2,opt,name=packets,proto3" json:"packets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttestBatchResponse) Reset() {
+	*x = AttestBatchResponse{}
+	mi := &file_attestor_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttestBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttestBatchResponse) ProtoMessage() {}
+
+func (x *AttestBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_attestor_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttestBatchResponse.ProtoReflect.Descriptor instead.
+func (*AttestBatchResponse) Descriptor() ([]byte, []int) {
+	return file_attestor_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AttestBatchResponse) GetState() *Attestation {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *AttestBatchResponse) GetPackets() *Attestation {
+	if x != nil {
+		return x.Packets
+	}
+	return nil
+}
+
 type LatestHeightRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Attestor      string                 `protobuf:"bytes,1,opt,name=attestor,proto3" json:"attestor,omitempty"`
@@ -303,7 +431,7 @@ type LatestHeightRequest struct {
 
 func (x *LatestHeightRequest) Reset() {
 	*x = LatestHeightRequest{}
-	mi := &file_attestor_proto_msgTypes[4]
+	mi := &file_attestor_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -315,7 +443,7 @@ func (x *LatestHeightRequest) String() string {
 func (*LatestHeightRequest) ProtoMessage() {}
 
 func (x *LatestHeightRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_attestor_proto_msgTypes[4]
+	mi := &file_attestor_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -328,7 +456,7 @@ func (x *LatestHeightRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LatestHeightRequest.ProtoReflect.Descriptor instead.
 func (*LatestHeightRequest) Descriptor() ([]byte, []int) {
-	return file_attestor_proto_rawDescGZIP(), []int{4}
+	return file_attestor_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *LatestHeightRequest) GetAttestor() string {
@@ -347,7 +475,7 @@ type LatestHeightResponse struct {
 
 func (x *LatestHeightResponse) Reset() {
 	*x = LatestHeightResponse{}
-	mi := &file_attestor_proto_msgTypes[5]
+	mi := &file_attestor_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -359,7 +487,7 @@ func (x *LatestHeightResponse) String() string {
 func (*LatestHeightResponse) ProtoMessage() {}
 
 func (x *LatestHeightResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_attestor_proto_msgTypes[5]
+	mi := &file_attestor_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -372,7 +500,7 @@ func (x *LatestHeightResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LatestHeightResponse.ProtoReflect.Descriptor instead.
 func (*LatestHeightResponse) Descriptor() ([]byte, []int) {
-	return file_attestor_proto_rawDescGZIP(), []int{5}
+	return file_attestor_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *LatestHeightResponse) GetHeight() uint64 {
@@ -382,6 +510,94 @@ func (x *LatestHeightResponse) GetHeight() uint64 {
 	return 0
 }
 
+type SubscribeLatestHeightRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attestor      string                 `protobuf:"bytes,1,opt,name=attestor,proto3" json:"attestor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeLatestHeightRequest) Reset() {
+	*x = SubscribeLatestHeightRequest{}
+	mi := &file_attestor_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeLatestHeightRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeLatestHeightRequest) ProtoMessage() {}
+
+func (x *SubscribeLatestHeightRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_attestor_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeLatestHeightRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeLatestHeightRequest) Descriptor() ([]byte, []int) {
+	return file_attestor_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SubscribeLatestHeightRequest) GetAttestor() string {
+	if x != nil {
+		return x.Attestor
+	}
+	return ""
+}
+
+type SubscribeLatestHeightResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Height        uint64                 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeLatestHeightResponse) Reset() {
+	*x = SubscribeLatestHeightResponse{}
+	mi := &file_attestor_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeLatestHeightResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeLatestHeightResponse) ProtoMessage() {}
+
+func (x *SubscribeLatestHeightResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_attestor_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeLatestHeightResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeLatestHeightResponse) Descriptor() ([]byte, []int) {
+	return file_attestor_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SubscribeLatestHeightResponse) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
 type InfoRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Attestor      string                 `protobuf:"bytes,1,opt,name=attestor,proto3" json:"attestor,omitempty"`
@@ -391,7 +607,7 @@ type InfoRequest struct {
 
 func (x *InfoRequest) Reset() {
 	*x = InfoRequest{}
-	mi := &file_attestor_proto_msgTypes[6]
+	mi := &file_attestor_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -403,7 +619,7 @@ func (x *InfoRequest) String() string {
 func (*InfoRequest) ProtoMessage() {}
 
 func (x *InfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_attestor_proto_msgTypes[6]
+	mi := &file_attestor_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -416,7 +632,7 @@ func (x *InfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InfoRequest.ProtoReflect.Descriptor instead.
 func (*InfoRequest) Descriptor() ([]byte, []int) {
-	return file_attestor_proto_rawDescGZIP(), []int{6}
+	return file_attestor_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *InfoRequest) GetAttestor() string {
@@ -431,14 +647,16 @@ type InfoResponse struct {
 	// The chain this attestor watches.
 	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 	// The attestor's signing address.
-	Address       string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// Cache hit/miss counters; unset for attestors that don't cache.
+	Cache         *CacheStats `protobuf:"bytes,3,opt,name=cache,proto3" json:"cache,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *InfoResponse) Reset() {
 	*x = InfoResponse{}
-	mi := &file_attestor_proto_msgTypes[7]
+	mi := &file_attestor_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -450,7 +668,7 @@ func (x *InfoResponse) String() string {
 func (*InfoResponse) ProtoMessage() {}
 
 func (x *InfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_attestor_proto_msgTypes[7]
+	mi := &file_attestor_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -463,7 +681,7 @@ func (x *InfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InfoResponse.ProtoReflect.Descriptor instead.
 func (*InfoResponse) Descriptor() ([]byte, []int) {
-	return file_attestor_proto_rawDescGZIP(), []int{7}
+	return file_attestor_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *InfoResponse) GetChainId() string {
@@ -480,6 +698,129 @@ func (x *InfoResponse) GetAddress() string {
 	return ""
 }
 
+func (x *InfoResponse) GetCache() *CacheStats {
+	if x != nil {
+		return x.Cache
+	}
+	return nil
+}
+
+// Hit/miss counters of a local attestor's attestation caches.
+type CacheStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// StateAttestation lookups, keyed by height.
+	States *CacheCounter `protobuf:"bytes,1,opt,name=states,proto3" json:"states,omitempty"`
+	// Per-packet commitment lookups, keyed by (height, commitment path).
+	Commitments *CacheCounter `protobuf:"bytes,2,opt,name=commitments,proto3" json:"commitments,omitempty"`
+	// Packet attestation signature lookups, keyed by attested data digest.
+	Signatures    *CacheCounter `protobuf:"bytes,3,opt,name=signatures,proto3" json:"signatures,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CacheStats) Reset() {
+	*x = CacheStats{}
+	mi := &file_attestor_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CacheStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheStats) ProtoMessage() {}
+
+func (x *CacheStats) ProtoReflect() protoreflect.Message {
+	mi := &file_attestor_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheStats.ProtoReflect.Descriptor instead.
+func (*CacheStats) Descriptor() ([]byte, []int) {
+	return file_attestor_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CacheStats) GetStates() *CacheCounter {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+func (x *CacheStats) GetCommitments() *CacheCounter {
+	if x != nil {
+		return x.Commitments
+	}
+	return nil
+}
+
+func (x *CacheStats) GetSignatures() *CacheCounter {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
+type CacheCounter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hits          uint64                 `protobuf:"varint,1,opt,name=hits,proto3" json:"hits,omitempty"`
+	Misses        uint64                 `protobuf:"varint,2,opt,name=misses,proto3" json:"misses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CacheCounter) Reset() {
+	*x = CacheCounter{}
+	mi := &file_attestor_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CacheCounter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheCounter) ProtoMessage() {}
+
+func (x *CacheCounter) ProtoReflect() protoreflect.Message {
+	mi := &file_attestor_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheCounter.ProtoReflect.Descriptor instead.
+func (*CacheCounter) Descriptor() ([]byte, []int) {
+	return file_attestor_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CacheCounter) GetHits() uint64 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+func (x *CacheCounter) GetMisses() uint64 {
+	if x != nil {
+		return x.Misses
+	}
+	return 0
+}
+
 // Attestation is a single attestation from a given block height
 // for the requested data.
 type Attestation struct {
@@ -498,7 +839,7 @@ type Attestation struct {
 
 func (x *Attestation) Reset() {
 	*x = Attestation{}
-	mi := &file_attestor_proto_msgTypes[8]
+	mi := &file_attestor_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -510,7 +851,7 @@ func (x *Attestation) String() string {
 func (*Attestation) ProtoMessage() {}
 
 func (x *Attestation) ProtoReflect() protoreflect.Message {
-	mi := &file_attestor_proto_msgTypes[8]
+	mi := &file_attestor_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -523,7 +864,7 @@ func (x *Attestation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Attestation.ProtoReflect.Descriptor instead.
 func (*Attestation) Descriptor() ([]byte, []int) {
-	return file_attestor_proto_rawDescGZIP(), []int{8}
+	return file_attestor_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *Attestation) GetHeight() uint64 {
@@ -570,16 +911,39 @@ const file_attestor_proto_rawDesc = "" +
 	"\x06height\x18\x03 \x01(\x04R\x06height\x12H\n" +
 	"\x0fcommitment_type\x18\x04 \x01(\x0e2\x1f.ibc.v2.attestor.CommitmentTypeR\x0ecommitmentType\"[\n" +
 	"\x19PacketAttestationResponse\x12>\n" +
-	"\vattestation\x18\x01 \x01(\v2\x1c.ibc.v2.attestor.AttestationR\vattestation\"1\n" +
+	"\vattestation\x18\x01 \x01(\v2\x1c.ibc.v2.attestor.AttestationR\vattestation\"\xac\x01\n" +
+	"\x12AttestBatchRequest\x12\x1a\n" +
+	"\battestor\x18\x01 \x01(\tR\battestor\x12\x16\n" +
+	"\x06height\x18\x02 \x01(\x04R\x06height\x12\x18\n" +
+	"\apackets\x18\x03 \x03(\fR\apackets\x12H\n" +
+	"\x0fcommitment_type\x18\x04 \x01(\x0e2\x1f.ibc.v2.attestor.CommitmentTypeR\x0ecommitmentType\"\x81\x01\n" +
+	"\x13AttestBatchResponse\x122\n" +
+	"\x05state\x18\x01 \x01(\v2\x1c.ibc.v2.attestor.AttestationR\x05state\x126\n" +
+	"\apackets\x18\x02 \x01(\v2\x1c.ibc.v2.attestor.AttestationR\apackets\"1\n" +
 	"\x13LatestHeightRequest\x12\x1a\n" +
 	"\battestor\x18\x01 \x01(\tR\battestor\".\n" +
 	"\x14LatestHeightResponse\x12\x16\n" +
+	"\x06height\x18\x01 \x01(\x04R\x06height\":\n" +
+	"\x1cSubscribeLatestHeightRequest\x12\x1a\n" +
+	"\battestor\x18\x01 \x01(\tR\battestor\"7\n" +
+	"\x1dSubscribeLatestHeightResponse\x12\x16\n" +
 	"\x06height\x18\x01 \x01(\x04R\x06height\")\n" +
 	"\vInfoRequest\x12\x1a\n" +
-	"\battestor\x18\x01 \x01(\tR\battestor\"C\n" +
+	"\battestor\x18\x01 \x01(\tR\battestor\"v\n" +
 	"\fInfoResponse\x12\x19\n" +
 	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x18\n" +
-	"\aaddress\x18\x02 \x01(\tR\aaddress\"\x99\x01\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x121\n" +
+	"\x05cache\x18\x03 \x01(\v2\x1b.ibc.v2.attestor.CacheStatsR\x05cache\"\xc3\x01\n" +
+	"\n" +
+	"CacheStats\x125\n" +
+	"\x06states\x18\x01 \x01(\v2\x1d.ibc.v2.attestor.CacheCounterR\x06states\x12?\n" +
+	"\vcommitments\x18\x02 \x01(\v2\x1d.ibc.v2.attestor.CacheCounterR\vcommitments\x12=\n" +
+	"\n" +
+	"signatures\x18\x03 \x01(\v2\x1d.ibc.v2.attestor.CacheCounterR\n" +
+	"signatures\":\n" +
+	"\fCacheCounter\x12\x12\n" +
+	"\x04hits\x18\x01 \x01(\x04R\x04hits\x12\x16\n" +
+	"\x06misses\x18\x02 \x01(\x04R\x06misses\"\x99\x01\n" +
 	"\vAttestation\x12\x16\n" +
 	"\x06height\x18\x01 \x01(\x04R\x06height\x12!\n" +
 	"\ttimestamp\x18\x02 \x01(\x04H\x00R\ttimestamp\x88\x01\x01\x12#\n" +
@@ -590,11 +954,13 @@ const file_attestor_proto_rawDesc = "" +
 	"\x0eCommitmentType\x12\x1a\n" +
 	"\x16COMMITMENT_TYPE_PACKET\x10\x00\x12\x17\n" +
 	"\x13COMMITMENT_TYPE_ACK\x10\x01\x12\x1b\n" +
-	"\x17COMMITMENT_TYPE_RECEIPT\x10\x022\x8b\x03\n" +
+	"\x17COMMITMENT_TYPE_RECEIPT\x10\x022\xdf\x04\n" +
 	"\x12AttestationService\x12g\n" +
 	"\x10StateAttestation\x12(.ibc.v2.attestor.StateAttestationRequest\x1a).ibc.v2.attestor.StateAttestationResponse\x12j\n" +
-	"\x11PacketAttestation\x12).ibc.v2.attestor.PacketAttestationRequest\x1a*.ibc.v2.attestor.PacketAttestationResponse\x12[\n" +
-	"\fLatestHeight\x12$.ibc.v2.attestor.LatestHeightRequest\x1a%.ibc.v2.attestor.LatestHeightResponse\x12C\n" +
+	"\x11PacketAttestation\x12).ibc.v2.attestor.PacketAttestationRequest\x1a*.ibc.v2.attestor.PacketAttestationResponse\x12X\n" +
+	"\vAttestBatch\x12#.ibc.v2.attestor.AttestBatchRequest\x1a$.ibc.v2.attestor.AttestBatchResponse\x12[\n" +
+	"\fLatestHeight\x12$.ibc.v2.attestor.LatestHeightRequest\x1a%.ibc.v2.attestor.LatestHeightResponse\x12x\n" +
+	"\x15SubscribeLatestHeight\x12-.ibc.v2.attestor.SubscribeLatestHeightRequest\x1a..ibc.v2.attestor.SubscribeLatestHeightResponse0\x01\x12C\n" +
 	"\x04Info\x12\x1c.ibc.v2.attestor.InfoRequest\x1a\x1d.ibc.v2.attestor.InfoResponseB,Z*github.com/cosmos/ibc/link/api/v2/attestorb\x06proto3"
 
 var (
@@ -610,36 +976,53 @@ func file_attestor_proto_rawDescGZIP() []byte {
 }
 
 var file_attestor_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_attestor_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_attestor_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_attestor_proto_goTypes = []any{
-	(CommitmentType)(0),               // 0: ibc.v2.attestor.CommitmentType
-	(*StateAttestationRequest)(nil),   // 1: ibc.v2.attestor.StateAttestationRequest
-	(*StateAttestationResponse)(nil),  // 2: ibc.v2.attestor.StateAttestationResponse
-	(*PacketAttestationRequest)(nil),  // 3: ibc.v2.attestor.PacketAttestationRequest
-	(*PacketAttestationResponse)(nil), // 4: ibc.v2.attestor.PacketAttestationResponse
-	(*LatestHeightRequest)(nil),       // 5: ibc.v2.attestor.LatestHeightRequest
-	(*LatestHeightResponse)(nil),      // 6: ibc.v2.attestor.LatestHeightResponse
-	(*InfoRequest)(nil),               // 7: ibc.v2.attestor.InfoRequest
-	(*InfoResponse)(nil),              // 8: ibc.v2.attestor.InfoResponse
-	(*Attestation)(nil),               // 9: ibc.v2.attestor.Attestation
+	(CommitmentType)(0),                   // 0: ibc.v2.attestor.CommitmentType
+	(*StateAttestationRequest)(nil),       // 1: ibc.v2.attestor.StateAttestationRequest
+	(*StateAttestationResponse)(nil),      // 2: ibc.v2.attestor.StateAttestationResponse
+	(*PacketAttestationRequest)(nil),      // 3: ibc.v2.attestor.PacketAttestationRequest
+	(*PacketAttestationResponse)(nil),     // 4: ibc.v2.attestor.PacketAttestationResponse
+	(*AttestBatchRequest)(nil),            // 5: ibc.v2.attestor.AttestBatchRequest
+	(*AttestBatchResponse)(nil),           // 6: ibc.v2.attestor.AttestBatchResponse
+	(*LatestHeightRequest)(nil),           // 7: ibc.v2.attestor.LatestHeightRequest
+	(*LatestHeightResponse)(nil),          // 8: ibc.v2.attestor.LatestHeightResponse
+	(*SubscribeLatestHeightRequest)(nil),  // 9: ibc.v2.attestor.SubscribeLatestHeightRequest
+	(*SubscribeLatestHeightResponse)(nil), // 10: ibc.v2.attestor.SubscribeLatestHeightResponse
+	(*InfoRequest)(nil),                   // 11: ibc.v2.attestor.InfoRequest
+	(*InfoResponse)(nil),                  // 12: ibc.v2.attestor.InfoResponse
+	(*CacheStats)(nil),                    // 13: ibc.v2.attestor.CacheStats
+	(*CacheCounter)(nil),                  // 14: ibc.v2.attestor.CacheCounter
+	(*Attestation)(nil),                   // 15: ibc.v2.attestor.Attestation
 }
 var file_attestor_proto_depIdxs = []int32{
-	9, // 0: ibc.v2.attestor.StateAttestationResponse.attestation:type_name -> ibc.v2.attestor.Attestation
-	0, // 1: ibc.v2.attestor.PacketAttestationRequest.commitment_type:type_name -> ibc.v2.attestor.CommitmentType
-	9, // 2: ibc.v2.attestor.PacketAttestationResponse.attestation:type_name -> ibc.v2.attestor.Attestation
-	1, // 3: ibc.v2.attestor.AttestationService.StateAttestation:input_type -> ibc.v2.attestor.StateAttestationRequest
-	3, // 4: ibc.v2.attestor.AttestationService.PacketAttestation:input_type -> ibc.v2.attestor.PacketAttestationRequest
-	5, // 5: ibc.v2.attestor.AttestationService.LatestHeight:input_type -> ibc.v2.attestor.LatestHeightRequest
-	7, // 6: ibc.v2.attestor.AttestationService.Info:input_type -> ibc.v2.attestor.InfoRequest
-	2, // 7: ibc.v2.attestor.AttestationService.StateAttestation:output_type -> ibc.v2.attestor.StateAttestationResponse
-	4, // 8: ibc.v2.attestor.AttestationService.PacketAttestation:output_type -> ibc.v2.attestor.PacketAttestationResponse
-	6, // 9: ibc.v2.attestor.AttestationService.LatestHeight:output_type -> ibc.v2.attestor.LatestHeightResponse
-	8, // 10: ibc.v2.attestor.AttestationService.Info:output_type -> ibc.v2.attestor.InfoResponse
-	7, // [7:11] is the sub-list for method output_type
-	3, // [3:7] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	15, // 0: ibc.v2.attestor.StateAttestationResponse.attestation:type_name -> ibc.v2.attestor.Attestation
+	0,  // 1: ibc.v2.attestor.PacketAttestationRequest.commitment_type:type_name -> ibc.v2.attestor.CommitmentType
+	15, // 2: ibc.v2.attestor.PacketAttestationResponse.attestation:type_name -> ibc.v2.attestor.Attestation
+	0,  // 3: ibc.v2.attestor.AttestBatchRequest.commitment_type:type_name -> ibc.v2.attestor.CommitmentType
+	15, // 4: ibc.v2.attestor.AttestBatchResponse.state:type_name -> ibc.v2.attestor.Attestation
+	15, // 5: ibc.v2.attestor.AttestBatchResponse.packets:type_name -> ibc.v2.attestor.Attestation
+	13, // 6: ibc.v2.attestor.InfoResponse.cache:type_name -> ibc.v2.attestor.CacheStats
+	14, // 7: ibc.v2.attestor.CacheStats.states:type_name -> ibc.v2.attestor.CacheCounter
+	14, // 8: ibc.v2.attestor.CacheStats.commitments:type_name -> ibc.v2.attestor.CacheCounter
+	14, // 9: ibc.v2.attestor.CacheStats.signatures:type_name -> ibc.v2.attestor.CacheCounter
+	1,  // 10: ibc.v2.attestor.AttestationService.StateAttestation:input_type -> ibc.v2.attestor.StateAttestationRequest
+	3,  // 11: ibc.v2.attestor.AttestationService.PacketAttestation:input_type -> ibc.v2.attestor.PacketAttestationRequest
+	5,  // 12: ibc.v2.attestor.AttestationService.AttestBatch:input_type -> ibc.v2.attestor.AttestBatchRequest
+	7,  // 13: ibc.v2.attestor.AttestationService.LatestHeight:input_type -> ibc.v2.attestor.LatestHeightRequest
+	9,  // 14: ibc.v2.attestor.AttestationService.SubscribeLatestHeight:input_type -> ibc.v2.attestor.SubscribeLatestHeightRequest
+	11, // 15: ibc.v2.attestor.AttestationService.Info:input_type -> ibc.v2.attestor.InfoRequest
+	2,  // 16: ibc.v2.attestor.AttestationService.StateAttestation:output_type -> ibc.v2.attestor.StateAttestationResponse
+	4,  // 17: ibc.v2.attestor.AttestationService.PacketAttestation:output_type -> ibc.v2.attestor.PacketAttestationResponse
+	6,  // 18: ibc.v2.attestor.AttestationService.AttestBatch:output_type -> ibc.v2.attestor.AttestBatchResponse
+	8,  // 19: ibc.v2.attestor.AttestationService.LatestHeight:output_type -> ibc.v2.attestor.LatestHeightResponse
+	10, // 20: ibc.v2.attestor.AttestationService.SubscribeLatestHeight:output_type -> ibc.v2.attestor.SubscribeLatestHeightResponse
+	12, // 21: ibc.v2.attestor.AttestationService.Info:output_type -> ibc.v2.attestor.InfoResponse
+	16, // [16:22] is the sub-list for method output_type
+	10, // [10:16] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_attestor_proto_init() }
@@ -647,14 +1030,14 @@ func file_attestor_proto_init() {
 	if File_attestor_proto != nil {
 		return
 	}
-	file_attestor_proto_msgTypes[8].OneofWrappers = []any{}
+	file_attestor_proto_msgTypes[14].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_attestor_proto_rawDesc), len(file_attestor_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   9,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
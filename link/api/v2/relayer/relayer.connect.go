@@ -39,6 +39,12 @@ const (
 	// RelayerApiServiceStatusProcedure is the fully-qualified name of the RelayerApiService's Status
 	// RPC.
 	RelayerApiServiceStatusProcedure = "/ibc.v2.relayer.RelayerApiService/Status"
+	// RelayerApiServiceListAttestorEvidenceProcedure is the fully-qualified name of the
+	// RelayerApiService's ListAttestorEvidence RPC.
+	RelayerApiServiceListAttestorEvidenceProcedure = "/ibc.v2.relayer.RelayerApiService/ListAttestorEvidence"
+	// RelayerApiServiceClearAttestorEvidenceProcedure is the fully-qualified name of the
+	// RelayerApiService's ClearAttestorEvidence RPC.
+	RelayerApiServiceClearAttestorEvidenceProcedure = "/ibc.v2.relayer.RelayerApiService/ClearAttestorEvidence"
 )
 
 // RelayerApiServiceClient is a client for the ibc.v2.relayer.RelayerApiService service.
@@ -49,6 +55,12 @@ type RelayerApiServiceClient interface {
 	// Status returns per-packet relay status for a transaction previously
 	// submitted via Relay.
 	Status(context.Context, *connect.Request[StatusRequest]) (*connect.Response[StatusResponse], error)
+	// ListAttestorEvidence returns the misbehaviour evidence this relayer has
+	// collected against attestors, oldest first.
+	ListAttestorEvidence(context.Context, *connect.Request[ListAttestorEvidenceRequest]) (*connect.Response[ListAttestorEvidenceResponse], error)
+	// ClearAttestorEvidence clears the evidence against an attestor, admitting
+	// it to quorums that exclude misbehaving attestors again.
+	ClearAttestorEvidence(context.Context, *connect.Request[ClearAttestorEvidenceRequest]) (*connect.Response[ClearAttestorEvidenceResponse], error)
 }
 
 // NewRelayerApiServiceClient constructs a client for the ibc.v2.relayer.RelayerApiService service.
@@ -74,13 +86,27 @@ func NewRelayerApiServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(relayerApiServiceMethods.ByName("Status")),
 			connect.WithClientOptions(opts...),
 		),
+		listAttestorEvidence: connect.NewClient[ListAttestorEvidenceRequest, ListAttestorEvidenceResponse](
+			httpClient,
+			baseURL+RelayerApiServiceListAttestorEvidenceProcedure,
+			connect.WithSchema(relayerApiServiceMethods.ByName("ListAttestorEvidence")),
+			connect.WithClientOptions(opts...),
+		),
+		clearAttestorEvidence: connect.NewClient[ClearAttestorEvidenceRequest, ClearAttestorEvidenceResponse](
+			httpClient,
+			baseURL+RelayerApiServiceClearAttestorEvidenceProcedure,
+			connect.WithSchema(relayerApiServiceMethods.ByName("ClearAttestorEvidence")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // relayerApiServiceClient implements RelayerApiServiceClient.
 type relayerApiServiceClient struct {
-	relay  *connect.Client[RelayRequest, RelayResponse]
-	status *connect.Client[StatusRequest, StatusResponse]
+	relay                 *connect.Client[RelayRequest, RelayResponse]
+	status                *connect.Client[StatusRequest, StatusResponse]
+	listAttestorEvidence  *connect.Client[ListAttestorEvidenceRequest, ListAttestorEvidenceResponse]
+	clearAttestorEvidence *connect.Client[ClearAttestorEvidenceRequest, ClearAttestorEvidenceResponse]
 }
 
 // Relay calls ibc.v2.relayer.RelayerApiService.Relay.
@@ -93,6 +119,16 @@ func (c *relayerApiServiceClient) Status(ctx context.Context, req *connect.Reque
 	return c.status.CallUnary(ctx, req)
 }
 
+// ListAttestorEvidence calls ibc.v2.relayer.RelayerApiService.ListAttestorEvidence.
+func (c *relayerApiServiceClient) ListAttestorEvidence(ctx context.Context, req *connect.Request[ListAttestorEvidenceRequest]) (*connect.Response[ListAttestorEvidenceResponse], error) {
+	return c.listAttestorEvidence.CallUnary(ctx, req)
+}
+
+// ClearAttestorEvidence calls ibc.v2.relayer.RelayerApiService.ClearAttestorEvidence.
+func (c *relayerApiServiceClient) ClearAttestorEvidence(ctx context.Context, req *connect.Request[ClearAttestorEvidenceRequest]) (*connect.Response[ClearAttestorEvidenceResponse], error) {
+	return c.clearAttestorEvidence.CallUnary(ctx, req)
+}
+
 // RelayerApiServiceHandler is an implementation of the ibc.v2.relayer.RelayerApiService service.
 type RelayerApiServiceHandler interface {
 	// Relay tracks the packets emitted by a source transaction and submits the
@@ -101,6 +137,12 @@ type RelayerApiServiceHandler interface {
 	// Status returns per-packet relay status for a transaction previously
 	// submitted via Relay.
 	Status(context.Context, *connect.Request[StatusRequest]) (*connect.Response[StatusResponse], error)
+	// ListAttestorEvidence returns the misbehaviour evidence this relayer has
+	// collected against attestors, oldest first.
+	ListAttestorEvidence(context.Context, *connect.Request[ListAttestorEvidenceRequest]) (*connect.Response[ListAttestorEvidenceResponse], error)
+	// ClearAttestorEvidence clears the evidence against an attestor, admitting
+	// it to quorums that exclude misbehaving attestors again.
+	ClearAttestorEvidence(context.Context, *connect.Request[ClearAttestorEvidenceRequest]) (*connect.Response[ClearAttestorEvidenceResponse], error)
 }
 
 // NewRelayerApiServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -122,12 +164,28 @@ func NewRelayerApiServiceHandler(svc RelayerApiServiceHandler, opts ...connect.H
 		connect.WithSchema(relayerApiServiceMethods.ByName("Status")),
 		connect.WithHandlerOptions(opts...),
 	)
+	relayerApiServiceListAttestorEvidenceHandler := connect.NewUnaryHandler(
+		RelayerApiServiceListAttestorEvidenceProcedure,
+		svc.ListAttestorEvidence,
+		connect.WithSchema(relayerApiServiceMethods.ByName("ListAttestorEvidence")),
+		connect.WithHandlerOptions(opts...),
+	)
+	relayerApiServiceClearAttestorEvidenceHandler := connect.NewUnaryHandler(
+		RelayerApiServiceClearAttestorEvidenceProcedure,
+		svc.ClearAttestorEvidence,
+		connect.WithSchema(relayerApiServiceMethods.ByName("ClearAttestorEvidence")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/ibc.v2.relayer.RelayerApiService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case RelayerApiServiceRelayProcedure:
 			relayerApiServiceRelayHandler.ServeHTTP(w, r)
 		case RelayerApiServiceStatusProcedure:
 			relayerApiServiceStatusHandler.ServeHTTP(w, r)
+		case RelayerApiServiceListAttestorEvidenceProcedure:
+			relayerApiServiceListAttestorEvidenceHandler.ServeHTTP(w, r)
+		case RelayerApiServiceClearAttestorEvidenceProcedure:
+			relayerApiServiceClearAttestorEvidenceHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -144,3 +202,11 @@ func (UnimplementedRelayerApiServiceHandler) Relay(context.Context, *connect.Req
 func (UnimplementedRelayerApiServiceHandler) Status(context.Context, *connect.Request[StatusRequest]) (*connect.Response[StatusResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ibc.v2.relayer.RelayerApiService.Status is not implemented"))
 }
+
+func (UnimplementedRelayerApiServiceHandler) ListAttestorEvidence(context.Context, *connect.Request[ListAttestorEvidenceRequest]) (*connect.Response[ListAttestorEvidenceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ibc.v2.relayer.RelayerApiService.ListAttestorEvidence is not implemented"))
+}
+
+func (UnimplementedRelayerApiServiceHandler) ClearAttestorEvidence(context.Context, *connect.Request[ClearAttestorEvidenceRequest]) (*connect.Response[ClearAttestorEvidenceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ibc.v2.relayer.RelayerApiService.ClearAttestorEvidence is not implemented"))
+}
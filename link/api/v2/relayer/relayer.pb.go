@@ -92,6 +92,57 @@ func (PacketState) EnumDescriptor() ([]byte, []int) {
 	return file_relayer_proto_rawDescGZIP(), []int{0}
 }
 
+type EvidenceKind int32
+
+const (
+	EvidenceKind_EVIDENCE_KIND_UNSPECIFIED EvidenceKind = 0
+	// Conflicting state attestations.
+	EvidenceKind_EVIDENCE_KIND_STATE EvidenceKind = 1
+	// Conflicting packet attestations.
+	EvidenceKind_EVIDENCE_KIND_PACKET EvidenceKind = 2
+)
+
+// Enum value maps for EvidenceKind.
+var (
+	EvidenceKind_name = map[int32]string{
+		0: "EVIDENCE_KIND_UNSPECIFIED",
+		1: "EVIDENCE_KIND_STATE",
+		2: "EVIDENCE_KIND_PACKET",
+	}
+	EvidenceKind_value = map[string]int32{
+		"EVIDENCE_KIND_UNSPECIFIED": 0,
+		"EVIDENCE_KIND_STATE":       1,
+		"EVIDENCE_KIND_PACKET":      2,
+	}
+)
+
+func (x EvidenceKind) Enum() *EvidenceKind {
+	p := new(EvidenceKind)
+	*p = x
+	return p
+}
+
+func (x EvidenceKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EvidenceKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_relayer_proto_enumTypes[1].Descriptor()
+}
+
+func (EvidenceKind) Type() protoreflect.EnumType {
+	return &file_relayer_proto_enumTypes[1]
+}
+
+func (x EvidenceKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EvidenceKind.Descriptor instead.
+func (EvidenceKind) EnumDescriptor() ([]byte, []int) {
+	return file_relayer_proto_rawDescGZIP(), []int{1}
+}
+
 type RelayRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TxHash        string                 `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
@@ -615,6 +666,324 @@ func (x *PacketStatus) GetTimeoutTx() *TransactionInfo {
 	return nil
 }
 
+type ListAttestorEvidenceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Also return evidence an operator has already cleared.
+	IncludeCleared bool `protobuf:"varint,1,opt,name=include_cleared,json=includeCleared,proto3" json:"include_cleared,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListAttestorEvidenceRequest) Reset() {
+	*x = ListAttestorEvidenceRequest{}
+	mi := &file_relayer_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttestorEvidenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttestorEvidenceRequest) ProtoMessage() {}
+
+func (x *ListAttestorEvidenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_relayer_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttestorEvidenceRequest.ProtoReflect.Descriptor instead.
+func (*ListAttestorEvidenceRequest) Descriptor() ([]byte, []int) {
+	return file_relayer_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListAttestorEvidenceRequest) GetIncludeCleared() bool {
+	if x != nil {
+		return x.IncludeCleared
+	}
+	return false
+}
+
+type ListAttestorEvidenceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Evidence      []*AttestorEvidence    `protobuf:"bytes,1,rep,name=evidence,proto3" json:"evidence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttestorEvidenceResponse) Reset() {
+	*x = ListAttestorEvidenceResponse{}
+	mi := &file_relayer_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttestorEvidenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttestorEvidenceResponse) ProtoMessage() {}
+
+func (x *ListAttestorEvidenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_relayer_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttestorEvidenceResponse.ProtoReflect.Descriptor instead.
+func (*ListAttestorEvidenceResponse) Descriptor() ([]byte, []int) {
+	return file_relayer_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListAttestorEvidenceResponse) GetEvidence() []*AttestorEvidence {
+	if x != nil {
+		return x.Evidence
+	}
+	return nil
+}
+
+// AttestorEvidence a validly signed attestation that conflicts with the
+// quorum's attestation of the same kind at the same height.
+type AttestorEvidence struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The chain the attestor attests to.
+	ChainId         string       `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	AttestorName    string       `protobuf:"bytes,3,opt,name=attestor_name,json=attestorName,proto3" json:"attestor_name,omitempty"`
+	AttestorAddress string       `protobuf:"bytes,4,opt,name=attestor_address,json=attestorAddress,proto3" json:"attestor_address,omitempty"`
+	Kind            EvidenceKind `protobuf:"varint,5,opt,name=kind,proto3,enum=ibc.v2.relayer.EvidenceKind" json:"kind,omitempty"`
+	Height          uint64       `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	// The attestor's conflicting attestation data and signature.
+	Data      []byte `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`
+	Signature []byte `protobuf:"bytes,8,opt,name=signature,proto3" json:"signature,omitempty"`
+	// The attestation data the quorum agreed on, and one quorum member's
+	// signature over it.
+	QuorumData      []byte `protobuf:"bytes,9,opt,name=quorum_data,json=quorumData,proto3" json:"quorum_data,omitempty"`
+	QuorumSignature []byte `protobuf:"bytes,10,opt,name=quorum_signature,json=quorumSignature,proto3" json:"quorum_signature,omitempty"`
+	// Unix seconds the evidence was recorded.
+	CreatedAt uint64 `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Unix seconds the evidence was cleared, if it has been.
+	ClearedAt     *uint64 `protobuf:"varint,12,opt,name=cleared_at,json=clearedAt,proto3,oneof" json:"cleared_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttestorEvidence) Reset() {
+	*x = AttestorEvidence{}
+	mi := &file_relayer_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttestorEvidence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttestorEvidence) ProtoMessage() {}
+
+func (x *AttestorEvidence) ProtoReflect() protoreflect.Message {
+	mi := &file_relayer_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttestorEvidence.ProtoReflect.Descriptor instead.
+func (*AttestorEvidence) Descriptor() ([]byte, []int) {
+	return file_relayer_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AttestorEvidence) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AttestorEvidence) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *AttestorEvidence) GetAttestorName() string {
+	if x != nil {
+		return x.AttestorName
+	}
+	return ""
+}
+
+func (x *AttestorEvidence) GetAttestorAddress() string {
+	if x != nil {
+		return x.AttestorAddress
+	}
+	return ""
+}
+
+func (x *AttestorEvidence) GetKind() EvidenceKind {
+	if x != nil {
+		return x.Kind
+	}
+	return EvidenceKind_EVIDENCE_KIND_UNSPECIFIED
+}
+
+func (x *AttestorEvidence) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *AttestorEvidence) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *AttestorEvidence) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *AttestorEvidence) GetQuorumData() []byte {
+	if x != nil {
+		return x.QuorumData
+	}
+	return nil
+}
+
+func (x *AttestorEvidence) GetQuorumSignature() []byte {
+	if x != nil {
+		return x.QuorumSignature
+	}
+	return nil
+}
+
+func (x *AttestorEvidence) GetCreatedAt() uint64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *AttestorEvidence) GetClearedAt() uint64 {
+	if x != nil && x.ClearedAt != nil {
+		return *x.ClearedAt
+	}
+	return 0
+}
+
+type ClearAttestorEvidenceRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AttestorAddress string                 `protobuf:"bytes,1,opt,name=attestor_address,json=attestorAddress,proto3" json:"attestor_address,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ClearAttestorEvidenceRequest) Reset() {
+	*x = ClearAttestorEvidenceRequest{}
+	mi := &file_relayer_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearAttestorEvidenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearAttestorEvidenceRequest) ProtoMessage() {}
+
+func (x *ClearAttestorEvidenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_relayer_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearAttestorEvidenceRequest.ProtoReflect.Descriptor instead.
+func (*ClearAttestorEvidenceRequest) Descriptor() ([]byte, []int) {
+	return file_relayer_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ClearAttestorEvidenceRequest) GetAttestorAddress() string {
+	if x != nil {
+		return x.AttestorAddress
+	}
+	return ""
+}
+
+type ClearAttestorEvidenceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// How many evidence records were cleared.
+	Cleared       uint64 `protobuf:"varint,1,opt,name=cleared,proto3" json:"cleared,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearAttestorEvidenceResponse) Reset() {
+	*x = ClearAttestorEvidenceResponse{}
+	mi := &file_relayer_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearAttestorEvidenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearAttestorEvidenceResponse) ProtoMessage() {}
+
+func (x *ClearAttestorEvidenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_relayer_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearAttestorEvidenceResponse.ProtoReflect.Descriptor instead.
+func (*ClearAttestorEvidenceResponse) Descriptor() ([]byte, []int) {
+	return file_relayer_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ClearAttestorEvidenceResponse) GetCleared() uint64 {
+	if x != nil {
+		return x.Cleared
+	}
+	return 0
+}
+
 var File_relayer_proto protoreflect.FileDescriptor
 
 const file_relayer_proto_rawDesc = "" +
@@ -651,7 +1020,33 @@ const file_relayer_proto_rawDesc = "" +
 	"\arecv_tx\x18\x05 \x01(\v2\x1f.ibc.v2.relayer.TransactionInfoR\x06recvTx\x126\n" +
 	"\x06ack_tx\x18\x06 \x01(\v2\x1f.ibc.v2.relayer.TransactionInfoR\x05ackTx\x12>\n" +
 	"\n" +
-	"timeout_tx\x18\a \x01(\v2\x1f.ibc.v2.relayer.TransactionInfoR\ttimeoutTx*\xd6\x01\n" +
+	"timeout_tx\x18\a \x01(\v2\x1f.ibc.v2.relayer.TransactionInfoR\ttimeoutTx\"F\n" +
+	"\x1bListAttestorEvidenceRequest\x12'\n" +
+	"\x0finclude_cleared\x18\x01 \x01(\bR\x0eincludeCleared\"\\\n" +
+	"\x1cListAttestorEvidenceResponse\x12<\n" +
+	"\bevidence\x18\x01 \x03(\v2 .ibc.v2.relayer.AttestorEvidenceR\bevidence\"\xa7\x03\n" +
+	"\x10AttestorEvidence\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x19\n" +
+	"\bchain_id\x18\x02 \x01(\tR\achainId\x12#\n" +
+	"\rattestor_name\x18\x03 \x01(\tR\fattestorName\x12)\n" +
+	"\x10attestor_address\x18\x04 \x01(\tR\x0fattestorAddress\x120\n" +
+	"\x04kind\x18\x05 \x01(\x0e2\x1c.ibc.v2.relayer.EvidenceKindR\x04kind\x12\x16\n" +
+	"\x06height\x18\x06 \x01(\x04R\x06height\x12\x12\n" +
+	"\x04data\x18\a \x01(\fR\x04data\x12\x1c\n" +
+	"\tsignature\x18\b \x01(\fR\tsignature\x12\x1f\n" +
+	"\vquorum_data\x18\t \x01(\fR\n" +
+	"quorumData\x12)\n" +
+	"\x10quorum_signature\x18\n" +
+	" \x01(\fR\x0fquorumSignature\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\x04R\tcreatedAt\x12\"\n" +
+	"\n" +
+	"cleared_at\x18\f \x01(\x04H\x00R\tclearedAt\x88\x01\x01B\r\n" +
+	"\v_cleared_at\"I\n" +
+	"\x1cClearAttestorEvidenceRequest\x12)\n" +
+	"\x10attestor_address\x18\x01 \x01(\tR\x0fattestorAddress\"9\n" +
+	"\x1dClearAttestorEvidenceResponse\x12\x18\n" +
+	"\acleared\x18\x01 \x01(\x04R\acleared*\xd6\x01\n" +
 	"\vPacketState\x12\x1c\n" +
 	"\x18PACKET_STATE_UNSPECIFIED\x10\x00\x12\x1d\n" +
 	"\x19PACKET_STATE_NOT_SELECTED\x10\x01\x12\x18\n" +
@@ -659,10 +1054,16 @@ const file_relayer_proto_rawDesc = "" +
 	"\x16PACKET_STATE_SUCCEEDED\x10\x03\x12\x1a\n" +
 	"\x16PACKET_STATE_TIMED_OUT\x10\x04\x12\x19\n" +
 	"\x15PACKET_STATE_REJECTED\x10\x05\x12\x1d\n" +
-	"\x19PACKET_STATE_RELAY_FAILED\x10\x062\xa6\x01\n" +
+	"\x19PACKET_STATE_RELAY_FAILED\x10\x06*`\n" +
+	"\fEvidenceKind\x12\x1d\n" +
+	"\x19EVIDENCE_KIND_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13EVIDENCE_KIND_STATE\x10\x01\x12\x18\n" +
+	"\x14EVIDENCE_KIND_PACKET\x10\x022\x93\x03\n" +
 	"\x11RelayerApiService\x12F\n" +
 	"\x05Relay\x12\x1c.ibc.v2.relayer.RelayRequest\x1a\x1d.ibc.v2.relayer.RelayResponse\"\x00\x12I\n" +
-	"\x06Status\x12\x1d.ibc.v2.relayer.StatusRequest\x1a\x1e.ibc.v2.relayer.StatusResponse\"\x00B+Z)github.com/cosmos/ibc/link/api/v2/relayerb\x06proto3"
+	"\x06Status\x12\x1d.ibc.v2.relayer.StatusRequest\x1a\x1e.ibc.v2.relayer.StatusResponse\"\x00\x12s\n" +
+	"\x14ListAttestorEvidence\x12+.ibc.v2.relayer.ListAttestorEvidenceRequest\x1a,.ibc.v2.relayer.ListAttestorEvidenceResponse\"\x00\x12v\n" +
+	"\x15ClearAttestorEvidence\x12,.ibc.v2.relayer.ClearAttestorEvidenceRequest\x1a-.ibc.v2.relayer.ClearAttestorEvidenceResponse\"\x00B+Z)github.com/cosmos/ibc/link/api/v2/relayerb\x06proto3"
 
 var (
 	file_relayer_proto_rawDescOnce sync.Once
@@ -676,39 +1077,51 @@ func file_relayer_proto_rawDescGZIP() []byte {
 	return file_relayer_proto_rawDescData
 }
 
-var file_relayer_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_relayer_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_relayer_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_relayer_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_relayer_proto_goTypes = []any{
-	(PacketState)(0),        // 0: ibc.v2.relayer.PacketState
-	(*RelayRequest)(nil),    // 1: ibc.v2.relayer.RelayRequest
-	(*AllPackets)(nil),      // 2: ibc.v2.relayer.AllPackets
-	(*SelectedPackets)(nil), // 3: ibc.v2.relayer.SelectedPackets
-	(*PacketSelector)(nil),  // 4: ibc.v2.relayer.PacketSelector
-	(*RelayResponse)(nil),   // 5: ibc.v2.relayer.RelayResponse
-	(*StatusRequest)(nil),   // 6: ibc.v2.relayer.StatusRequest
-	(*StatusResponse)(nil),  // 7: ibc.v2.relayer.StatusResponse
-	(*TransactionInfo)(nil), // 8: ibc.v2.relayer.TransactionInfo
-	(*PacketStatus)(nil),    // 9: ibc.v2.relayer.PacketStatus
+	(PacketState)(0),                      // 0: ibc.v2.relayer.PacketState
+	(EvidenceKind)(0),                     // 1: ibc.v2.relayer.EvidenceKind
+	(*RelayRequest)(nil),                  // 2: ibc.v2.relayer.RelayRequest
+	(*AllPackets)(nil),                    // 3: ibc.v2.relayer.AllPackets
+	(*SelectedPackets)(nil),               // 4: ibc.v2.relayer.SelectedPackets
+	(*PacketSelector)(nil),                // 5: ibc.v2.relayer.PacketSelector
+	(*RelayResponse)(nil),                 // 6: ibc.v2.relayer.RelayResponse
+	(*StatusRequest)(nil),                 // 7: ibc.v2.relayer.StatusRequest
+	(*StatusResponse)(nil),                // 8: ibc.v2.relayer.StatusResponse
+	(*TransactionInfo)(nil),               // 9: ibc.v2.relayer.TransactionInfo
+	(*PacketStatus)(nil),                  // 10: ibc.v2.relayer.PacketStatus
+	(*ListAttestorEvidenceRequest)(nil),   // 11: ibc.v2.relayer.ListAttestorEvidenceRequest
+	(*ListAttestorEvidenceResponse)(nil),  // 12: ibc.v2.relayer.ListAttestorEvidenceResponse
+	(*AttestorEvidence)(nil),              // 13: ibc.v2.relayer.AttestorEvidence
+	(*ClearAttestorEvidenceRequest)(nil),  // 14: ibc.v2.relayer.ClearAttestorEvidenceRequest
+	(*ClearAttestorEvidenceResponse)(nil), // 15: ibc.v2.relayer.ClearAttestorEvidenceResponse
 }
 var file_relayer_proto_depIdxs = []int32{
-	2,  // 0: ibc.v2.relayer.RelayRequest.all_packets:type_name -> ibc.v2.relayer.AllPackets
-	3,  // 1: ibc.v2.relayer.RelayRequest.selected_packets:type_name -> ibc.v2.relayer.SelectedPackets
-	4,  // 2: ibc.v2.relayer.SelectedPackets.packets:type_name -> ibc.v2.relayer.PacketSelector
-	9,  // 3: ibc.v2.relayer.StatusResponse.packet_statuses:type_name -> ibc.v2.relayer.PacketStatus
+	3,  // 0: ibc.v2.relayer.RelayRequest.all_packets:type_name -> ibc.v2.relayer.AllPackets
+	4,  // 1: ibc.v2.relayer.RelayRequest.selected_packets:type_name -> ibc.v2.relayer.SelectedPackets
+	5,  // 2: ibc.v2.relayer.SelectedPackets.packets:type_name -> ibc.v2.relayer.PacketSelector
+	10, // 3: ibc.v2.relayer.StatusResponse.packet_statuses:type_name -> ibc.v2.relayer.PacketStatus
 	0,  // 4: ibc.v2.relayer.PacketStatus.state:type_name -> ibc.v2.relayer.PacketState
-	8,  // 5: ibc.v2.relayer.PacketStatus.send_tx:type_name -> ibc.v2.relayer.TransactionInfo
-	8,  // 6: ibc.v2.relayer.PacketStatus.recv_tx:type_name -> ibc.v2.relayer.TransactionInfo
-	8,  // 7: ibc.v2.relayer.PacketStatus.ack_tx:type_name -> ibc.v2.relayer.TransactionInfo
-	8,  // 8: ibc.v2.relayer.PacketStatus.timeout_tx:type_name -> ibc.v2.relayer.TransactionInfo
-	1,  // 9: ibc.v2.relayer.RelayerApiService.Relay:input_type -> ibc.v2.relayer.RelayRequest
-	6,  // 10: ibc.v2.relayer.RelayerApiService.Status:input_type -> ibc.v2.relayer.StatusRequest
-	5,  // 11: ibc.v2.relayer.RelayerApiService.Relay:output_type -> ibc.v2.relayer.RelayResponse
-	7,  // 12: ibc.v2.relayer.RelayerApiService.Status:output_type -> ibc.v2.relayer.StatusResponse
-	11, // [11:13] is the sub-list for method output_type
-	9,  // [9:11] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	9,  // 5: ibc.v2.relayer.PacketStatus.send_tx:type_name -> ibc.v2.relayer.TransactionInfo
+	9,  // 6: ibc.v2.relayer.PacketStatus.recv_tx:type_name -> ibc.v2.relayer.TransactionInfo
+	9,  // 7: ibc.v2.relayer.PacketStatus.ack_tx:type_name -> ibc.v2.relayer.TransactionInfo
+	9,  // 8: ibc.v2.relayer.PacketStatus.timeout_tx:type_name -> ibc.v2.relayer.TransactionInfo
+	13, // 9: ibc.v2.relayer.ListAttestorEvidenceResponse.evidence:type_name -> ibc.v2.relayer.AttestorEvidence
+	1,  // 10: ibc.v2.relayer.AttestorEvidence.kind:type_name -> ibc.v2.relayer.EvidenceKind
+	2,  // 11: ibc.v2.relayer.RelayerApiService.Relay:input_type -> ibc.v2.relayer.RelayRequest
+	7,  // 12: ibc.v2.relayer.RelayerApiService.Status:input_type -> ibc.v2.relayer.StatusRequest
+	11, // 13: ibc.v2.relayer.RelayerApiService.ListAttestorEvidence:input_type -> ibc.v2.relayer.ListAttestorEvidenceRequest
+	14, // 14: ibc.v2.relayer.RelayerApiService.ClearAttestorEvidence:input_type -> ibc.v2.relayer.ClearAttestorEvidenceRequest
+	6,  // 15: ibc.v2.relayer.RelayerApiService.Relay:output_type -> ibc.v2.relayer.RelayResponse
+	8,  // 16: ibc.v2.relayer.RelayerApiService.Status:output_type -> ibc.v2.relayer.StatusResponse
+	12, // 17: ibc.v2.relayer.RelayerApiService.ListAttestorEvidence:output_type -> ibc.v2.relayer.ListAttestorEvidenceResponse
+	15, // 18: ibc.v2.relayer.RelayerApiService.ClearAttestorEvidence:output_type -> ibc.v2.relayer.ClearAttestorEvidenceResponse
+	15, // [15:19] is the sub-list for method output_type
+	11, // [11:15] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_relayer_proto_init() }
@@ -720,13 +1133,14 @@ func file_relayer_proto_init() {
 		(*RelayRequest_AllPackets)(nil),
 		(*RelayRequest_SelectedPackets)(nil),
 	}
+	file_relayer_proto_msgTypes[11].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_relayer_proto_rawDesc), len(file_relayer_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   9,
+			NumEnums:      2,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
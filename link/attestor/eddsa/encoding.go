@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package eddsa
+
+import (
+	"crypto/ed25519"
+	"encoding/binary"
+	"fmt"
+)
+
+// PacketCompact one attested packet: the hash of its commitment path and the
+// commitment stored under it.
+type PacketCompact struct {
+	Path       [32]byte
+	Commitment [32]byte
+}
+
+const (
+	stateAttestationSize = 8 + 8
+	packetHeaderSize     = 8 + 4
+	packetCompactSize    = 32 + 32
+)
+
+// EncodeStateAttestation encodes height || timestamp, both big-endian uint64.
+func EncodeStateAttestation(height, timestamp uint64) []byte {
+	data := make([]byte, 0, stateAttestationSize)
+	data = binary.BigEndian.AppendUint64(data, height)
+
+	return binary.BigEndian.AppendUint64(data, timestamp)
+}
+
+// DecodeStateAttestation decodes an EncodeStateAttestation encoding.
+func DecodeStateAttestation(data []byte) (height, timestamp uint64, err error) {
+	if len(data) != stateAttestationSize {
+		return 0, 0, fmt.Errorf("state attestation must be %d bytes, got %d", stateAttestationSize, len(data))
+	}
+
+	return binary.BigEndian.Uint64(data), binary.BigEndian.Uint64(data[8:]), nil
+}
+
+// EncodePacketAttestation encodes height || count || (path || commitment)*,
+// with height a big-endian uint64 and count a big-endian uint32.
+func EncodePacketAttestation(height uint64, packets []PacketCompact) []byte {
+	data := make([]byte, 0, packetHeaderSize+len(packets)*packetCompactSize)
+	data = binary.BigEndian.AppendUint64(data, height)
+	data = binary.BigEndian.AppendUint32(data, uint32(len(packets)))
+
+	for _, packet := range packets {
+		data = append(data, packet.Path[:]...)
+		data = append(data, packet.Commitment[:]...)
+	}
+
+	return data
+}
+
+// DecodePacketAttestation decodes an EncodePacketAttestation encoding.
+func DecodePacketAttestation(data []byte) (height uint64, packets []PacketCompact, err error) {
+	if len(data) < packetHeaderSize {
+		return 0, nil, fmt.Errorf("packet attestation must be at least %d bytes, got %d", packetHeaderSize, len(data))
+	}
+
+	height = binary.BigEndian.Uint64(data)
+	count := int(binary.BigEndian.Uint32(data[8:]))
+
+	body := data[packetHeaderSize:]
+	if len(body) != count*packetCompactSize {
+		return 0, nil, fmt.Errorf("packet attestation of %d packets must carry %d bytes, got %d",
+			count, count*packetCompactSize, len(body))
+	}
+
+	packets = make([]PacketCompact, count)
+	for i := range packets {
+		offset := i * packetCompactSize
+		copy(packets[i].Path[:], body[offset:])
+		copy(packets[i].Commitment[:], body[offset+32:])
+	}
+
+	return height, packets, nil
+}
+
+// EncodeAttestationProof encodes len(attestationData) || attestationData ||
+// count || signature*, with lengths as big-endian uint32 and each signature a
+// 64-byte ed25519 signature.
+func EncodeAttestationProof(attestationData []byte, signatures [][]byte) ([]byte, error) {
+	data := make([]byte, 0, 4+len(attestationData)+4+len(signatures)*ed25519.SignatureSize)
+	data = binary.BigEndian.AppendUint32(data, uint32(len(attestationData)))
+	data = append(data, attestationData...)
+	data = binary.BigEndian.AppendUint32(data, uint32(len(signatures)))
+
+	for i, signature := range signatures {
+		if len(signature) != ed25519.SignatureSize {
+			return nil, fmt.Errorf("encode attestation proof: signature %d is %d bytes, expected %d",
+				i, len(signature), ed25519.SignatureSize)
+		}
+
+		data = append(data, signature...)
+	}
+
+	return data, nil
+}
+
+// DecodeAttestationProof decodes an EncodeAttestationProof encoding.
+func DecodeAttestationProof(data []byte) (attestationData []byte, signatures [][]byte, err error) {
+	if len(data) < 4 {
+		return nil, nil, fmt.Errorf("attestation proof truncated")
+	}
+
+	size := int(binary.BigEndian.Uint32(data))
+	data = data[4:]
+
+	if len(data) < size+4 {
+		return nil, nil, fmt.Errorf("attestation proof truncated")
+	}
+
+	attestationData, data = data[:size], data[size:]
+	count := int(binary.BigEndian.Uint32(data))
+	data = data[4:]
+
+	if len(data) != count*ed25519.SignatureSize {
+		return nil, nil, fmt.Errorf("attestation proof of %d signatures must carry %d bytes, got %d",
+			count, count*ed25519.SignatureSize, len(data))
+	}
+
+	signatures = make([][]byte, count)
+	for i := range signatures {
+		signatures[i] = data[i*ed25519.SignatureSize : (i+1)*ed25519.SignatureSize]
+	}
+
+	return attestationData, signatures, nil
+}
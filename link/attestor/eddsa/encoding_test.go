@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package eddsa
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeStateAttestation(t *testing.T) {
+	// ACT
+	data := EncodeStateAttestation(42, 1_700_000_000)
+
+	// ASSERT
+	assert.Equal(t, []byte{0, 0, 0, 0, 0, 0, 0, 42, 0, 0, 0, 0, 0x65, 0x53, 0xf1, 0x00}, data)
+
+	height, timestamp, err := DecodeStateAttestation(data)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(42), height)
+	assert.Equal(t, uint64(1_700_000_000), timestamp)
+
+	_, _, err = DecodeStateAttestation(data[:15])
+	require.Error(t, err)
+}
+
+func TestEncodePacketAttestation(t *testing.T) {
+	first := PacketCompact{Path: filledWord(0x11), Commitment: filledWord(0x22)}
+	second := PacketCompact{Path: filledWord(0x33), Commitment: filledWord(0x44)}
+
+	for _, tt := range []struct {
+		name    string
+		height  uint64
+		packets []PacketCompact
+	}{
+		{name: "noPackets", height: 1, packets: []PacketCompact{}},
+		{name: "onePacket", height: 42, packets: []PacketCompact{first}},
+		{name: "twoPackets", height: ^uint64(0), packets: []PacketCompact{first, second}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			// ACT
+			data := EncodePacketAttestation(tt.height, tt.packets)
+
+			// ASSERT
+			assert.Len(t, data, 12+64*len(tt.packets))
+
+			height, packets, err := DecodePacketAttestation(data)
+			require.NoError(t, err)
+			assert.Equal(t, tt.height, height)
+			assert.Equal(t, tt.packets, packets)
+
+			_, _, err = DecodePacketAttestation(append(data, 0x00))
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestEncodeAttestationProof(t *testing.T) {
+	t.Run("roundTrip", func(t *testing.T) {
+		// ARRANGE
+		data := EncodeStateAttestation(7, 8)
+		signatures := [][]byte{bytes.Repeat([]byte{0x01}, 64), bytes.Repeat([]byte{0x02}, 64)}
+
+		// ACT
+		proof, err := EncodeAttestationProof(data, signatures)
+
+		// ASSERT
+		require.NoError(t, err)
+		assert.Len(t, proof, 4+len(data)+4+2*64)
+
+		decodedData, decodedSignatures, err := DecodeAttestationProof(proof)
+		require.NoError(t, err)
+		assert.Equal(t, data, decodedData)
+		assert.Equal(t, signatures, decodedSignatures)
+
+		_, _, err = DecodeAttestationProof(proof[:len(proof)-1])
+		require.Error(t, err)
+	})
+
+	t.Run("rejectsWrongSignatureLength", func(t *testing.T) {
+		_, err := EncodeAttestationProof([]byte("data"), [][]byte{make([]byte, 65)})
+		require.ErrorContains(t, err, "signature 0 is 65 bytes")
+	})
+}
+
+func filledWord(b byte) [32]byte {
+	var word [32]byte
+	for i := range word {
+		word[i] = b
+	}
+
+	return word
+}
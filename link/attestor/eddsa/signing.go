@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Package eddsa encodes and signs attestations for counterparty light clients
+// that verify ed25519 signatures, such as Solana- or Cosmos-hosted attestation
+// clients. It mirrors package evm, with a compact binary encoding in place of
+// ABI and ed25519 in place of secp256k1.
+package eddsa
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// Signer signs a message with ed25519.
+type Signer interface {
+	Sign(ctx context.Context, data []byte) ([]byte, error)
+}
+
+// Domain separation tags.
+const (
+	TagStateAttestation  = "ibc-attestation/eddsa/state/v1"
+	TagPacketAttestation = "ibc-attestation/eddsa/packet/v1"
+)
+
+// Message computes tag || sha256(data), the domain-separated message an
+// ed25519 attestation client verifies signatures over.
+func Message(tag string, data []byte) []byte {
+	inner := sha256.Sum256(data)
+
+	message := make([]byte, 0, len(tag)+sha256.Size)
+	message = append(message, tag...)
+
+	return append(message, inner[:]...)
+}
+
+// Sign signs Message(tag, data).
+func Sign(ctx context.Context, signer Signer, tag string, data []byte) ([]byte, error) {
+	signature, err := signer.Sign(ctx, Message(tag, data))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(signature) != ed25519.SignatureSize {
+		return nil, fmt.Errorf("invalid signature length %d, expected %d", len(signature), ed25519.SignatureSize)
+	}
+
+	return signature, nil
+}
+
+// Verify checks sig is publicKey's signature over Message(tag, data), the
+// inverse of Sign. Unlike secp256k1, ed25519 cannot recover the signer, so
+// the verifier must already know which key it expects.
+func Verify(publicKey []byte, tag string, data, sig []byte) error {
+	if len(publicKey) != ed25519.PublicKeySize {
+		return fmt.Errorf("public key must be %d bytes, got %d", ed25519.PublicKeySize, len(publicKey))
+	}
+
+	if len(sig) != ed25519.SignatureSize {
+		return fmt.Errorf("signature must be %d bytes, got %d", ed25519.SignatureSize, len(sig))
+	}
+
+	if !ed25519.Verify(publicKey, Message(tag, data), sig) {
+		return fmt.Errorf("invalid signature")
+	}
+
+	return nil
+}
+
+// Address derives the attestor address of an ed25519 public key: the
+// 0x-prefixed hex of the raw 32-byte key, which is what the on-chain
+// attestation set lists.
+func Address(publicKey []byte) (string, error) {
+	if len(publicKey) != ed25519.PublicKeySize {
+		return "", fmt.Errorf("public key must be %d bytes, got %d", ed25519.PublicKeySize, len(publicKey))
+	}
+
+	return "0x" + hex.EncodeToString(publicKey), nil
+}
+
+// PublicKey is the inverse of Address.
+func PublicKey(address string) ([]byte, error) {
+	publicKey, err := hex.DecodeString(strings.TrimPrefix(address, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("decode address %q: %w", address, err)
+	}
+
+	if len(publicKey) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("address %q is not a %d-byte ed25519 public key", address, ed25519.PublicKeySize)
+	}
+
+	return publicKey, nil
+}
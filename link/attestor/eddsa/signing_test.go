@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package eddsa
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type keySigner ed25519.PrivateKey
+
+func (k keySigner) Sign(_ context.Context, data []byte) ([]byte, error) {
+	return ed25519.Sign(ed25519.PrivateKey(k), data), nil
+}
+
+func TestMessageMatchesFormula(t *testing.T) {
+	data := []byte("some attestation data")
+
+	for _, tag := range []string{TagStateAttestation, TagPacketAttestation} {
+		inner := sha256.Sum256(data)
+
+		assert.Equal(t, append([]byte(tag), inner[:]...), Message(tag, data), "tag %s", tag)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	ctx := context.Background()
+
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	data := EncodeStateAttestation(42, 1000)
+
+	t.Run("roundTrip", func(t *testing.T) {
+		// ACT
+		sig, err := Sign(ctx, keySigner(privateKey), TagStateAttestation, data)
+
+		// ASSERT
+		require.NoError(t, err)
+		require.Len(t, sig, ed25519.SignatureSize)
+		require.NoError(t, Verify(publicKey, TagStateAttestation, data, sig))
+	})
+
+	t.Run("rejectsOtherDomain", func(t *testing.T) {
+		// ARRANGE
+		sig, err := Sign(ctx, keySigner(privateKey), TagPacketAttestation, data)
+		require.NoError(t, err)
+
+		// ACT
+		err = Verify(publicKey, TagStateAttestation, data, sig)
+
+		// ASSERT
+		require.ErrorContains(t, err, "invalid signature")
+	})
+
+	t.Run("rejectsWrongLength", func(t *testing.T) {
+		require.Error(t, Verify(publicKey, TagStateAttestation, data, []byte{0x01, 0x02}))
+		require.Error(t, Verify(publicKey[:31], TagStateAttestation, data, make([]byte, 64)))
+	})
+}
+
+func TestAddress(t *testing.T) {
+	// ARRANGE
+	publicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	// ACT
+	address, err := Address(publicKey)
+
+	// ASSERT
+	require.NoError(t, err)
+	assert.Len(t, address, 2+64)
+
+	decoded, err := PublicKey(address)
+	require.NoError(t, err)
+	assert.Equal(t, []byte(publicKey), decoded)
+
+	_, err = Address(publicKey[:20])
+	require.Error(t, err)
+
+	_, err = PublicKey("0x" + address[2:42])
+	require.Error(t, err)
+}
@@ -0,0 +1,291 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"log/slog"
+	"path/filepath"
+	"slices"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/ibc/link/internal/config"
+	"github.com/cosmos/ibc/link/internal/deploy"
+	"github.com/cosmos/ibc/link/internal/deploy/manifest"
+	"github.com/cosmos/ibc/link/internal/deploy/topology"
+)
+
+var (
+	flagDeployApplyFile   string
+	flagDeployApplyOutput string
+)
+
+var cmdDeployApply = &cobra.Command{
+	Use:   "apply",
+	Short: "Deploy a mesh of chains, connections and apps declared in a topology file",
+	Long: "Plans the core stack of every chain, both clients of every connection and every chain's apps, " +
+		"in that order, runs the plan like the single-chain deploy commands do, then writes a relayer " +
+		"config for the whole mesh to --output. Rerunning resumes an interrupted apply.",
+	Example: "  ibc deploy apply -f topology.yml --dry-run\n" +
+		"  ibc deploy apply -f topology.yml --output relayer.yml",
+	RunE: deployApply,
+}
+
+func deployApply(cmd *cobra.Command, _ []string) error {
+	ctx := cmd.Context()
+	// resolve -f against the working directory before setupHomeWithConfig
+	// changes it to --home
+	path, err := filepath.Abs(flagDeployApplyFile)
+	if err != nil {
+		return errors.Wrap(err, "topology path")
+	}
+	topo, err := topology.Load(path)
+	if err != nil {
+		return err
+	}
+	cfg, err := setupHomeWithConfig()
+	if err != nil {
+		return err
+	}
+
+	steps, err := topologySteps(ctx, cfg, topo)
+	if err != nil {
+		return err
+	}
+	return applyTopology(ctx, cfg, topo, steps)
+}
+
+// applyTopology runs the plan of topo, then renders the relayer config from
+// the manifests it leaves. A dry run or an export executes nothing, so there
+// is no deployment to render yet.
+func applyTopology(ctx context.Context, cfg config.Config, topo topology.Topology, steps []deploy.Step) error {
+	executed, err := planThenRun(ctx, steps)
+	if err != nil || !executed {
+		return err
+	}
+
+	manifests := make(map[string]*manifest.Manifest, len(topo.Chains))
+	for _, c := range topo.Chains {
+		m, err := manifest.Load(flagDeployManifestDir, c.ChainID)
+		if err != nil {
+			return err
+		}
+		if m == nil {
+			return errors.Errorf("no manifest for chain %s in %s after apply", c.ChainID, flagDeployManifestDir)
+		}
+		manifests[c.ChainID] = m
+	}
+	rendered, err := renderTopologyConfig(ctx, cfg, topo, manifests)
+	if err != nil {
+		return err
+	}
+	if err := rendered.StoreToFileWithComments(flagDeployApplyOutput); err != nil {
+		return errors.Wrapf(err, "write %s", flagDeployApplyOutput)
+	}
+	slog.Info("wrote relayer config", "path", flagDeployApplyOutput)
+	return nil
+}
+
+// topologySteps orders the steps of every chain in topo by dependency: the
+// core stack of each chain, then both clients of each connection, then the
+// apps of each chain, IFT tokens after the GMP app governing them.
+func topologySteps(ctx context.Context, cfg config.Config, topo topology.Topology) ([]deploy.Step, error) {
+	dir := flagDeployManifestDir
+	for _, conn := range topo.Connections {
+		for _, end := range []topology.End{conn.A, conn.B} {
+			if _, ok := cfg.Signer(end.Signer); !ok {
+				return nil, errors.Errorf("connection %s: signer %q not found in config", conn.Name(), end.Signer)
+			}
+		}
+	}
+	targets := make(map[string]deploy.Target, len(topo.Chains))
+	var steps []deploy.Step
+	for _, c := range topo.Chains {
+		if chain, ok := cfg.Chain(c.ChainID); ok && chain.Type() != config.ChainTypeEVM {
+			return nil, errors.Errorf("chain %s is a %s chain: apply renders relayer configs, which relay evm chains "+
+				"only; deploy it with the single-chain commands", c.ChainID, chain.Type())
+		}
+		target, err := newTarget(ctx, cfg, c.ChainID, flagDeployDeployer, true)
+		if err != nil {
+			return nil, errors.Wrapf(err, "chain %s", c.ChainID)
+		}
+		targets[c.ChainID] = target
+		steps = append(steps, deploy.CoreSteps(target, dir, c.ChainID)...)
+	}
+
+	params, err := topologyClientParams(topo)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range params {
+		spec, err := newClientSpec(ctx, cfg, targets[p.CounterpartyChainID], p)
+		if err != nil {
+			return nil, errors.Wrapf(err, "client %s on chain %s", p.ClientID, p.ChainID)
+		}
+		steps = append(steps, deploy.ClientSteps(targets[p.ChainID], dir, p.ChainID, spec)...)
+	}
+
+	for _, c := range topo.Chains {
+		target := targets[c.ChainID]
+		if c.Apps.GMP || c.Apps.Transfer {
+			apps, appsErr := deploy.Require[deploy.AppDeployer](target, "app deployment")
+			if appsErr != nil {
+				return nil, errors.Wrapf(appsErr, "chain %s", c.ChainID)
+			}
+			if c.Apps.GMP {
+				steps = append(steps, deploy.GMPSteps(apps, dir, c.ChainID)...)
+			}
+			if c.Apps.Transfer {
+				steps = append(steps, deploy.TransferSteps(apps, dir, c.ChainID)...)
+			}
+		}
+		for _, tok := range c.Apps.IFT {
+			owner := tok.Owner
+			if owner == "" {
+				chain, _ := cfg.Chain(c.ChainID)
+				owner, err = senderAddress(ctx, cfg, chain)
+				if err != nil {
+					return nil, errors.Wrapf(err, "ift token %s on chain %s", tok.Symbol, c.ChainID)
+				}
+			}
+			tokens, tokensErr := deploy.Require[deploy.IFTDeployer](target, "ift token deployment")
+			if tokensErr != nil {
+				return nil, errors.Wrapf(tokensErr, "chain %s", c.ChainID)
+			}
+			spec := deploy.IFTSpec{Owner: owner, Name: tok.Name, Symbol: tok.Symbol}
+			steps = append(steps, deploy.IFTSteps(tokens, dir, c.ChainID, spec)...)
+		}
+	}
+	return steps, nil
+}
+
+// topologyClientParams lists the two clients of each connection: each end's
+// client tracks the other end, trusting the attestor set declared for it,
+// with client ids defaulting to the connection's shared name.
+func topologyClientParams(topo topology.Topology) ([]clientParams, error) {
+	params := make([]clientParams, 0, 2*len(topo.Connections))
+	deployedBy := make(map[[2]string]string)
+	for _, conn := range topo.Connections {
+		idA, idB := topologyClientIDs(conn)
+		ends := [2]struct {
+			end, counterparty  topology.End
+			id, counterpartyID string
+		}{
+			{conn.A, conn.B, idA, idB},
+			{conn.B, conn.A, idB, idA},
+		}
+		for _, e := range ends {
+			key := [2]string{e.end.ChainID, e.id}
+			if other, dup := deployedBy[key]; dup {
+				return nil, errors.Errorf(
+					"connections %s and %s both deploy client %s on chain %s; set a distinct clientId",
+					other, conn.Name(), e.id, e.end.ChainID,
+				)
+			}
+			deployedBy[key] = conn.Name()
+
+			p := clientParams{
+				ChainID:              e.end.ChainID,
+				CounterpartyChainID:  e.counterparty.ChainID,
+				ClientID:             e.id,
+				CounterpartyClientID: e.counterpartyID,
+				Type:                 deploy.ClientTypeAttestation,
+				Threshold:            1,
+			}
+			if set, ok := topo.AttestorSet(e.counterparty.AttestorSet); ok {
+				p.Attestors = set.Attestors
+				p.Threshold = set.Threshold
+			}
+			params = append(params, p)
+		}
+	}
+	return params, nil
+}
+
+// topologyClientIDs returns the client ids of conn's ends, defaulting to the
+// shared name `deploy client` derives.
+func topologyClientIDs(conn topology.Connection) (string, string) {
+	idA, idB := conn.A.ClientID, conn.B.ClientID
+	if idA == "" {
+		idA = defaultClientID(conn.A.ChainID, conn.B.ChainID)
+	}
+	if idB == "" {
+		idB = defaultClientID(conn.A.ChainID, conn.B.ChainID)
+	}
+	return idA, idB
+}
+
+// renderTopologyConfig projects an applied topology into cfg: the routers of
+// its chains, one relayer connection per topology connection, replacing any
+// of the same alias, and the attestors of their clients not configured
+// already.
+func renderTopologyConfig(
+	ctx context.Context,
+	cfg config.Config,
+	topo topology.Topology,
+	manifests map[string]*manifest.Manifest,
+) (config.Config, error) {
+	out := cfg
+	out.Chains = slices.Clone(cfg.Chains)
+	for i, chain := range out.Chains {
+		m, ok := manifests[chain.ChainID]
+		if !ok || chain.EVM == nil {
+			continue
+		}
+		evm := *chain.EVM
+		evm.ICS26Router = m.Core.Router
+		out.Chains[i].EVM = &evm
+	}
+
+	aliases := make(map[string]struct{}, len(topo.Connections))
+	for _, conn := range topo.Connections {
+		aliases[conn.Name()] = struct{}{}
+	}
+	out.Relayer.Connections = slices.DeleteFunc(
+		slices.Clone(cfg.Relayer.Connections),
+		func(c config.ConnectionConfig) bool { _, ok := aliases[c.Alias]; return ok },
+	)
+
+	out.Attestors = slices.Clone(cfg.Attestors)
+	seen := make(map[string]struct{}, len(cfg.Attestors))
+	configured := make(map[[2]string]struct{}, len(cfg.Attestors))
+	for _, a := range cfg.Attestors {
+		seen[a.Name] = struct{}{}
+		if a.Signer != "" {
+			configured[[2]string{a.ChainID, a.Signer}] = struct{}{}
+		}
+	}
+	unconfigured := func(attestors config.Attestors) config.Attestors {
+		return slices.DeleteFunc(attestors, func(a config.AttestorConfig) bool {
+			_, ok := configured[[2]string{a.ChainID, a.Signer}]
+			return a.Signer != "" && ok
+		})
+	}
+
+	for _, conn := range topo.Connections {
+		a, b := manifests[conn.A.ChainID], manifests[conn.B.ChainID]
+		idA, idB := topologyClientIDs(conn)
+		ca, okA := a.Client(idA)
+		cb, okB := b.Client(idB)
+		if !okA || !okB {
+			return config.Config{}, errors.Errorf(
+				"connection %s: client %s on chain %s or %s on chain %s not recorded",
+				conn.Name(), idA, conn.A.ChainID, idB, conn.B.ChainID,
+			)
+		}
+		out.Relayer.Connections = append(out.Relayer.Connections, config.ConnectionConfig{
+			Alias:   conn.Name(),
+			ClientA: renderedClientEnd(a, ca, conn.A.Signer),
+			ClientB: renderedClientEnd(b, cb, conn.B.Signer),
+		})
+		out.Attestors = appendUniqueAttestors(
+			out.Attestors, seen, unconfigured(attestorsFromClient(ctx, cfg, ca, conn.B.ChainID)),
+		)
+		out.Attestors = appendUniqueAttestors(
+			out.Attestors, seen, unconfigured(attestorsFromClient(ctx, cfg, cb, conn.A.ChainID)),
+		)
+	}
+	return out, nil
+}
@@ -0,0 +1,163 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc/link/internal/config"
+	"github.com/cosmos/ibc/link/internal/deploy"
+	"github.com/cosmos/ibc/link/internal/deploy/manifest"
+	"github.com/cosmos/ibc/link/internal/deploy/topology"
+)
+
+func TestTopologyClientParams(t *testing.T) {
+	topo := topology.Topology{
+		Chains:       []topology.Chain{{ChainID: "1"}, {ChainID: "2"}, {ChainID: "3"}},
+		AttestorSets: []topology.AttestorSet{{Name: "watching-1", Attestors: []string{"0xa", "0xb"}, Threshold: 2}},
+		Connections: []topology.Connection{
+			{
+				A: topology.End{ChainID: "1", Signer: "r1", AttestorSet: "watching-1"},
+				B: topology.End{ChainID: "2", Signer: "r2"},
+			},
+			{
+				A: topology.End{ChainID: "3", Signer: "r3", ClientID: "custom-3"},
+				B: topology.End{ChainID: "1", Signer: "r1"},
+			},
+		},
+	}
+
+	params, err := topologyClientParams(topo)
+	require.NoError(t, err)
+	require.Len(t, params, 4)
+
+	// chain 1's client tracks chain 2 with chain 2's configured attestors
+	require.Equal(t, "1", params[0].ChainID)
+	require.Equal(t, "2", params[0].CounterpartyChainID)
+	require.Equal(t, "link-1-2", params[0].ClientID)
+	require.Equal(t, "link-1-2", params[0].CounterpartyClientID)
+	require.Empty(t, params[0].Attestors)
+	require.EqualValues(t, 1, params[0].Threshold)
+
+	// chain 2's client tracks chain 1 with the set declared for chain 1
+	require.Equal(t, "2", params[1].ChainID)
+	require.Equal(t, []string{"0xa", "0xb"}, params[1].Attestors)
+	require.EqualValues(t, 2, params[1].Threshold)
+
+	// an explicit id on one end only; the other keeps the shared default
+	require.Equal(t, "custom-3", params[2].ClientID)
+	require.Equal(t, "link-1-3", params[2].CounterpartyClientID)
+	require.Equal(t, "link-1-3", params[3].ClientID)
+	require.Equal(t, "custom-3", params[3].CounterpartyClientID)
+	// attestor sets are per connection end: this end of chain 1 names none
+	require.Empty(t, params[2].Attestors)
+
+	// a second connection between the same chains needs its own client ids
+	topo.Connections = append(topo.Connections, topology.Connection{
+		Alias: "second",
+		A:     topology.End{ChainID: "2", Signer: "r2"},
+		B:     topology.End{ChainID: "1", Signer: "r1"},
+	})
+	_, err = topologyClientParams(topo)
+	require.ErrorContains(t, err, "connections 1-2 and second both deploy client link-1-2 on chain 2")
+}
+
+func TestRenderTopologyConfig(t *testing.T) {
+	watcherSigner, watcherAddress := newLocalSignerConfig(t, "attestor-watching-2")
+
+	a := manifest.New("1", "evm")
+	a.Core.Router = "0xrouterA"
+	a.UpsertClient(manifest.Client{
+		ClientID: "link-1-2", Type: "attestation",
+		CounterpartyChainID: "2", CounterpartyClientID: "link-1-2",
+		Params: map[string]any{"attestors": []any{watcherAddress, "0xUnresolvedAddress"}},
+	})
+	b := manifest.New("2", "evm")
+	b.Core.Router = "0xrouterB"
+	b.UpsertClient(manifest.Client{
+		ClientID: "link-1-2", Type: "attestation",
+		CounterpartyChainID: "1", CounterpartyClientID: "link-1-2",
+	})
+
+	cfg := config.Config{
+		Chains: []config.ChainConfig{
+			{ChainID: "1", EVM: &config.EVMChainConfig{RPC: "http://a", ICS26Router: "0xstale"}},
+			{ChainID: "2", EVM: &config.EVMChainConfig{RPC: "http://b"}},
+		},
+		Relayer: config.RelayerConfig{Connections: []config.ConnectionConfig{
+			{Alias: "1-2", ClientA: config.ClientEnd{ClientID: "stale"}},
+			{Alias: "unrelated"},
+		}},
+		// the watcher is already configured: it must not be projected again
+		Attestors: config.Attestors{{ChainID: "2", Name: "watcher", Signer: "attestor-watching-2"}},
+		Signers:   config.Signers{watcherSigner},
+	}
+	topo := topology.Topology{
+		Chains: []topology.Chain{{ChainID: "1"}, {ChainID: "2"}},
+		Connections: []topology.Connection{{
+			A: topology.End{ChainID: "1", Signer: "relayer-1"},
+			B: topology.End{ChainID: "2", Signer: "relayer-2"},
+		}},
+	}
+
+	out, err := renderTopologyConfig(context.Background(), cfg, topo, map[string]*manifest.Manifest{"1": a, "2": b})
+	require.NoError(t, err)
+
+	require.Equal(t, "0xrouterA", out.Chains[0].EVM.ICS26Router)
+	require.Equal(t, "http://a", out.Chains[0].EVM.RPC)
+	require.Equal(t, "0xrouterB", out.Chains[1].EVM.ICS26Router)
+	// the input config is not modified
+	require.Equal(t, "0xstale", cfg.Chains[0].EVM.ICS26Router)
+
+	require.Len(t, out.Relayer.Connections, 2)
+	require.Equal(t, "unrelated", out.Relayer.Connections[0].Alias)
+	conn := out.Relayer.Connections[1]
+	require.Equal(t, "1-2", conn.Alias)
+	require.Equal(t, "link-1-2", conn.ClientA.ClientID)
+	require.Equal(t, config.SignerAliases{"relayer-1"}, conn.ClientA.Signer)
+	require.Equal(t, config.SignerAliases{"relayer-2"}, conn.ClientB.Signer)
+
+	require.Len(t, out.Attestors, 2)
+	require.Equal(t, "watcher", out.Attestors[0].Name)
+	require.Equal(t, "attestor-2-0xUnresolvedAddress", out.Attestors[1].Name)
+
+	// a client missing from its manifest cannot be rendered
+	_, err = renderTopologyConfig(context.Background(), cfg, topo,
+		map[string]*manifest.Manifest{"1": a, "2": manifest.New("2", "evm")})
+	require.ErrorContains(t, err, "not recorded")
+}
+
+func TestApplyExportRendersNothing(t *testing.T) {
+	home := t.TempDir()
+	export, manifestDir, homeFlag, output := flagDeployExport, flagDeployManifestDir, globalFlags.Home,
+		flagDeployApplyOutput
+	t.Cleanup(func() {
+		flagDeployExport, flagDeployManifestDir, globalFlags.Home, flagDeployApplyOutput = export, manifestDir,
+			homeFlag, output
+	})
+	globalFlags.Home, flagDeployManifestDir = home, "deployments"
+	flagDeployApplyOutput = filepath.Join(home, "relayer.yml")
+	require.NoError(t, manifest.New("1", "evm").Save(filepath.Join(home, "deployments")))
+
+	flagDeployExport = filepath.Join(home, "out")
+	require.NoError(t, deployPersistentPreRun(cmdDeployApply, nil))
+
+	// chain 1 is deployed already: its pending manifest is pruned
+	deployed := deploy.Step{
+		Name: "core",
+		Done: func(context.Context) (bool, error) { return true, nil },
+		Run:  func(context.Context) error { return errors.New("ran a deployed step") },
+	}
+	topo := topology.Topology{Chains: []topology.Chain{{ChainID: "1"}}}
+	require.NoError(t, applyTopology(context.Background(), config.Config{}, topo, []deploy.Step{deployed}))
+
+	// nothing executed, so no relayer config is rendered from the plan
+	_, err := os.Stat(flagDeployApplyOutput)
+	require.True(t, os.IsNotExist(err))
+}
@@ -3,16 +3,83 @@
 package main
 
 import (
+	"context"
+	"log/slog"
+	"os"
+	"slices"
+	"strings"
+
 	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
 
+	relayerv2 "github.com/cosmos/ibc/link/api/v2/relayer"
+	"github.com/cosmos/ibc/link/internal/chains"
 	"github.com/cosmos/ibc/link/internal/config"
+	"github.com/cosmos/ibc/link/internal/deploy"
+	"github.com/cosmos/ibc/link/internal/deploy/manifest"
+	"github.com/cosmos/ibc/link/internal/livevalidate"
 	"github.com/cosmos/ibc/link/internal/service/signer"
+	"github.com/cosmos/ibc/link/keyfile"
 )
 
+var (
+	flagAttestorsEvidenceIncludeCleared bool
+
+	flagAttestorsRotateSigner    string
+	flagAttestorsRotateGenerate  bool
+	flagAttestorsRotateThreshold uint8
+	flagAttestorsRotateDualSign  bool
+)
+
+var (
+	cmdAttestors = &cobra.Command{
+		Use:   "attestors",
+		Short: "Commands about the attestors this relayer queries",
+	}
+
+	cmdAttestorsEvidence = &cobra.Command{
+		Use:   "evidence",
+		Short: "List misbehaviour evidence the running relayer has collected against attestors",
+		Args:  cobra.NoArgs,
+		RunE:  attestorsEvidence,
+	}
+
+	cmdAttestorsEvidenceClear = &cobra.Command{
+		Use:   "clear [address]",
+		Short: "Clear the evidence against an attestor, readmitting it to quorums",
+		Args:  cobra.ExactArgs(1),
+		RunE:  attestorsEvidenceClear,
+	}
+
+	cmdAttestorsRotate = &cobra.Command{
+		Use:   "rotate [name]",
+		Short: "Rotate a local attestor's key, migrating every client that trusts it to the new key",
+		Long: "Stages --signer as the attestor's nextSigner (generating or importing the key if asked), " +
+			"migrates each recorded attestation client tracking the attestor's chain to the new address, " +
+			"promotes the new key to the attestor's signer and checks attestor quorum. " +
+			"With --dual-sign it stops after staging so the attestor can be restarted serving both keys; " +
+			"rerun without it to finish. Reruns resume from the recorded state.",
+		Args: cobra.ExactArgs(1),
+		RunE: attestorsRotate,
+	}
+)
+
+func attestorsEvidence(cmd *cobra.Command, _ []string) error {
+	return relayerCall(cmd, relayerv2.RelayerApiServiceClient.ListAttestorEvidence, &relayerv2.ListAttestorEvidenceRequest{
+		IncludeCleared: flagAttestorsEvidenceIncludeCleared,
+	})
+}
+
+func attestorsEvidenceClear(cmd *cobra.Command, args []string) error {
+	return relayerCall(cmd, relayerv2.RelayerApiServiceClient.ClearAttestorEvidence, &relayerv2.ClearAttestorEvidenceRequest{
+		AttestorAddress: args[0],
+	})
+}
+
 // resolveAttestorToken resolves one attestor token: an attestors[].name or a
 // signers[] alias resolves through its key; anything else is passed through
 // verbatim as an address, whose format only the target driver can judge.
-func resolveAttestorToken(cfg config.Config, token string) (string, error) {
+func resolveAttestorToken(ctx context.Context, cfg config.Config, token string) (string, error) {
 	alias := token
 	if attestor, ok := cfg.AttestorByName(token); ok {
 		if attestor.Type == config.AttestorTypeRemote {
@@ -24,7 +91,7 @@ func resolveAttestorToken(cfg config.Config, token string) (string, error) {
 		alias = attestor.Signer
 	}
 	if sc, ok := cfg.Signer(alias); ok {
-		return signer.EVMAddressOf(sc)
+		return signer.AttestorAddressOf(ctx, sc)
 	}
 	return token, nil
 }
@@ -32,7 +99,7 @@ func resolveAttestorToken(cfg config.Config, token string) (string, error) {
 // attestorsForChain derives the default attestor set for clients tracking
 // chainID: every configured attestor for that chain, resolved to an
 // address. Errors when none are configured or any is unresolvable.
-func attestorsForChain(cfg config.Config, chainID string) ([]string, error) {
+func attestorsForChain(ctx context.Context, cfg config.Config, chainID string) ([]string, error) {
 	configured := cfg.AttestorsForChain(chainID)
 	if len(configured) == 0 {
 		return nil, errors.Errorf(
@@ -50,7 +117,7 @@ func attestorsForChain(cfg config.Config, chainID string) ([]string, error) {
 				attestor.Signer,
 			)
 		}
-		address, err := signer.EVMAddressOf(sc)
+		address, err := signer.AttestorAddressOf(ctx, sc)
 		if err != nil {
 			return nil, errors.Wrapf(err, "attestor %q", attestor.Name)
 		}
@@ -58,3 +125,335 @@ func attestorsForChain(cfg config.Config, chainID string) ([]string, error) {
 	}
 	return attestors, nil
 }
+
+// clientRotation is one attestation client whose attestor set a key
+// rotation changes.
+type clientRotation struct {
+	ChainID   string
+	ClientID  string
+	Attestors []string
+	Threshold uint8
+}
+
+func attestorsRotate(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	cfg, err := setupHomeWithConfig()
+	if err != nil {
+		return err
+	}
+
+	entry, ok := cfg.AttestorByName(args[0])
+	switch {
+	case !ok:
+		return errors.Errorf("attestor %q not found in config", args[0])
+	case entry.Type != config.AttestorTypeLocal:
+		return errors.Errorf("attestor %q is %s: only local attestors can be rotated", entry.Name, entry.Type)
+	}
+
+	var nextKey signer.LocalKey
+	if entry.NextSigner == "" {
+		if cfg, entry, nextKey, err = stageRotation(cfg, entry); err != nil {
+			return err
+		}
+
+		if flagAttestorsRotateDualSign && flagDeployDryRun {
+			slog.Info("Dry run: next key not staged", "attestor", entry.Name, "nextSigner", entry.NextSigner)
+			return nil
+		}
+
+		if flagAttestorsRotateDualSign {
+			slog.Info("Next key staged: restart the attestor to serve both keys, then rerun without --dual-sign",
+				"attestor", entry.Name, "nextSigner", entry.NextSigner, "nextName", entry.NextName())
+			return nil
+		}
+	} else if flagAttestorsRotateSigner != "" && flagAttestorsRotateSigner != entry.NextSigner {
+		return errors.Errorf(
+			"attestor %q is already rotating to signer %q: finish that rotation or remove its nextSigner",
+			entry.Name, entry.NextSigner,
+		)
+	}
+
+	oldAddress, err := attestorAddress(ctx, cfg, entry.Signer)
+	if err != nil {
+		return errors.Wrapf(err, "attestor %q", entry.Name)
+	}
+
+	newAddress, err := nextAttestorAddress(ctx, cfg, entry.NextSigner, nextKey)
+	if err != nil {
+		return errors.Wrapf(err, "attestor %q next signer", entry.Name)
+	}
+
+	manifests, err := loadManifests(cfg, flagDeployManifestDir)
+	if err != nil {
+		return err
+	}
+
+	rotations, err := rotationClients(manifests, entry.ChainID, oldAddress, newAddress, flagAttestorsRotateThreshold)
+	if err != nil {
+		return err
+	}
+
+	var steps []deploy.Step
+	for _, r := range rotations {
+		target, targetErr := newTarget(ctx, cfg, r.ChainID, flagDeployDeployer, true)
+		if targetErr != nil {
+			return errors.Wrapf(targetErr, "chain %s", r.ChainID)
+		}
+
+		steps = append(steps, deploy.AttestationSetSteps(
+			target, flagDeployManifestDir, r.ChainID, r.ClientID, r.Attestors, r.Threshold,
+		)...)
+	}
+
+	executed, err := planThenRun(ctx, steps)
+	if err != nil || !executed {
+		return err
+	}
+
+	cfg = promoteRotation(cfg, entry)
+	if err := storeConfig(cfg); err != nil {
+		return err
+	}
+
+	slog.Info("Attestor key rotated: restart the attestor to serve only the new key",
+		"attestor", entry.Name, "signer", entry.NextSigner, "address", newAddress)
+
+	clientSet, err := chains.NewClientSetFromConfig(cfg)
+	if err != nil {
+		return errors.Wrap(err, "chains")
+	}
+
+	return livevalidate.CheckAttestorQuorum(ctx, cfg, clientSet)
+}
+
+// stageRotation records --signer as entry's nextSigner, first generating or
+// importing its key when asked, and saves the config. It returns the key it
+// generated or imported, if any. Under --dry-run the rotation is staged in
+// memory only: neither the key nor the config is written.
+func stageRotation(
+	cfg config.Config,
+	entry config.AttestorConfig,
+) (config.Config, config.AttestorConfig, signer.LocalKey, error) {
+	alias := flagAttestorsRotateSigner
+	if alias == "" {
+		return cfg, entry, nil, errors.New("--signer is required to start a rotation")
+	}
+
+	var key signer.LocalKey
+	if flagAttestorsRotateGenerate || flagKeysImportKeystore != "" {
+		var err error
+		if key, err = rotationKey(entry.Scheme); err != nil {
+			return cfg, entry, nil, err
+		}
+
+		keyPath, err := signer.KeyFilePath(globalFlags.Home, alias)
+		if err != nil {
+			return cfg, entry, nil, err
+		}
+
+		if flagDeployDryRun {
+			if _, err = os.Stat(keyPath); err == nil {
+				return cfg, entry, nil, errors.Errorf("key already exists: %s", keyPath)
+			}
+		} else if err = storeKey(key, keyPath, keysSignerConfig(cfg, alias)); err != nil {
+			if errors.Is(err, os.ErrExist) {
+				return cfg, entry, nil, errors.Errorf("key already exists: %s", keyPath)
+			}
+			return cfg, entry, nil, err
+		}
+
+		if _, exists := cfg.Signer(alias); !exists {
+			cfg.Signers = append(cfg.Signers, keysSignerConfig(cfg, alias))
+		}
+	}
+
+	if _, ok := cfg.Signer(alias); !ok {
+		return cfg, entry, nil, errors.Errorf(
+			"signer %q not found in config: pass --generate or --keystore to create it", alias,
+		)
+	}
+
+	entry.NextSigner = alias
+	cfg = withAttestor(cfg, entry)
+	if err := cfg.Attestors.Validate(); err != nil {
+		return cfg, entry, nil, errors.Wrap(err, ".attestors")
+	}
+
+	if flagDeployDryRun {
+		return cfg, entry, key, nil
+	}
+
+	return cfg, entry, key, storeConfig(cfg)
+}
+
+// nextAttestorAddress derives the address the next signer alias attests
+// under, from key when the rotation just generated or imported it: a dry run
+// has not written it to the file alias refers to.
+func nextAttestorAddress(ctx context.Context, cfg config.Config, alias string, key signer.LocalKey) (string, error) {
+	if key != nil {
+		return signer.AttestorAddress(key)
+	}
+
+	return attestorAddress(ctx, cfg, alias)
+}
+
+// rotationKey generates the next key of the type scheme signs with, or
+// imports an ECDSA key from --keystore.
+func rotationKey(scheme config.AttestationScheme) (signer.LocalKey, error) {
+	keyType := keyfile.ECDSA
+	if scheme == config.AttestationSchemeEdDSA {
+		keyType = keyfile.EDDSA
+	}
+
+	if flagKeysImportKeystore == "" {
+		return signer.GenerateLocalKey(keyType)
+	}
+
+	if keyType != keyfile.ECDSA {
+		return nil, errors.Errorf("--keystore holds an ecdsa key, %s attestors sign with %s", scheme, keyType)
+	}
+
+	privateKey, err := importedPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	return signer.NewLocalSecp256k1Signer(privateKey)
+}
+
+// promoteRotation makes entry's next signer its signer.
+func promoteRotation(cfg config.Config, entry config.AttestorConfig) config.Config {
+	entry.Signer, entry.NextSigner = entry.NextSigner, ""
+	return withAttestor(cfg, entry)
+}
+
+// withAttestor returns cfg with the local attestor named entry.Name
+// replaced by entry.
+func withAttestor(cfg config.Config, entry config.AttestorConfig) config.Config {
+	cfg.Attestors = slices.Clone(cfg.Attestors)
+	for i, a := range cfg.Attestors {
+		if a.Name == entry.Name && a.Type == config.AttestorTypeLocal {
+			cfg.Attestors[i] = entry
+		}
+	}
+
+	return cfg
+}
+
+func storeConfig(cfg config.Config) error {
+	configPath, err := globalFlags.ConfigPath()
+	if err != nil {
+		return err
+	}
+
+	return cfg.StoreToFileWithComments(configPath)
+}
+
+// attestorAddress derives the address the signer alias attests under.
+func attestorAddress(ctx context.Context, cfg config.Config, alias string) (string, error) {
+	sc, ok := cfg.Signer(alias)
+	if !ok {
+		return "", errors.Errorf("unknown signer %q", alias)
+	}
+
+	return signer.AttestorAddressOf(ctx, sc)
+}
+
+// loadManifests loads every recorded deployment manifest in dir.
+func loadManifests(cfg config.Config, dir string) ([]*manifest.Manifest, error) {
+	chainIDs, err := statusChains(cfg, dir, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var manifests []*manifest.Manifest
+	for _, chainID := range chainIDs {
+		m, err := manifest.Load(dir, chainID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "manifest for chain %s", chainID)
+		}
+		if m != nil {
+			manifests = append(manifests, m)
+		}
+	}
+
+	return manifests, nil
+}
+
+// rotationClients lists the recorded attestation clients tracking
+// watchedChainID that trust oldAddress, or already trust newAddress from an
+// interrupted rotation, with oldAddress replaced by newAddress. A zero
+// threshold keeps each client's recorded threshold.
+func rotationClients(
+	manifests []*manifest.Manifest,
+	watchedChainID, oldAddress, newAddress string,
+	threshold uint8,
+) ([]clientRotation, error) {
+	var rotations []clientRotation
+	for _, m := range manifests {
+		for _, c := range m.Clients {
+			if c.Type != deploy.ClientTypeAttestation || c.CounterpartyChainID != watchedChainID {
+				continue
+			}
+
+			recorded := recordedAttestors(c)
+
+			hasOld := slices.ContainsFunc(recorded, func(a string) bool { return strings.EqualFold(a, oldAddress) })
+			hasNew := slices.ContainsFunc(recorded, func(a string) bool { return strings.EqualFold(a, newAddress) })
+			if !hasOld && !hasNew {
+				continue
+			}
+
+			attestors := make([]string, 0, len(recorded))
+			for _, a := range recorded {
+				switch {
+				case strings.EqualFold(a, oldAddress) && !hasNew:
+					attestors = append(attestors, newAddress)
+				case !strings.EqualFold(a, oldAddress):
+					attestors = append(attestors, a)
+				}
+			}
+
+			clientThreshold := threshold
+			if clientThreshold == 0 {
+				recordedThreshold, ok := c.Params["threshold"].(float64)
+				if !ok {
+					return nil, errors.Errorf(
+						"client %q on chain %s has no recorded threshold: pass --threshold", c.ClientID, m.ChainID,
+					)
+				}
+				clientThreshold = uint8(recordedThreshold)
+			}
+
+			rotations = append(rotations, clientRotation{
+				ChainID:   m.ChainID,
+				ClientID:  c.ClientID,
+				Attestors: attestors,
+				Threshold: clientThreshold,
+			})
+		}
+	}
+
+	if len(rotations) == 0 {
+		return nil, errors.Errorf(
+			"no recorded attestation client tracking chain %s trusts %s or %s", watchedChainID, oldAddress, newAddress,
+		)
+	}
+
+	return rotations, nil
+}
+
+// recordedAttestors reads a manifest client's attestor addresses.
+func recordedAttestors(c manifest.Client) []string {
+	raw, _ := c.Params["attestors"].([]any)
+
+	attestors := make([]string, 0, len(raw))
+	for _, a := range raw {
+		if address, ok := a.(string); ok {
+			attestors = append(attestors, address)
+		}
+	}
+
+	return attestors
+}
@@ -3,12 +3,15 @@
 package main
 
 import (
+	"context"
+	"os"
 	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/ibc/link/internal/config"
+	"github.com/cosmos/ibc/link/internal/deploy/manifest"
 	"github.com/cosmos/ibc/link/internal/service/signer"
 	"github.com/cosmos/ibc/link/keyfile"
 )
@@ -40,56 +43,166 @@ func attestorFixture(t *testing.T) (config.Config, string) {
 }
 
 func TestResolveAttestorToken(t *testing.T) {
+	ctx := context.Background()
 	cfg, address := attestorFixture(t)
 
 	// anything that is not an alias passes through verbatim: address
 	// formats are the target driver's to judge
-	got, err := resolveAttestorToken(cfg, "0x00000000000000000000000000000000000000aa")
+	got, err := resolveAttestorToken(ctx, cfg, "0x00000000000000000000000000000000000000aa")
 	require.NoError(t, err)
 	require.Equal(t, "0x00000000000000000000000000000000000000aa", got)
 
 	// attestor name resolves through its signer
-	got, err = resolveAttestorToken(cfg, "watcher-2")
+	got, err = resolveAttestorToken(ctx, cfg, "watcher-2")
 	require.NoError(t, err)
 	require.Equal(t, address, got)
 
 	// signer alias resolves directly
-	got, err = resolveAttestorToken(cfg, "watcher-key")
+	got, err = resolveAttestorToken(ctx, cfg, "watcher-key")
 	require.NoError(t, err)
 	require.Equal(t, address, got)
 
 	// an unknown token is treated as an address, not an error
-	got, err = resolveAttestorToken(cfg, "nonsense")
+	got, err = resolveAttestorToken(ctx, cfg, "nonsense")
 	require.NoError(t, err)
 	require.Equal(t, "nonsense", got)
 
 	// a token that IS a known alias must resolve or fail — never fall
-	// through to address passthrough
-	_, err = resolveAttestorToken(cfg, "kms")
-	require.ErrorContains(t, err, "remote signer")
+	// through to address passthrough; "kms" resolves through its KMS,
+	// which is unreachable here
+	_, err = resolveAttestorToken(ctx, cfg, "kms")
+	require.ErrorContains(t, err, "create remote signer")
 
 	// a remote attestor's address isn't known statically — fail clearly
 	// rather than falling through to address passthrough
-	_, err = resolveAttestorToken(cfg, "watcher-remote")
+	_, err = resolveAttestorToken(ctx, cfg, "watcher-remote")
 	require.ErrorContains(t, err, `attestor "watcher-remote" is remote`)
 }
 
 func TestAttestorsForChain(t *testing.T) {
+	ctx := context.Background()
 	cfg, address := attestorFixture(t)
 
-	got, err := attestorsForChain(cfg, "2")
+	got, err := attestorsForChain(ctx, cfg, "2")
 	require.NoError(t, err)
 	require.Equal(t, []string{address}, got)
 
-	// chain 9's only attestor resolves through a remote signer
-	_, err = attestorsForChain(cfg, "9")
-	require.ErrorContains(t, err, "remote signer")
+	// chain 9's only attestor resolves through a remote signer whose KMS
+	// is unreachable
+	_, err = attestorsForChain(ctx, cfg, "9")
+	require.ErrorContains(t, err, "create remote signer")
 
 	// nothing configured for chain 3
-	_, err = attestorsForChain(cfg, "3")
+	_, err = attestorsForChain(ctx, cfg, "3")
 	require.ErrorContains(t, err, "no attestors configured for chain 3")
 }
 
+func TestRotationClients(t *testing.T) {
+	client := func(id, counterpartyChain string, attestors ...any) manifest.Client {
+		return manifest.Client{
+			ClientID:            id,
+			Type:                "attestation",
+			CounterpartyChainID: counterpartyChain,
+			Params:              map[string]any{"attestors": attestors, "threshold": float64(2)},
+		}
+	}
+
+	m := manifest.New("1", "evm")
+	m.Clients = []manifest.Client{
+		client("tracks-2", "2", "0xOLD", "0xb"),
+		client("tracks-3", "3", "0xold", "0xb"),
+		client("other-set", "2", "0xc", "0xd"),
+		client("migrated", "2", "0xnew", "0xb"),
+	}
+
+	t.Run("replacesOldAddress", func(t *testing.T) {
+		got, err := rotationClients([]*manifest.Manifest{m}, "2", "0xold", "0xnew", 0)
+		require.NoError(t, err)
+		require.Equal(t, []clientRotation{
+			{ChainID: "1", ClientID: "tracks-2", Attestors: []string{"0xnew", "0xb"}, Threshold: 2},
+			{ChainID: "1", ClientID: "migrated", Attestors: []string{"0xnew", "0xb"}, Threshold: 2},
+		}, got)
+	})
+
+	t.Run("thresholdOverride", func(t *testing.T) {
+		got, err := rotationClients([]*manifest.Manifest{m}, "3", "0xold", "0xnew", 1)
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		require.Equal(t, uint8(1), got[0].Threshold)
+	})
+
+	t.Run("noTrustingClient", func(t *testing.T) {
+		_, err := rotationClients([]*manifest.Manifest{m}, "4", "0xold", "0xnew", 0)
+		require.ErrorContains(t, err, "no recorded attestation client tracking chain 4")
+	})
+}
+
+func TestPromoteRotation(t *testing.T) {
+	cfg, _ := attestorFixture(t)
+	entry, ok := cfg.AttestorByName("watcher-2")
+	require.True(t, ok)
+	entry.NextSigner = "watcher-key-2"
+
+	got := promoteRotation(withAttestor(cfg, entry), entry)
+
+	promoted, ok := got.AttestorByName("watcher-2")
+	require.True(t, ok)
+	require.Equal(t, "watcher-key-2", promoted.Signer)
+	require.Empty(t, promoted.NextSigner)
+
+	original, _ := cfg.AttestorByName("watcher-2")
+	require.Equal(t, "watcher-key", original.Signer, "the input config is not mutated")
+}
+
+func TestStageRotationDryRun(t *testing.T) {
+	cfg, _ := attestorFixture(t)
+	home := t.TempDir()
+	homeFlag, signerFlag, generate, dryRun := globalFlags.Home, flagAttestorsRotateSigner, flagAttestorsRotateGenerate,
+		flagDeployDryRun
+	t.Cleanup(func() {
+		globalFlags.Home, flagAttestorsRotateSigner, flagAttestorsRotateGenerate, flagDeployDryRun = homeFlag,
+			signerFlag, generate, dryRun
+	})
+	globalFlags.Home, flagAttestorsRotateSigner, flagAttestorsRotateGenerate = home, "watcher-key-2", true
+	require.NoError(t, storeConfig(cfg))
+	configPath, err := globalFlags.ConfigPath()
+	require.NoError(t, err)
+	stored, err := os.ReadFile(configPath)
+	require.NoError(t, err)
+	entry, _ := cfg.AttestorByName("watcher-2")
+
+	flagDeployDryRun = true
+	staged, stagedEntry, key, err := stageRotation(cfg, entry)
+	require.NoError(t, err)
+	require.Equal(t, "watcher-key-2", stagedEntry.NextSigner)
+	_, ok := staged.Signer("watcher-key-2")
+	require.True(t, ok, "the rotation is staged in memory")
+	// the new address comes from the key, which is not on disk
+	address, err := nextAttestorAddress(context.Background(), staged, "watcher-key-2", key)
+	require.NoError(t, err)
+	want, err := signer.PublicKeyToEVMAddress(key.PublicKey())
+	require.NoError(t, err)
+	require.Equal(t, want, address)
+
+	// neither the config nor the key directory changed
+	after, err := os.ReadFile(configPath)
+	require.NoError(t, err)
+	require.Equal(t, stored, after)
+	keyPath, err := signer.KeyFilePath(home, "watcher-key-2")
+	require.NoError(t, err)
+	_, err = os.Stat(keyPath)
+	require.True(t, os.IsNotExist(err))
+
+	flagDeployDryRun = false
+	_, _, _, err = stageRotation(cfg, entry)
+	require.NoError(t, err)
+	_, err = os.Stat(keyPath)
+	require.NoError(t, err)
+	after, err = os.ReadFile(configPath)
+	require.NoError(t, err)
+	require.NotEqual(t, stored, after)
+}
+
 func TestDefaultClientID(t *testing.T) {
 	require.Equal(t, "link-31337-31338", defaultClientID("31338", "31337"))
 	require.Equal(t, "link-31337-31338", defaultClientID("31337", "31338"))
@@ -5,22 +5,26 @@ package main
 import (
 	"bufio"
 	"context"
-	"encoding/hex"
 	"fmt"
 	"log/slog"
+	"math"
 	"os"
-	"path/filepath"
+	"slices"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
+	"github.com/cosmos/ibc/link/internal/chains"
 	"github.com/cosmos/ibc/link/internal/config"
 	"github.com/cosmos/ibc/link/internal/deploy"
+	"github.com/cosmos/ibc/link/internal/deploy/cosmos"
 	"github.com/cosmos/ibc/link/internal/deploy/evm"
 	"github.com/cosmos/ibc/link/internal/deploy/manifest"
+	"github.com/cosmos/ibc/link/internal/livevalidate"
 	"github.com/cosmos/ibc/link/internal/service/signer"
 	"github.com/cosmos/ibc/link/keyfile"
 )
@@ -29,6 +33,8 @@ var (
 	flagDeployManifestDir     string
 	flagDeployDeployer        string
 	flagDeployDryRun          bool
+	flagDeploySalt            string
+	flagDeployCreate2Factory  string
 	flagDeployYes             bool
 	flagDeployChain           string
 	flagDeployCounterparty    string
@@ -53,6 +59,20 @@ var (
 	flagDeployBridgeClientID string
 	flagDeployBridgeCtorA    string
 	flagDeployBridgeCtorB    string
+
+	flagDeployRelayers    []string
+	flagDeployRelayerRole uint64
+	flagDeployAdmin       string
+	flagDeployAdminDelay  time.Duration
+
+	flagDeployComponent  string
+	flagDeployUpgradeIFT string
+	flagDeployRollback   bool
+
+	flagDeployUpdateClientID  string
+	flagDeployUpdateAdd       []string
+	flagDeployUpdateRemove    []string
+	flagDeployUpdateThreshold uint8
 )
 
 var (
@@ -73,6 +93,14 @@ var (
 		RunE:  deployClient,
 	}
 
+	cmdDeployClientUpdate = &cobra.Command{
+		Use:   "update",
+		Short: "Add or remove attestors of an attestation client, or change its threshold",
+		Long: "Computes the new attestor set from the client's live one, migrates the client to it " +
+			"like `attestors rotate` does, checks the chain reports the new set and checks attestor quorum.",
+		RunE: deployClientUpdate,
+	}
+
 	cmdDeployStatus = &cobra.Command{
 		Use:   useStatus,
 		Short: "Verify recorded deployments against live chain state",
@@ -99,12 +127,30 @@ var (
 		RunE:  deployGMP,
 	}
 
+	cmdDeployTransfer = &cobra.Command{
+		Use:   useTransfer,
+		Short: "Deploy the ICS20 transfer app on one chain",
+		RunE:  deployTransfer,
+	}
+
 	cmdDeployIFT = &cobra.Command{
 		Use:   useIFT,
 		Short: "Deploy an IFT token on one chain",
 		RunE:  deployIFT,
 	}
 
+	cmdDeployRoles = &cobra.Command{
+		Use:   "roles",
+		Short: "Restrict relaying to permissioned relayers and hand the admin role to a multisig or timelock",
+		RunE:  deployRoles,
+	}
+
+	cmdDeployUpgrade = &cobra.Command{
+		Use:   "upgrade",
+		Short: "Upgrade the router, GMP or transfer app, or IFT tokens on one chain, or roll them back",
+		RunE:  deployUpgrade,
+	}
+
 	cmdDeployIFTBridge = &cobra.Command{
 		Use:   "ift-bridge",
 		Short: "Register both sides of an IFT bridge between two chains' tokens",
@@ -120,41 +166,30 @@ func resolveDeployerAlias(chain config.ChainConfig, flagAlias string) string {
 	return chain.Deployer
 }
 
-// deployerKeyHex resolves a local ECDSA signer's private key for the target.
-func deployerKeyHex(cfg config.Config, alias string) (string, error) {
+// deployerSigner opens the deployer's ECDSA signer, of any type: remote and
+// pkcs11 deployers sign through their KMS or token like relayers do.
+func deployerSigner(ctx context.Context, cfg config.Config, alias string) (signer.Signer, error) {
 	if alias == "" {
-		return "", errors.New("no deployer configured: set chains[].deployer or pass --deployer")
-	}
-	var sc *config.SignerConfig
-	for i := range cfg.Signers {
-		if cfg.Signers[i].Alias == alias {
-			sc = &cfg.Signers[i]
-			break
-		}
-	}
-	if sc == nil {
-		return "", errors.Errorf("deployer signer %q not found in config", alias)
-	}
-	if sc.Type != config.SignerLocal {
-		return "", errors.Errorf("deployer signer %q must be a local key (deployment tooling needs the raw key)", alias)
+		return nil, errors.New("no deployer configured: set chains[].deployer or pass --deployer")
 	}
-	path, err := config.ExpandHome(sc.File)
-	if err != nil {
-		return "", err
+	sc, ok := cfg.Signer(alias)
+	if !ok {
+		return nil, errors.Errorf("deployer signer %q not found in config", alias)
 	}
-	key, err := signer.LocalKeyFromFile(config.KeyFileFallbacks(path)...)
+	s, _, err := signer.NewSignerFromConfig(ctx, sc)
 	if err != nil {
-		return "", err
+		return nil, errors.Wrapf(err, "deployer signer %q", alias)
 	}
-	if key.Type() != keyfile.ECDSA {
-		return "", errors.Errorf("deployer signer %q must be an ecdsa key", alias)
+	if s.Type() != keyfile.ECDSA {
+		signer.Close(s)
+		return nil, errors.Errorf("deployer signer %q must be an ecdsa key", alias)
 	}
-	return hex.EncodeToString(key.PrivateKey()), nil
+	return s, nil
 }
 
 // newTarget builds the deploy target for a chain based on its configured
-// type. When needSigner is false, the target is built read-only: no local
-// deployer key is resolved.
+// type. When needSigner is false, the target is built read-only: no
+// deployer signer is opened.
 func newTarget(
 	ctx context.Context,
 	cfg config.Config,
@@ -165,27 +200,59 @@ func newTarget(
 	if !ok {
 		return nil, errors.Errorf("chain %q not declared in config", chainID)
 	}
-	var keyHex string
-	if needSigner {
+	opts := evm.Options{ChainID: chainID}
+	var deployer signer.Signer
+	if chain.Type() == config.ChainTypeCosmos && (flagDeployExport != "" || flagDeploySalt != "") {
+		return nil, errors.Errorf("chain %q is a cosmos chain: --export and --salt apply to evm chains only", chainID)
+	}
+	if needSigner && flagDeployExport != "" {
+		from, err := exportSender(ctx, cfg, chain, deployerFlag)
+		if err != nil {
+			return nil, err
+		}
+		opts.Export, opts.From = exportRecorder(chainID, from), from
+	} else if needSigner {
 		alias := resolveDeployerAlias(chain, deployerFlag)
 		var err error
-		keyHex, err = deployerKeyHex(cfg, alias)
+		deployer, err = deployerSigner(ctx, cfg, alias)
 		if err != nil {
 			return nil, err
 		}
 	}
 	switch chain.Type() {
 	case config.ChainTypeEVM:
-		return evm.New(ctx, evm.Options{
-			ChainID:        chainID,
-			RPCURL:         chain.EVM.RPC,
-			DeployerKeyHex: keyHex,
+		opts.Salt, opts.Factory = create2Settings(chain)
+		opts.RPCURL, opts.Deployer = chain.EVM.RPC, deployer
+		return evm.New(ctx, opts)
+	case config.ChainTypeCosmos:
+		return cosmos.New(ctx, cosmos.Options{
+			ChainID:       chainID,
+			GRPC:          chain.Cosmos.GRPC,
+			AccountPrefix: chain.Cosmos.AccountPrefix,
+			GasPrice:      chain.Cosmos.GasPrice,
+			Deployer:      deployer,
 		})
 	default:
 		return nil, errors.Errorf("chain %q has no supported deployment target", chainID)
 	}
 }
 
+// create2Settings is the salt and factory of chain's deterministic
+// deployments, the flags overriding the config. An empty salt deploys by
+// plain creation.
+func create2Settings(chain config.ChainConfig) (salt, factory string) {
+	if chain.Create2 != nil {
+		salt, factory = chain.Create2.Salt, chain.Create2.Factory
+	}
+	if flagDeploySalt != "" {
+		salt = flagDeploySalt
+	}
+	if flagDeployCreate2Factory != "" {
+		factory = flagDeployCreate2Factory
+	}
+	return salt, factory
+}
+
 func confirmOrAbort(results []deploy.StepResult) error {
 	if flagDeployYes || flagDeployDryRun {
 		return nil
@@ -210,23 +277,34 @@ func confirmOrAbort(results []deploy.StepResult) error {
 	return nil
 }
 
-// planThenRun previews steps in dry-run mode, confirms, then executes.
-func planThenRun(ctx context.Context, steps []deploy.Step) error {
+// planThenRun previews steps in dry-run mode, confirms, then executes. It
+// reports whether the steps ran against the chain: not under --dry-run or
+// --export, after which nothing the plan changes can be read back.
+func planThenRun(ctx context.Context, steps []deploy.Step) (bool, error) {
 	log := slog.Default()
 	preview, err := deploy.RunSteps(ctx, log, true, steps)
 	if err != nil {
-		return err
+		return false, err
 	}
 	if flagDeployDryRun {
-		return config.PrintJSON(preview)
+		return false, config.PrintJSON(preview)
+	}
+	if flagDeployExport != "" {
+		return false, exportSteps(ctx, steps)
 	}
 	if confirmErr := confirmOrAbort(preview); confirmErr != nil {
-		return confirmErr
+		return false, confirmErr
 	}
 	results, err := deploy.RunSteps(ctx, log, false, steps)
 	if printErr := config.PrintJSON(results); printErr != nil {
-		return printErr
+		return false, printErr
 	}
+	return err == nil, err
+}
+
+// planAndRun is planThenRun for commands with nothing to do after the run.
+func planAndRun(ctx context.Context, steps []deploy.Step) error {
+	_, err := planThenRun(ctx, steps)
 	return err
 }
 
@@ -242,36 +320,92 @@ func deployCore(cmd *cobra.Command, _ []string) error {
 	if err != nil {
 		return err
 	}
-	return planThenRun(cmd.Context(), deploy.CoreSteps(target, flagDeployManifestDir, flagDeployChain))
+	return planAndRun(cmd.Context(), deploy.CoreSteps(target, flagDeployManifestDir, flagDeployChain))
 }
 
-// clientSpec assembles the ClientSpec for --chain tracking --counterparty-chain,
-// defaulting trusted state from the counterparty chain head.
+// clientParams are the inputs of one client deployment, from `deploy client`
+// flags or a topology connection. Zero values take the defaults clientSpec
+// documents.
+type clientParams struct {
+	ChainID              string
+	CounterpartyChainID  string
+	ClientID             string
+	CounterpartyClientID string
+	Type                 string
+	Attestors            []string
+	Threshold            uint8
+	Height               uint64
+	Timestamp            uint64
+}
+
+// clientSpec assembles the ClientSpec for --chain tracking --counterparty-chain
+// from the `deploy client` flags.
 func clientSpec(
 	ctx context.Context,
 	cfg config.Config,
 	counterpartyTarget deploy.Target,
 	chainID, counterpartyChainID string,
 ) (deploy.ClientSpec, error) {
+	return newClientSpec(ctx, cfg, counterpartyTarget, clientParams{
+		ChainID:              chainID,
+		CounterpartyChainID:  counterpartyChainID,
+		ClientID:             flagDeployClientID,
+		CounterpartyClientID: flagDeployCounterpartyCID,
+		Type:                 flagDeployClientType,
+		Attestors:            flagDeployAttestors,
+		Threshold:            flagDeployThreshold,
+		Height:               flagDeployHeight,
+		Timestamp:            flagDeployTimestamp,
+	})
+}
+
+// newClientSpec assembles the ClientSpec for p, defaulting client ids to the
+// shared connection name, attestors to the ones configured for the
+// counterparty chain and trusted state to the counterparty chain head.
+func newClientSpec(
+	ctx context.Context,
+	cfg config.Config,
+	counterpartyTarget deploy.Target,
+	p clientParams,
+) (deploy.ClientSpec, error) {
+	chainID, counterpartyChainID := p.ChainID, p.CounterpartyChainID
 	// both ids default to one shared name: client ids are per-chain
 	// namespaces, so the same name on each side unambiguously identifies
 	// the connection, and the mirrored `deploy client` invocation derives
 	// the identical pair
-	clientID := flagDeployClientID
+	clientID := p.ClientID
 	if clientID == "" {
 		clientID = defaultClientID(chainID, counterpartyChainID)
 	}
 	if !deploy.ValidClientID(clientID) {
 		return deploy.ClientSpec{}, errors.Errorf("invalid client id %q", clientID)
 	}
-	counterpartyClientID := flagDeployCounterpartyCID
+	counterpartyClientID := p.CounterpartyClientID
+	if counterpartyClientID == "" && deploy.AssignsClientIDs(counterpartyTarget) {
+		// the counterparty named its client on creation: find the one its
+		// manifest records tracking this side
+		m, err := manifest.Load(flagDeployManifestDir, counterpartyChainID)
+		if err != nil {
+			return deploy.ClientSpec{}, err
+		}
+		var client manifest.Client
+		if m != nil {
+			client, _ = m.ClientTracking(chainID, clientID)
+		}
+		if client.ClientID == "" {
+			return deploy.ClientSpec{}, errors.Errorf(
+				"client ids on chain %s are assigned on creation: deploy its client tracking chain %s first, "+
+					"or pass --counterparty-client-id", counterpartyChainID, chainID)
+		}
+		counterpartyClientID = client.ClientID
+	}
 	if counterpartyClientID == "" {
 		counterpartyClientID = defaultClientID(chainID, counterpartyChainID)
 	}
 	var attestors []string
-	if len(flagDeployAttestors) > 0 {
-		for _, token := range flagDeployAttestors {
-			address, err := resolveAttestorToken(cfg, token)
+	if len(p.Attestors) > 0 {
+		for _, token := range p.Attestors {
+			address, err := resolveAttestorToken(ctx, cfg, token)
 			if err != nil {
 				return deploy.ClientSpec{}, err
 			}
@@ -279,12 +413,12 @@ func clientSpec(
 		}
 	} else {
 		var err error
-		attestors, err = attestorsForChain(cfg, counterpartyChainID)
+		attestors, err = attestorsForChain(ctx, cfg, counterpartyChainID)
 		if err != nil {
 			return deploy.ClientSpec{}, err
 		}
 	}
-	height, timestamp := flagDeployHeight, flagDeployTimestamp
+	height, timestamp := p.Height, p.Timestamp
 	if height == 0 || timestamp == 0 {
 		h, ts, err := counterpartyTarget.Head(ctx)
 		if err != nil {
@@ -301,22 +435,24 @@ func clientSpec(
 	}
 	spec := deploy.ClientSpec{
 		ClientID:             clientID,
-		Type:                 flagDeployClientType,
+		Type:                 p.Type,
 		CounterpartyChainID:  counterpartyChainID,
 		CounterpartyClientID: counterpartyClientID,
+		// the counterparty's prefix, under which its commitments are proven
+		CounterpartyMerklePrefix: counterpartyTarget.MerklePrefix(),
 	}
-	switch flagDeployClientType {
+	switch p.Type {
 	case deploy.ClientTypeAttestation:
 		spec.Params = deploy.AttestationParams{
 			Attestors:        attestors,
-			Threshold:        flagDeployThreshold,
+			Threshold:        p.Threshold,
 			InitialHeight:    height,
 			InitialTimestamp: timestamp,
 		}
 	default:
 		return deploy.ClientSpec{}, fmt.Errorf(
 			"cannot construct client spec for unknown client type %s",
-			flagDeployClientType,
+			p.Type,
 		)
 	}
 	return spec, nil
@@ -350,7 +486,143 @@ func deployClient(cmd *cobra.Command, _ []string) error {
 	if err != nil {
 		return err
 	}
-	return planThenRun(cmd.Context(), deploy.ClientSteps(target, flagDeployManifestDir, flagDeployChain, spec))
+	return planAndRun(cmd.Context(), deploy.ClientSteps(target, flagDeployManifestDir, flagDeployChain, spec))
+}
+
+func deployClientUpdate(cmd *cobra.Command, _ []string) error {
+	ctx := cmd.Context()
+	cfg, err := setupHomeWithConfig()
+	if err != nil {
+		return err
+	}
+	if flagDeployChain == "" {
+		return errors.New("--chain is required")
+	}
+	m, err := manifest.Load(flagDeployManifestDir, flagDeployChain)
+	if err != nil {
+		return err
+	}
+	if m == nil {
+		return errors.Errorf("no deployment recorded for chain %s", flagDeployChain)
+	}
+	recorded, ok := m.Client(flagDeployUpdateClientID)
+	if !ok {
+		return errors.Errorf("client %q not recorded for chain %s", flagDeployUpdateClientID, flagDeployChain)
+	}
+	if recorded.Type != deploy.ClientTypeAttestation {
+		return errors.Errorf("client %q is %s: only attestation clients have an attestor set",
+			recorded.ClientID, recorded.Type)
+	}
+
+	add, err := resolveAttestorTokens(ctx, cfg, flagDeployUpdateAdd)
+	if err != nil {
+		return err
+	}
+	remove, err := resolveAttestorTokens(ctx, cfg, flagDeployUpdateRemove)
+	if err != nil {
+		return err
+	}
+
+	clientSet, err := chains.NewClientSetFromConfig(cfg)
+	if err != nil {
+		return errors.Wrap(err, "chains")
+	}
+	chainClient, ok := clientSet.Get(flagDeployChain)
+	if !ok {
+		return errors.Errorf("chain %q not declared in config", flagDeployChain)
+	}
+	live, liveThreshold, err := chainClient.GetAttestationSet(ctx, recorded.ClientID)
+	if err != nil {
+		return errors.Wrapf(err, "get attestation set of client %s", recorded.ClientID)
+	}
+	if !deploy.SameAddresses(live, recordedAttestors(recorded)) {
+		slog.Warn("Manifest records a different attestor set than the chain, updating the live one",
+			"client", recorded.ClientID, "recorded", recordedAttestors(recorded), "live", live)
+	}
+
+	attestors, threshold, err := updatedAttestorSet(live, liveThreshold, add, remove, flagDeployUpdateThreshold)
+	if err != nil {
+		return errors.Wrapf(err, "client %s", recorded.ClientID)
+	}
+
+	target, err := newTarget(ctx, cfg, flagDeployChain, flagDeployDeployer, true)
+	if err != nil {
+		return err
+	}
+	steps := deploy.AttestationSetSteps(target, flagDeployManifestDir, flagDeployChain, recorded.ClientID,
+		attestors, threshold)
+	executed, err := planThenRun(ctx, steps)
+	if err != nil || !executed {
+		return err
+	}
+
+	// read back through the relayer's chain client, not the deploy target
+	live, liveThreshold, err = chainClient.GetAttestationSet(ctx, recorded.ClientID)
+	if err != nil {
+		return errors.Wrapf(err, "get attestation set of client %s", recorded.ClientID)
+	}
+	if liveThreshold != threshold || !deploy.SameAddresses(live, attestors) {
+		return errors.Errorf("client %s reports attestors %v with threshold %d after the update, want %v with %d",
+			recorded.ClientID, live, liveThreshold, attestors, threshold)
+	}
+
+	return livevalidate.CheckAttestorQuorum(ctx, cfg, clientSet)
+}
+
+// resolveAttestorTokens resolves each token with resolveAttestorToken.
+func resolveAttestorTokens(ctx context.Context, cfg config.Config, tokens []string) ([]string, error) {
+	addresses := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		address, err := resolveAttestorToken(ctx, cfg, token)
+		if err != nil {
+			return nil, errors.Wrapf(err, "attestor %q", token)
+		}
+		addresses = append(addresses, address)
+	}
+	return addresses, nil
+}
+
+// updatedAttestorSet applies add and remove to the live attestor set, keeping
+// its order, and threshold to its threshold, 0 keeping the live one. Adding
+// a member or removing a non-member changes nothing, so a rerun computes the
+// set an earlier run already applied.
+func updatedAttestorSet(
+	live []string,
+	liveThreshold uint8,
+	add, remove []string,
+	threshold uint8,
+) ([]string, uint8, error) {
+	contains := func(set []string, address string) bool {
+		return slices.ContainsFunc(set, func(a string) bool { return strings.EqualFold(a, address) })
+	}
+	for _, a := range add {
+		if contains(remove, a) {
+			return nil, 0, errors.Errorf("attestor %s both added and removed", a)
+		}
+	}
+
+	attestors := make([]string, 0, len(live)+len(add))
+	for _, a := range live {
+		if !contains(remove, a) {
+			attestors = append(attestors, a)
+		}
+	}
+	for _, a := range add {
+		if !contains(attestors, a) {
+			attestors = append(attestors, a)
+		}
+	}
+
+	if threshold == 0 {
+		threshold = liveThreshold
+	}
+	switch {
+	case len(attestors) == 0:
+		return nil, 0, errors.New("no attestors left")
+	case int(threshold) > len(attestors):
+		return nil, 0, errors.Errorf("threshold %d exceeds the %d attestors", threshold, len(attestors))
+	}
+	return attestors, threshold, nil
 }
 
 // statusChains resolves which chains status reports on: an explicit --chain
@@ -369,12 +641,11 @@ func statusChains(cfg config.Config, manifestDir, explicit string) ([]string, er
 		seen[chain.ChainID] = struct{}{}
 		chains = append(chains, chain.ChainID)
 	}
-	matches, err := filepath.Glob(filepath.Join(manifestDir, "*.json"))
+	recorded, err := manifestChains(manifestDir)
 	if err != nil {
 		return nil, err
 	}
-	for _, path := range matches {
-		id := strings.TrimSuffix(filepath.Base(path), ".json")
+	for _, id := range recorded {
 		if _, ok := seen[id]; !ok {
 			chains = append(chains, id)
 		}
@@ -462,7 +733,12 @@ func deployShow(_ *cobra.Command, args []string) error {
 
 // attestorsFromClient projects one client's on-chain attestor addresses into
 // attestors
-func attestorsFromClient(cfg config.Config, c manifest.Client, watchedChainID string) config.Attestors {
+func attestorsFromClient(
+	ctx context.Context,
+	cfg config.Config,
+	c manifest.Client,
+	watchedChainID string,
+) config.Attestors {
 	addresses, _ := c.Params["attestors"].([]any)
 
 	out := make(config.Attestors, 0, len(addresses))
@@ -471,7 +747,7 @@ func attestorsFromClient(cfg config.Config, c manifest.Client, watchedChainID st
 		if !ok {
 			continue
 		}
-		alias, _ := signerAliasForAddress(cfg, address)
+		alias, _ := signerAliasForAddress(ctx, cfg, address)
 		out = append(out, config.AttestorConfig{
 			ChainID: watchedChainID,
 			Name:    fmt.Sprintf("attestor-%s-%s", watchedChainID, address),
@@ -500,14 +776,12 @@ func appendUniqueAttestors(
 	return existing
 }
 
-// signerAliasForAddress finds the local signer in cfg whose derived EVM
-// address matches address, case-insensitively.
-func signerAliasForAddress(cfg config.Config, address string) (string, bool) {
+// signerAliasForAddress finds the signer in cfg whose derived attestor
+// address matches address, case-insensitively. Signers whose address can't
+// be derived, such as one behind an unreachable KMS, are skipped.
+func signerAliasForAddress(ctx context.Context, cfg config.Config, address string) (string, bool) {
 	for _, sc := range cfg.Signers {
-		if sc.Type != config.SignerLocal {
-			continue
-		}
-		derived, err := signer.EVMAddressOf(sc)
+		derived, err := signer.AttestorAddressOf(ctx, sc)
 		if err != nil {
 			continue
 		}
@@ -535,7 +809,7 @@ func renderedChain(cfg config.Config, m *manifest.Manifest) config.ChainConfig {
 func renderedClientEnd(m *manifest.Manifest, c manifest.Client, signer string) config.ClientEnd {
 	return config.ClientEnd{
 		ChainID:  m.ChainID,
-		Signer:   signer,
+		Signer:   config.SignerAliases{signer},
 		ClientID: c.ClientID,
 		Type:     config.ClientType(c.Type),
 	}
@@ -554,6 +828,7 @@ type renderedDeployment struct {
 // renderRelayConfig projects two deployment manifests into the config
 // sections needed to relay between them for every mutual client pair.
 func renderRelayConfig(
+	ctx context.Context,
 	cfg config.Config,
 	a, b *manifest.Manifest,
 	signerA, signerB string,
@@ -582,8 +857,12 @@ func renderRelayConfig(
 			ClientA: renderedClientEnd(a, ca, signerA),
 			ClientB: renderedClientEnd(b, cb, signerB),
 		})
-		full.Attestors = appendUniqueAttestors(full.Attestors, seenAttestors, attestorsFromClient(cfg, ca, b.ChainID))
-		full.Attestors = appendUniqueAttestors(full.Attestors, seenAttestors, attestorsFromClient(cfg, cb, a.ChainID))
+		full.Attestors = appendUniqueAttestors(
+			full.Attestors, seenAttestors, attestorsFromClient(ctx, cfg, ca, b.ChainID),
+		)
+		full.Attestors = appendUniqueAttestors(
+			full.Attestors, seenAttestors, attestorsFromClient(ctx, cfg, cb, a.ChainID),
+		)
 	}
 	if len(full.Relayer.Connections) == 0 {
 		return renderedDeployment{}, nil, errors.Errorf(
@@ -601,7 +880,7 @@ func renderRelayConfig(
 	return out, config.CollectComments(full), nil
 }
 
-func deployRenderConfig(_ *cobra.Command, args []string) error {
+func deployRenderConfig(cmd *cobra.Command, args []string) error {
 	cfg, err := setupHomeWithConfig()
 	if err != nil {
 		return err
@@ -628,9 +907,12 @@ func deployRenderConfig(_ *cobra.Command, args []string) error {
 				chainID, flagDeployManifestDir,
 			)
 		}
+		if m.Target != evm.TargetName {
+			return errors.Errorf("chain %s is a %s chain: the relayer relays evm chains only", chainID, m.Target)
+		}
 		manifests[i] = m
 	}
-	out, comments, err := renderRelayConfig(cfg, manifests[0], manifests[1], signers[0], signers[1])
+	out, comments, err := renderRelayConfig(cmd.Context(), cfg, manifests[0], manifests[1], signers[0], signers[1])
 	if err != nil {
 		return err
 	}
@@ -639,7 +921,7 @@ func deployRenderConfig(_ *cobra.Command, args []string) error {
 
 // deployerAddress derives the deployer's EVM address, for use as the default
 // IFT owner.
-func deployerAddress(cfg config.Config, alias string) (string, error) {
+func deployerAddress(ctx context.Context, cfg config.Config, alias string) (string, error) {
 	if alias == "" {
 		return "", errors.New("no deployer configured: set chains[].deployer or pass --deployer")
 	}
@@ -647,7 +929,7 @@ func deployerAddress(cfg config.Config, alias string) (string, error) {
 	if !ok {
 		return "", errors.Errorf("deployer signer %q not found in config", alias)
 	}
-	return signer.EVMAddressOf(sc)
+	return signer.EVMAddressOf(ctx, sc)
 }
 
 func deployGMP(cmd *cobra.Command, _ []string) error {
@@ -662,7 +944,30 @@ func deployGMP(cmd *cobra.Command, _ []string) error {
 	if err != nil {
 		return err
 	}
-	return planThenRun(cmd.Context(), deploy.GMPSteps(target, flagDeployManifestDir, flagDeployChain))
+	apps, err := deploy.Require[deploy.AppDeployer](target, "gmp app deployment")
+	if err != nil {
+		return err
+	}
+	return planAndRun(cmd.Context(), deploy.GMPSteps(apps, flagDeployManifestDir, flagDeployChain))
+}
+
+func deployTransfer(cmd *cobra.Command, _ []string) error {
+	cfg, err := setupHomeWithConfig()
+	if err != nil {
+		return err
+	}
+	if flagDeployChain == "" {
+		return errors.New("--chain is required")
+	}
+	target, err := newTarget(cmd.Context(), cfg, flagDeployChain, flagDeployDeployer, true)
+	if err != nil {
+		return err
+	}
+	apps, err := deploy.Require[deploy.AppDeployer](target, "transfer app deployment")
+	if err != nil {
+		return err
+	}
+	return planAndRun(cmd.Context(), deploy.TransferSteps(apps, flagDeployManifestDir, flagDeployChain))
 }
 
 func deployIFT(cmd *cobra.Command, _ []string) error {
@@ -679,7 +984,7 @@ func deployIFT(cmd *cobra.Command, _ []string) error {
 	}
 	owner := flagDeployIFTOwner
 	if owner == "" {
-		owner, err = deployerAddress(cfg, resolveDeployerAlias(chain, flagDeployDeployer))
+		owner, err = senderAddress(cmd.Context(), cfg, chain)
 		if err != nil {
 			return err
 		}
@@ -688,8 +993,12 @@ func deployIFT(cmd *cobra.Command, _ []string) error {
 	if err != nil {
 		return err
 	}
+	tokens, err := deploy.Require[deploy.IFTDeployer](target, "ift token deployment")
+	if err != nil {
+		return err
+	}
 	spec := deploy.IFTSpec{Owner: owner, Name: flagDeployIFTName, Symbol: flagDeployIFTSymbol}
-	return planThenRun(cmd.Context(), deploy.IFTSteps(target, flagDeployManifestDir, flagDeployChain, spec))
+	return planAndRun(cmd.Context(), deploy.IFTSteps(tokens, flagDeployManifestDir, flagDeployChain, spec))
 }
 
 // deployIFTBridge registers both sides of an IFT bridge in one invocation:
@@ -716,11 +1025,11 @@ func deployIFTBridge(cmd *cobra.Command, _ []string) error {
 	if !deploy.ValidClientID(clientID) {
 		return errors.Errorf("invalid client id %q", clientID)
 	}
-	targetA, err := newTarget(cmd.Context(), cfg, flagDeployBridgeChainA, flagDeployDeployer, true)
+	targetA, err := iftBridgeTarget(cmd.Context(), cfg, flagDeployBridgeChainA)
 	if err != nil {
 		return errors.Wrapf(err, "chain %s", flagDeployBridgeChainA)
 	}
-	targetB, err := newTarget(cmd.Context(), cfg, flagDeployBridgeChainB, flagDeployDeployer, true)
+	targetB, err := iftBridgeTarget(cmd.Context(), cfg, flagDeployBridgeChainB)
 	if err != nil {
 		return errors.Wrapf(err, "chain %s", flagDeployBridgeChainB)
 	}
@@ -730,5 +1039,117 @@ func deployIFTBridge(cmd *cobra.Command, _ []string) error {
 	stepsB := deploy.IFTBridgeSteps(
 		targetB, flagDeployManifestDir, flagDeployBridgeChainB, flagDeployBridgeIFTB, flagDeployBridgeCtorB,
 		deploy.BridgeSpec{ClientID: clientID, CounterpartyIFT: flagDeployBridgeIFTA})
-	return planThenRun(cmd.Context(), append(stepsA, stepsB...))
+	return planAndRun(cmd.Context(), append(stepsA, stepsB...))
+}
+
+// iftBridgeTarget is the deployment target of one side of an IFT bridge.
+func iftBridgeTarget(ctx context.Context, cfg config.Config, chainID string) (deploy.IFTDeployer, error) {
+	target, err := newTarget(ctx, cfg, chainID, flagDeployDeployer, true)
+	if err != nil {
+		return nil, err
+	}
+	return deploy.Require[deploy.IFTDeployer](target, "ift bridge registration")
+}
+
+func deployRoles(cmd *cobra.Command, _ []string) error {
+	cfg, err := setupHomeWithConfig()
+	if err != nil {
+		return err
+	}
+	if flagDeployChain == "" {
+		return errors.New("--chain is required")
+	}
+	spec, err := rolesSpec(cmd.Context(), cfg, flagDeployChain)
+	if err != nil {
+		return err
+	}
+	if len(spec.Relayers) == 0 && spec.Admin == "" {
+		return errors.Errorf("no relayers configured for chain %s and no --admin: nothing to do", flagDeployChain)
+	}
+	target, err := newTarget(cmd.Context(), cfg, flagDeployChain, flagDeployDeployer, true)
+	if err != nil {
+		return err
+	}
+	roles, err := deploy.Require[deploy.RoleManager](target, "access roles")
+	if err != nil {
+		return err
+	}
+	return planAndRun(cmd.Context(), deploy.RolesSteps(roles, flagDeployManifestDir, flagDeployChain, spec))
+}
+
+// rolesSpec assembles the RolesSpec for chainID from the roles flags.
+// Relayers default to the signers of every configured connection's client
+// end on chainID.
+func rolesSpec(ctx context.Context, cfg config.Config, chainID string) (deploy.RolesSpec, error) {
+	if flagDeployAdminDelay < 0 || flagDeployAdminDelay.Seconds() > math.MaxUint32 {
+		return deploy.RolesSpec{}, errors.Errorf("invalid --admin-delay %s", flagDeployAdminDelay)
+	}
+	spec := deploy.RolesSpec{
+		RelayerRole: flagDeployRelayerRole,
+		AdminDelay:  uint32(flagDeployAdminDelay / time.Second),
+	}
+
+	relayers := flagDeployRelayers
+	if len(relayers) == 0 {
+		for _, pair := range config.RelayerChainSignerPairs(cfg) {
+			if pair.ChainID == chainID {
+				relayers = append(relayers, pair.SignerAlias)
+			}
+		}
+	}
+	for _, relayer := range relayers {
+		address, err := resolveAddress(ctx, cfg, relayer)
+		if err != nil {
+			return deploy.RolesSpec{}, errors.Wrapf(err, "relayer %q", relayer)
+		}
+		spec.Relayers = append(spec.Relayers, address)
+	}
+
+	if flagDeployAdmin != "" {
+		admin, err := resolveAddress(ctx, cfg, flagDeployAdmin)
+		if err != nil {
+			return deploy.RolesSpec{}, errors.Wrap(err, "admin")
+		}
+		spec.Admin = admin
+	}
+	return spec, nil
+}
+
+// deployUpgrade upgrades, or with --rollback restores, the proxies of
+// --component recorded for --chain: one step per proxy.
+func deployUpgrade(cmd *cobra.Command, _ []string) error {
+	cfg, err := setupHomeWithConfig()
+	if err != nil {
+		return err
+	}
+	if flagDeployChain == "" {
+		return errors.New("--chain is required")
+	}
+	if flagDeployUpgradeIFT != "" && flagDeployComponent != deploy.ComponentIFT {
+		return errors.Errorf("--ift requires --component %s", deploy.ComponentIFT)
+	}
+	proxies, err := deploy.ComponentProxies(flagDeployManifestDir, flagDeployChain, flagDeployComponent,
+		flagDeployUpgradeIFT)
+	if err != nil {
+		return err
+	}
+	target, err := newTarget(cmd.Context(), cfg, flagDeployChain, flagDeployDeployer, true)
+	if err != nil {
+		return err
+	}
+	upgrader, err := deploy.Require[deploy.Upgrader](target, "proxy upgrades")
+	if err != nil {
+		return err
+	}
+	var steps []deploy.Step
+	for _, proxy := range proxies {
+		if flagDeployRollback {
+			steps = append(steps, deploy.RollbackSteps(
+				upgrader, flagDeployManifestDir, flagDeployChain, flagDeployComponent, proxy)...)
+		} else {
+			steps = append(steps, deploy.UpgradeSteps(
+				upgrader, flagDeployManifestDir, flagDeployChain, flagDeployComponent, proxy)...)
+		}
+	}
+	return planAndRun(cmd.Context(), steps)
 }
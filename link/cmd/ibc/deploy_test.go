@@ -3,10 +3,12 @@
 package main
 
 import (
+	"context"
 	"io"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -117,7 +119,7 @@ func TestRenderRelayConfig(t *testing.T) {
 		},
 		Signers: config.Signers{watcherSigner, unreferencedSigner},
 	}
-	out, _, err := renderRelayConfig(cfg, a, b, "signer-a", "signer-b")
+	out, _, err := renderRelayConfig(context.Background(), cfg, a, b, "signer-a", "signer-b")
 	require.NoError(t, err)
 
 	// chain 1 is declared: its config is copied, router updated, rpc kept
@@ -137,8 +139,8 @@ func TestRenderRelayConfig(t *testing.T) {
 	require.Equal(t, "1", conn.ClientA.ChainID)
 	require.Equal(t, "link-1-2", conn.ClientB.ClientID)
 	require.Equal(t, "2", conn.ClientB.ChainID)
-	require.Equal(t, "signer-a", conn.ClientA.Signer)
-	require.Equal(t, "signer-b", conn.ClientB.Signer)
+	require.Equal(t, config.SignerAliases{"signer-a"}, conn.ClientA.Signer)
+	require.Equal(t, config.SignerAliases{"signer-b"}, conn.ClientB.Signer)
 
 	// second connection between the same chain pair gets a seqno suffix
 	conn2 := out.Relayer.Connections[1]
@@ -163,7 +165,7 @@ func TestRenderRelayConfig(t *testing.T) {
 	// no mutual pair: B has no client tracking A back
 	empty := manifest.New("2", "evm")
 	empty.Core.Router = "0xrouterB"
-	_, _, err = renderRelayConfig(cfg, a, empty, "signer-a", "signer-b")
+	_, _, err = renderRelayConfig(context.Background(), cfg, a, empty, "signer-a", "signer-b")
 	require.ErrorContains(t, err, "no mutual client pair")
 
 	// mismatched back-reference: B's client points at a different A client
@@ -173,7 +175,7 @@ func TestRenderRelayConfig(t *testing.T) {
 		ClientID: "link-1", Type: "attestation",
 		CounterpartyChainID: "1", CounterpartyClientID: "link-other",
 	})
-	_, _, err = renderRelayConfig(cfg, a, mismatched, "signer-a", "signer-b")
+	_, _, err = renderRelayConfig(context.Background(), cfg, a, mismatched, "signer-a", "signer-b")
 	require.ErrorContains(t, err, "no mutual client pair")
 }
 
@@ -197,7 +199,7 @@ func TestRenderConfigEmitsComments(t *testing.T) {
 		CounterpartyChainID: "1", CounterpartyClientID: "link-1-2",
 	})
 
-	out, comments, err := renderRelayConfig(config.Config{}, a, b, "", "")
+	out, comments, err := renderRelayConfig(context.Background(), config.Config{}, a, b, "", "")
 	require.NoError(t, err)
 
 	rendered := captureStdout(t, func() {
@@ -227,3 +229,110 @@ func captureStdout(t *testing.T, fn func()) string {
 
 	return string(bz)
 }
+
+func TestRolesSpec(t *testing.T) {
+	relayerA, addressA := newLocalSignerConfig(t, "relayer-a")
+	relayerB, addressB := newLocalSignerConfig(t, "relayer-b")
+	cfg := config.Config{
+		Signers: []config.SignerConfig{relayerA, relayerB},
+		Relayer: config.RelayerConfig{Connections: []config.ConnectionConfig{{
+			Alias:   "a-b",
+			ClientA: config.ClientEnd{ChainID: "1", Signer: config.SignerAliases{"relayer-a"}},
+			ClientB: config.ClientEnd{ChainID: "2", Signer: config.SignerAliases{"relayer-b"}},
+		}}},
+	}
+	t.Cleanup(func() {
+		flagDeployRelayers, flagDeployAdmin, flagDeployAdminDelay = nil, "", 0
+	})
+
+	t.Run("defaults to the chain's connection signers", func(t *testing.T) {
+		flagDeployRelayers, flagDeployAdmin, flagDeployAdminDelay = nil, "", 0
+
+		spec, err := rolesSpec(context.Background(), cfg, "1")
+		require.NoError(t, err)
+		require.Equal(t, []string{addressA}, spec.Relayers)
+		require.Empty(t, spec.Admin)
+	})
+
+	t.Run("flags", func(t *testing.T) {
+		admin := "0x00000000000000000000000000000000000000aa"
+		flagDeployRelayers = []string{"relayer-b", addressA}
+		flagDeployAdmin, flagDeployAdminDelay = admin, 36*time.Hour
+
+		spec, err := rolesSpec(context.Background(), cfg, "1")
+		require.NoError(t, err)
+		require.Equal(t, []string{addressB, addressA}, spec.Relayers)
+		require.Equal(t, admin, spec.Admin)
+		require.Equal(t, uint32(129600), spec.AdminDelay)
+	})
+
+	t.Run("unknown relayer", func(t *testing.T) {
+		flagDeployRelayers, flagDeployAdmin, flagDeployAdminDelay = []string{"nobody"}, "", 0
+
+		_, err := rolesSpec(context.Background(), cfg, "1")
+		require.ErrorContains(t, err, `relayer "nobody"`)
+	})
+}
+
+func TestUpdatedAttestorSet(t *testing.T) {
+	live := []string{"0xA", "0xB", "0xC"}
+
+	tests := []struct {
+		name          string
+		add, remove   []string
+		threshold     uint8
+		wantAttestors []string
+		wantThreshold uint8
+		wantErr       string
+	}{
+		{
+			name:          "add keeps threshold",
+			add:           []string{"0xD"},
+			wantAttestors: []string{"0xA", "0xB", "0xC", "0xD"},
+			wantThreshold: 2,
+		},
+		{
+			name:          "remove and raise threshold",
+			remove:        []string{"0xb"},
+			threshold:     2,
+			wantAttestors: []string{"0xA", "0xC"},
+			wantThreshold: 2,
+		},
+		{
+			name:          "rerun changes nothing",
+			add:           []string{"0xa"},
+			remove:        []string{"0xE"},
+			wantAttestors: []string{"0xA", "0xB", "0xC"},
+			wantThreshold: 2,
+		},
+		{
+			name:    "added and removed",
+			add:     []string{"0xD"},
+			remove:  []string{"0xd"},
+			wantErr: "both added and removed",
+		},
+		{
+			name:    "threshold above set size",
+			remove:  []string{"0xA", "0xB"},
+			wantErr: "threshold 2 exceeds the 1 attestors",
+		},
+		{
+			name:    "empty set",
+			remove:  live,
+			wantErr: "no attestors left",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attestors, threshold, err := updatedAttestorSet(live, 2, tt.add, tt.remove, tt.threshold)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantAttestors, attestors)
+			require.Equal(t, tt.wantThreshold, threshold)
+		})
+	}
+}
@@ -0,0 +1,332 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/ibc/link/internal/config"
+	"github.com/cosmos/ibc/link/internal/deploy"
+	"github.com/cosmos/ibc/link/internal/deploy/evm"
+	"github.com/cosmos/ibc/link/internal/deploy/manifest"
+)
+
+const (
+	exportFormatSafe   = "safe"
+	exportFormatBundle = "bundle"
+
+	// pendingDir, under the manifest directory, holds the manifests of
+	// exported plans until `deploy reconcile` confirms them on chain.
+	pendingDir = "pending"
+)
+
+var (
+	flagDeployExport       string
+	flagDeployExportFormat string
+	flagDeployExportFrom   string
+
+	// exportRecorders and exportSenders hold each chain's recorded calls and
+	// the account they are recorded for while exporting.
+	exportRecorders = map[string]*deploy.Recorder{}
+	exportSenders   = map[string]string{}
+)
+
+var cmdDeployReconcile = &cobra.Command{
+	Use:   "reconcile",
+	Short: "Promote the manifests of executed exported plans once the chain matches them",
+	Long: "Verifies each manifest an --export run left pending against live chain state. Those whose every " +
+		"check passes, because the multisig or offline signer has executed the exported transactions, " +
+		"replace the recorded manifest; the others stay pending and their failed checks are reported.",
+	Example: "  ibc deploy reconcile\n" +
+		"  ibc deploy reconcile --chain 1",
+	RunE: deployReconcile,
+}
+
+// deployPersistentPreRun sets up --export: the run records into copies of the
+// manifests under the pending directory, leaving the recorded ones untouched
+// until the exported transactions have executed.
+func deployPersistentPreRun(cmd *cobra.Command, _ []string) error {
+	if flagDeployExport == "" {
+		return nil
+	}
+	if !plansTransactions(cmd) {
+		// staging would leave a pending directory no export cleans up
+		return errors.Errorf("--export does not apply to %s: it plans no transactions", cmd.CommandPath())
+	}
+	if flagDeployDryRun {
+		return errors.New("--export already records the plan without submitting it: drop --dry-run")
+	}
+	if flagDeployExportFormat != exportFormatSafe && flagDeployExportFormat != exportFormatBundle {
+		return errors.Errorf("invalid --export-format %q: want %s or %s",
+			flagDeployExportFormat, exportFormatSafe, exportFormatBundle)
+	}
+	// resolve both directories before setupHomeWithConfig changes the working
+	// directory to --home
+	var err error
+	if flagDeployExport, err = filepath.Abs(flagDeployExport); err != nil {
+		return errors.Wrap(err, "export path")
+	}
+	home, err := config.ExpandHome(globalFlags.Home)
+	if err != nil {
+		return errors.Wrap(err, "home")
+	}
+	dir := flagDeployManifestDir
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(home, dir)
+	}
+	pending := filepath.Join(dir, pendingDir)
+	if entries, err := os.ReadDir(pending); err == nil && len(entries) > 0 {
+		return errors.Errorf("%s holds the manifests of exported plans not reconciled yet: "+
+			"execute them and run `ibc deploy reconcile`, or remove the directory to discard them", pending)
+	}
+	if err := copyManifests(dir, pending); err != nil {
+		return errors.Wrap(err, "stage pending manifests")
+	}
+	flagDeployManifestDir = pending
+	return nil
+}
+
+// plansTransactions reports whether cmd runs a step plan, the only commands
+// --export records.
+func plansTransactions(cmd *cobra.Command) bool {
+	switch cmd {
+	case cmdDeployCore, cmdDeployClient, cmdDeployClientUpdate, cmdDeployGMP, cmdDeployTransfer, cmdDeployIFT,
+		cmdDeployIFTBridge, cmdDeployRoles, cmdDeployUpgrade, cmdDeployApply:
+		return true
+	default:
+		return false
+	}
+}
+
+// copyManifests copies every manifest in from into to.
+func copyManifests(from, to string) error {
+	if err := os.MkdirAll(to, 0o755); err != nil {
+		return err
+	}
+	matches, err := filepath.Glob(filepath.Join(from, "*.json"))
+	if err != nil {
+		return err
+	}
+	for _, path := range matches {
+		bz, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(to, filepath.Base(path)), bz, 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// exportSender is the account an exported plan for chain is built for:
+// --export-from, or for a bundle the deployer, which signs it offline.
+func exportSender(
+	ctx context.Context,
+	cfg config.Config,
+	chain config.ChainConfig,
+	deployerFlag string,
+) (string, error) {
+	if flagDeployExportFrom != "" {
+		return resolveAddress(ctx, cfg, flagDeployExportFrom)
+	}
+	if flagDeployExportFormat == exportFormatSafe {
+		return "", errors.New("--export-from is required: the address of the Safe executing the batch")
+	}
+	return deployerAddress(ctx, cfg, resolveDeployerAlias(chain, deployerFlag))
+}
+
+// senderAddress is the account deployment transactions on chain come from:
+// the exported plan's sender, or the deployer.
+func senderAddress(ctx context.Context, cfg config.Config, chain config.ChainConfig) (string, error) {
+	if flagDeployExport != "" {
+		return exportSender(ctx, cfg, chain, flagDeployDeployer)
+	}
+	return deployerAddress(ctx, cfg, resolveDeployerAlias(chain, flagDeployDeployer))
+}
+
+// exportRecorder returns the recorder of chainID's calls from sender,
+// creating it on first use.
+func exportRecorder(chainID, sender string) *deploy.Recorder {
+	r, ok := exportRecorders[chainID]
+	if !ok {
+		r = &deploy.Recorder{Creations: flagDeployExportFormat == exportFormatBundle}
+		exportRecorders[chainID] = r
+		exportSenders[chainID] = sender
+	}
+	return r
+}
+
+// writeExports writes one file in format per chain with recorded calls to
+// dir and returns their paths.
+func writeExports(
+	dir, format string,
+	recorders map[string]*deploy.Recorder,
+	senders map[string]string,
+	now time.Time,
+) ([]string, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	chainIDs := make([]string, 0, len(recorders))
+	for chainID := range recorders {
+		chainIDs = append(chainIDs, chainID)
+	}
+	slices.Sort(chainIDs)
+
+	var paths []string
+	for _, chainID := range chainIDs {
+		calls := recorders[chainID].Calls()
+		if len(calls) == 0 {
+			continue
+		}
+		var out any = evm.Bundle{ChainID: chainID, From: senders[chainID], Transactions: calls}
+		if format == exportFormatSafe {
+			batch, err := evm.NewSafeBatch(chainID, senders[chainID], calls, now)
+			if err != nil {
+				return nil, err
+			}
+			out = batch
+		}
+		bz, err := json.MarshalIndent(out, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		path := filepath.Join(dir, chainID+"."+format+".json")
+		if err := os.WriteFile(path, bz, 0o644); err != nil {
+			return nil, errors.Wrapf(err, "write %s", path)
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+// exportSteps runs steps recording their transactions, then writes the
+// exported plans. Only the manifests of chains with a plan stay pending: a
+// failed run discards them all.
+func exportSteps(ctx context.Context, steps []deploy.Step) error {
+	results, err := deploy.RunSteps(ctx, slog.Default(), false, steps)
+	if err == nil {
+		var paths []string
+		paths, err = writeExports(flagDeployExport, flagDeployExportFormat, exportRecorders, exportSenders, time.Now())
+		for _, path := range paths {
+			slog.Info("wrote exported plan", "path", path)
+		}
+	}
+	if err == nil {
+		err = prunePending(flagDeployManifestDir, exportRecorders)
+	}
+	if err != nil {
+		if rmErr := os.RemoveAll(flagDeployManifestDir); rmErr != nil {
+			slog.Warn("discard pending manifests", "dir", flagDeployManifestDir, "error", rmErr)
+		}
+	}
+	if printErr := config.PrintJSON(results); printErr != nil {
+		return printErr
+	}
+	return err
+}
+
+// prunePending removes the pending manifests of chains without recorded
+// calls, and the directory once it is empty.
+func prunePending(dir string, recorders map[string]*deploy.Recorder) error {
+	matches, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return err
+	}
+	kept := 0
+	for _, path := range matches {
+		chainID := strings.TrimSuffix(filepath.Base(path), ".json")
+		if r, ok := recorders[chainID]; ok && len(r.Calls()) > 0 {
+			kept++
+			continue
+		}
+		if err := os.Remove(path); err != nil {
+			return err
+		}
+	}
+	if kept == 0 {
+		return os.RemoveAll(dir)
+	}
+	return nil
+}
+
+func deployReconcile(cmd *cobra.Command, _ []string) error {
+	cfg, err := setupHomeWithConfig()
+	if err != nil {
+		return err
+	}
+	pending := filepath.Join(flagDeployManifestDir, pendingDir)
+	chainIDs := []string{flagDeployChain}
+	if flagDeployChain == "" {
+		matches, err := filepath.Glob(filepath.Join(pending, "*.json"))
+		if err != nil {
+			return err
+		}
+		chainIDs = chainIDs[:0]
+		for _, path := range matches {
+			chainIDs = append(chainIDs, strings.TrimSuffix(filepath.Base(path), ".json"))
+		}
+	}
+
+	failed := false
+	out := map[string]any{}
+	for _, chainID := range chainIDs {
+		m, err := manifest.Load(pending, chainID)
+		if err != nil {
+			return err
+		}
+		if m == nil {
+			out[chainID] = map[string]string{useStatus: "nothing pending"}
+			continue
+		}
+		reconciled, report, err := reconcileChain(cmd.Context(), cfg, m)
+		switch {
+		case err != nil:
+			out[chainID] = statusError(err)
+			failed = true
+		case !reconciled:
+			out[chainID] = map[string]any{useStatus: "pending", "report": report}
+			failed = true
+		default:
+			out[chainID] = map[string]any{useStatus: "reconciled", "report": report}
+		}
+	}
+	if entries, err := os.ReadDir(pending); err == nil && len(entries) == 0 {
+		_ = os.Remove(pending) // Best-effort cleanup of the emptied directory
+	}
+	if err := config.PrintJSON(out); err != nil {
+		return err
+	}
+	if failed {
+		return errors.New("some exported plans are not reconciled: execute them and rerun")
+	}
+	return nil
+}
+
+// reconcileChain promotes m, a pending manifest, to the manifest directory if
+// the chain matches every check of it.
+func reconcileChain(ctx context.Context, cfg config.Config, m *manifest.Manifest) (bool, deploy.Report, error) {
+	target, err := newTarget(ctx, cfg, m.ChainID, flagDeployDeployer, false)
+	if err != nil {
+		return false, deploy.Report{}, err
+	}
+	report, err := target.Verify(ctx, m)
+	if err != nil || len(report.Failed()) > 0 {
+		return false, report, err
+	}
+	if err := m.Save(flagDeployManifestDir); err != nil {
+		return false, report, err
+	}
+	return true, report, os.Remove(manifest.Path(filepath.Join(flagDeployManifestDir, pendingDir), m.ChainID))
+}
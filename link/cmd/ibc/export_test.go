@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc/link/internal/deploy"
+	"github.com/cosmos/ibc/link/internal/deploy/evm"
+	"github.com/cosmos/ibc/link/internal/deploy/manifest"
+)
+
+const safeAddress = "0x00000000000000000000000000000000000000aa"
+
+func TestWriteExports(t *testing.T) {
+	wiring := &deploy.Recorder{}
+	require.NoError(t, wiring.Record(context.Background(), deploy.Call{To: "0xrouter", Value: "0", Data: "0x01"}))
+	recorders := map[string]*deploy.Recorder{"1": wiring, "2": {}}
+	senders := map[string]string{"1": safeAddress, "2": safeAddress}
+	dir := filepath.Join(t.TempDir(), "out")
+
+	paths, err := writeExports(dir, exportFormatSafe, recorders, senders, time.UnixMilli(42))
+	require.NoError(t, err)
+	// chain 2 recorded nothing: no batch
+	require.Equal(t, []string{filepath.Join(dir, "1.safe.json")}, paths)
+
+	bz, err := os.ReadFile(paths[0])
+	require.NoError(t, err)
+	var batch evm.SafeBatch
+	require.NoError(t, json.Unmarshal(bz, &batch))
+	require.Equal(t, "1", batch.ChainID)
+	require.EqualValues(t, 42, batch.CreatedAt)
+	require.Equal(t, safeAddress, batch.Meta.CreatedFromSafeAddress)
+	require.Equal(t, []evm.SafeTransaction{{To: "0xrouter", Value: "0", Data: "0x01"}}, batch.Transactions)
+	// the builder expects the method fields present, as null
+	require.Contains(t, string(bz), `"contractMethod": null`)
+
+	paths, err = writeExports(dir, exportFormatBundle, recorders, senders, time.UnixMilli(42))
+	require.NoError(t, err)
+	bz, err = os.ReadFile(paths[0])
+	require.NoError(t, err)
+	var bundle evm.Bundle
+	require.NoError(t, json.Unmarshal(bz, &bundle))
+	require.Equal(t, evm.Bundle{ChainID: "1", From: safeAddress, Transactions: wiring.Calls()}, bundle)
+
+	// a Safe cannot create the contracts later calls reference
+	creating := &deploy.Recorder{Creations: true}
+	require.NoError(t, creating.Record(context.Background(), deploy.Call{Value: "0", Data: "0x60"}))
+	_, err = writeExports(dir, exportFormatSafe, map[string]*deploy.Recorder{"1": creating}, senders, time.Now())
+	require.ErrorContains(t, err, "Safe batch cannot")
+}
+
+func TestPrunePending(t *testing.T) {
+	dir := t.TempDir()
+	for _, chainID := range []string{"1", "2"} {
+		require.NoError(t, manifest.New(chainID, "evm").Save(dir))
+	}
+	recorded := &deploy.Recorder{}
+	require.NoError(t, recorded.Record(context.Background(), deploy.Call{To: "0xrouter", Value: "0", Data: "0x01"}))
+
+	require.NoError(t, prunePending(dir, map[string]*deploy.Recorder{"1": recorded, "2": {}}))
+	_, err := os.Stat(manifest.Path(dir, "1"))
+	require.NoError(t, err)
+	_, err = os.Stat(manifest.Path(dir, "2"))
+	require.True(t, os.IsNotExist(err))
+
+	// nothing recorded at all: the pending directory goes
+	require.NoError(t, prunePending(dir, nil))
+	_, err = os.Stat(dir)
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestExportOnlyOnPlanningCommands(t *testing.T) {
+	home := t.TempDir()
+	export, manifestDir, homeFlag := flagDeployExport, flagDeployManifestDir, globalFlags.Home
+	t.Cleanup(func() {
+		flagDeployExport, flagDeployManifestDir, globalFlags.Home = export, manifestDir, homeFlag
+	})
+	globalFlags.Home, flagDeployManifestDir = home, "deployments"
+
+	for _, cmd := range []*cobra.Command{
+		cmdDeployStatus, cmdDeployShow, cmdDeployRenderConfig, cmdDeployImport, cmdDeployDiff,
+		cmdDeployReconcile, cmdDeployManifestMigrate,
+	} {
+		flagDeployExport = filepath.Join(home, "out")
+		require.ErrorContains(t, deployPersistentPreRun(cmd, nil), "--export does not apply", cmd.Name())
+		require.Equal(t, "deployments", flagDeployManifestDir)
+	}
+	_, err := os.Stat(filepath.Join(home, "deployments", pendingDir))
+	require.True(t, os.IsNotExist(err))
+
+	flagDeployExport = filepath.Join(home, "out")
+	require.NoError(t, deployPersistentPreRun(cmdDeployCore, nil))
+	require.Equal(t, filepath.Join(home, "deployments", pendingDir), flagDeployManifestDir)
+}
@@ -4,16 +4,13 @@ package main
 
 import (
 	"context"
-	"crypto/ecdsa"
 	"math/big"
-	"strings"
 	"time"
 
 	"github.com/cosmos/solidity-ibc-eureka/packages/go-abigen/ift"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -81,12 +78,12 @@ func txIFTMint(cmd *cobra.Command, _ []string) error {
 		return errors.Errorf("invalid --amount %q", flagTxIFTAmount)
 	}
 
-	backend, key, chainID, cfg, err := dialIFTChain(cmd.Context())
+	backend, from, chainID, cfg, err := dialTxChain(cmd.Context(), flagTxIFTChain, flagTxIFTFrom)
 	if err != nil {
 		return err
 	}
 
-	to, err := resolveAddress(cfg, flagTxIFTTo)
+	to, err := resolveAddress(cmd.Context(), cfg, flagTxIFTTo)
 	if err != nil {
 		return err
 	}
@@ -96,11 +93,10 @@ func txIFTMint(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
-	opts, err := bind.NewKeyedTransactorWithChainID(key, chainID)
+	opts, err := signer.NewTransactor(cmd.Context(), from, chainID)
 	if err != nil {
 		return err
 	}
-	opts.Context = cmd.Context()
 
 	tx, err := contract.Mint(opts, common.HexToAddress(to), amount)
 	if err != nil {
@@ -125,12 +121,12 @@ func txIFTSend(cmd *cobra.Command, _ []string) error {
 		return errors.Errorf("invalid --amount %q", flagTxIFTAmount)
 	}
 
-	backend, key, chainID, cfg, err := dialIFTChain(cmd.Context())
+	backend, from, chainID, cfg, err := dialTxChain(cmd.Context(), flagTxIFTChain, flagTxIFTFrom)
 	if err != nil {
 		return err
 	}
 
-	receiver, err := resolveAddress(cfg, flagTxIFTTo)
+	receiver, err := resolveAddress(cmd.Context(), cfg, flagTxIFTTo)
 	if err != nil {
 		return err
 	}
@@ -140,11 +136,10 @@ func txIFTSend(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
-	opts, err := bind.NewKeyedTransactorWithChainID(key, chainID)
+	opts, err := signer.NewTransactor(cmd.Context(), from, chainID)
 	if err != nil {
 		return err
 	}
-	opts.Context = cmd.Context()
 
 	if flagTxIFTTimeout <= 0 {
 		return errors.Errorf("invalid --timeout %s: must be positive", flagTxIFTTimeout)
@@ -168,29 +163,16 @@ func txIFTSend(cmd *cobra.Command, _ []string) error {
 }
 
 func queryIFTBalance(cmd *cobra.Command, _ []string) error {
-	cfg, err := setupHomeWithConfig()
+	backend, cfg, err := dialQueryChain(cmd.Context(), flagQueryIFTChain)
 	if err != nil {
 		return err
 	}
 
-	chain, ok := cfg.Chain(flagQueryIFTChain)
-	if !ok {
-		return errors.Errorf("chain %q not declared in config", flagQueryIFTChain)
-	}
-	if chain.Type() != config.ChainTypeEVM {
-		return errors.Errorf("chain %q is not an EVM chain", flagQueryIFTChain)
-	}
-
-	account, err := resolveAddress(cfg, flagQueryIFTAccount)
+	account, err := resolveAddress(cmd.Context(), cfg, flagQueryIFTAccount)
 	if err != nil {
 		return err
 	}
 
-	backend, err := ethclient.DialContext(cmd.Context(), chain.EVM.RPC)
-	if err != nil {
-		return errors.Wrapf(err, "dial %s", chain.EVM.RPC)
-	}
-
 	if !common.IsHexAddress(flagQueryIFTAddress) {
 		return errors.Errorf("invalid IFT address %q", flagQueryIFTAddress)
 	}
@@ -229,30 +211,29 @@ func parseIFTAmount(s string) *big.Int {
 	return amount
 }
 
-// dialIFTChain resolves --chain's RPC and --from's signer from config, and
-// dials the chain.
-func dialIFTChain(ctx context.Context) (*ethclient.Client, *ecdsa.PrivateKey, *big.Int, config.Config, error) {
+// dialTxChain resolves the EVM chain's RPC and opens the fromAlias signer from
+// config, and dials the chain.
+func dialTxChain(
+	ctx context.Context,
+	chainName, fromAlias string,
+) (*ethclient.Client, signer.Signer, *big.Int, config.Config, error) {
 	cfg, err := setupHomeWithConfig()
 	if err != nil {
 		return nil, nil, nil, config.Config{}, err
 	}
 
-	chain, ok := cfg.Chain(flagTxIFTChain)
+	chain, ok := cfg.Chain(chainName)
 	if !ok {
-		return nil, nil, nil, config.Config{}, errors.Errorf("chain %q not declared in config", flagTxIFTChain)
+		return nil, nil, nil, config.Config{}, errors.Errorf("chain %q not declared in config", chainName)
 	}
 	if chain.Type() != config.ChainTypeEVM {
-		return nil, nil, nil, config.Config{}, errors.Errorf("chain %q is not an EVM chain", flagTxIFTChain)
+		return nil, nil, nil, config.Config{}, errors.Errorf("chain %q is not an EVM chain", chainName)
 	}
 
-	keyHex, err := deployerKeyHex(cfg, flagTxIFTFrom)
+	from, err := deployerSigner(ctx, cfg, fromAlias)
 	if err != nil {
 		return nil, nil, nil, config.Config{}, err
 	}
-	key, err := crypto.HexToECDSA(strings.TrimPrefix(keyHex, "0x"))
-	if err != nil {
-		return nil, nil, nil, config.Config{}, errors.Wrap(err, "signer key")
-	}
 
 	backend, err := ethclient.DialContext(ctx, chain.EVM.RPC)
 	if err != nil {
@@ -264,12 +245,34 @@ func dialIFTChain(ctx context.Context) (*ethclient.Client, *ecdsa.PrivateKey, *b
 		return nil, nil, nil, config.Config{}, errors.Wrap(err, "query chain id")
 	}
 
-	return backend, key, chainID, cfg, nil
+	return backend, from, chainID, cfg, nil
+}
+
+// dialQueryChain resolves the EVM chain's RPC from config and dials it.
+func dialQueryChain(ctx context.Context, chainName string) (*ethclient.Client, config.Config, error) {
+	cfg, err := setupHomeWithConfig()
+	if err != nil {
+		return nil, config.Config{}, err
+	}
+
+	chain, ok := cfg.Chain(chainName)
+	if !ok {
+		return nil, config.Config{}, errors.Errorf("chain %q not declared in config", chainName)
+	}
+	if chain.Type() != config.ChainTypeEVM {
+		return nil, config.Config{}, errors.Errorf("chain %q is not an EVM chain", chainName)
+	}
+
+	backend, err := ethclient.DialContext(ctx, chain.EVM.RPC)
+	if err != nil {
+		return nil, config.Config{}, errors.Wrapf(err, "dial %s", chain.EVM.RPC)
+	}
+	return backend, cfg, nil
 }
 
 // resolveAddress accepts either a raw EVM address or a config signer alias,
 // resolving the alias to its derived EVM address.
-func resolveAddress(cfg config.Config, value string) (string, error) {
+func resolveAddress(ctx context.Context, cfg config.Config, value string) (string, error) {
 	if common.IsHexAddress(value) {
 		return value, nil
 	}
@@ -277,5 +280,5 @@ func resolveAddress(cfg config.Config, value string) (string, error) {
 	if !ok {
 		return "", errors.Errorf("invalid address %q: not a hex address or a configured signer alias", value)
 	}
-	return signer.EVMAddressOf(sc)
+	return signer.EVMAddressOf(ctx, sc)
 }
@@ -0,0 +1,242 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/ibc/link/internal/config"
+	"github.com/cosmos/ibc/link/internal/deploy"
+	"github.com/cosmos/ibc/link/internal/deploy/manifest"
+)
+
+var (
+	flagDeployImportRouter string
+	flagDeployImportIFTs   []string
+	flagDeployImportForce  bool
+	flagDeployFromBlock    uint64
+)
+
+var (
+	cmdDeployImport = &cobra.Command{
+		Use:   "import",
+		Short: "Rebuild a chain's manifest from on-chain state",
+		Long: "Discovers the core stack behind the router, the clients and apps the router's events " +
+			"announce and the bridges of the given IFT tokens, and writes them as the chain's manifest. " +
+			"Counterparty chains come from the relayer connections in the config. An existing manifest " +
+			"is only replaced with --force, keeping a .bak copy and whatever chain state cannot tell: " +
+			"logic contracts, launch-time client parameters, roles and upgrade history.",
+		Example: "  ibc deploy import --chain 1\n" +
+			"  ibc deploy import --chain 1 --router 0x... --ift 0x... --from-block 19000000",
+		RunE: deployImport,
+	}
+
+	cmdDeployDiff = &cobra.Command{
+		Use:   "diff",
+		Short: "Show field-level drift between a chain's manifest, the config and the chain",
+		Long: "Rebuilds the manifest from on-chain state like import does and prints every field on which " +
+			"the recorded manifest, the chain and the config disagree. Exits non-zero on drift.",
+		RunE: deployDiff,
+	}
+)
+
+func deployImport(cmd *cobra.Command, _ []string) error {
+	cfg, err := setupHomeWithConfig()
+	if err != nil {
+		return err
+	}
+	if flagDeployChain == "" {
+		return errors.New("--chain is required")
+	}
+	prev, err := manifest.Load(flagDeployManifestDir, flagDeployChain)
+	if err != nil {
+		return err
+	}
+	if prev != nil && !flagDeployImportForce {
+		return errors.Errorf("a manifest for chain %s exists in %s: pass --force to replace it",
+			flagDeployChain, flagDeployManifestDir)
+	}
+	router := flagDeployImportRouter
+	if router == "" {
+		router = recordedRouter(cfg, flagDeployChain, prev)
+	}
+	if router == "" {
+		return errors.Errorf("no router known for chain %s: pass --router", flagDeployChain)
+	}
+
+	m, err := importManifest(cmd.Context(), cfg, flagDeployChain, router, flagDeployImportIFTs, prev)
+	if err != nil {
+		return err
+	}
+	if prev != nil {
+		path := manifest.Path(flagDeployManifestDir, flagDeployChain)
+		bz, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(path+".bak", bz, 0o644); err != nil {
+			return errors.Wrap(err, "back up manifest")
+		}
+		slog.Info("backed up manifest", "path", path+".bak")
+	}
+	if err := m.Save(flagDeployManifestDir); err != nil {
+		return err
+	}
+	return config.PrintJSON(m)
+}
+
+func deployDiff(cmd *cobra.Command, _ []string) error {
+	cfg, err := setupHomeWithConfig()
+	if err != nil {
+		return err
+	}
+	if flagDeployChain == "" {
+		return errors.New("--chain is required")
+	}
+	m, err := manifest.Load(flagDeployManifestDir, flagDeployChain)
+	if err != nil {
+		return err
+	}
+	if m == nil {
+		return errors.Errorf("no manifest for chain %s in %s: run `ibc deploy import` to rebuild it",
+			flagDeployChain, flagDeployManifestDir)
+	}
+	if m.Core.Router == "" {
+		return errors.Errorf("manifest for chain %s records no router", flagDeployChain)
+	}
+
+	live, err := importManifest(cmd.Context(), cfg, flagDeployChain, m.Core.Router, nil, m)
+	if err != nil {
+		return err
+	}
+	drifts := append(deploy.Diff(m, live), configDrift(cfg, flagDeployChain, m, live)...)
+	if err := config.PrintJSON(map[string]any{"chainId": flagDeployChain, "drift": drifts}); err != nil {
+		return err
+	}
+	if len(drifts) > 0 {
+		return errors.Errorf("%d field(s) drifted on chain %s", len(drifts), flagDeployChain)
+	}
+	return nil
+}
+
+// recordedRouter is chainID's router as the manifest, or else the config,
+// records it.
+func recordedRouter(cfg config.Config, chainID string, m *manifest.Manifest) string {
+	if m != nil && m.Core.Router != "" {
+		return m.Core.Router
+	}
+	if chain, ok := cfg.Chain(chainID); ok && chain.EVM != nil {
+		return chain.EVM.ICS26Router
+	}
+	return ""
+}
+
+// importManifest rebuilds chainID's manifest from the router's on-chain state,
+// with the tokens given and those prev records.
+func importManifest(
+	ctx context.Context,
+	cfg config.Config,
+	chainID, router string,
+	tokens []string,
+	prev *manifest.Manifest,
+) (*manifest.Manifest, error) {
+	target, err := newTarget(ctx, cfg, chainID, flagDeployDeployer, false)
+	if err != nil {
+		return nil, err
+	}
+	discoverer, err := deploy.Require[deploy.Discoverer](target, "deployment discovery")
+	if err != nil {
+		return nil, err
+	}
+	opts := deploy.ImportOptions{
+		ChainID:            chainID,
+		Target:             target.Name(),
+		Router:             router,
+		FromBlock:          flagDeployFromBlock,
+		Tokens:             slices.Clone(tokens),
+		CounterpartyChains: counterpartyChains(cfg, chainID),
+		Previous:           prev,
+	}
+	if prev != nil {
+		opts.Target = prev.Target
+		for _, tok := range prev.Tokens {
+			opts.Tokens = append(opts.Tokens, tok.Address)
+		}
+	}
+	return deploy.Import(ctx, discoverer, opts)
+}
+
+// counterpartyChains maps the ids of chainID's clients in the configured
+// connections to the chain at the connection's other end.
+func counterpartyChains(cfg config.Config, chainID string) map[string]string {
+	out := map[string]string{}
+	for _, conn := range cfg.Relayer.Connections {
+		for _, ends := range [][2]config.ClientEnd{{conn.ClientA, conn.ClientB}, {conn.ClientB, conn.ClientA}} {
+			if ends[0].ChainID == chainID && ends[0].ClientID != "" {
+				out[ends[0].ClientID] = ends[1].ChainID
+			}
+		}
+	}
+	return out
+}
+
+// configDrift reports where the config disagrees with chainID's manifest m or
+// live, its rebuild from chain state: the router, and the clients of the
+// relayer connections ending on the chain.
+func configDrift(cfg config.Config, chainID string, m, live *manifest.Manifest) []deploy.Drift {
+	var drifts []deploy.Drift
+	if chain, ok := cfg.Chain(chainID); ok && chain.EVM != nil && chain.EVM.ICS26Router != "" &&
+		!strings.EqualFold(chain.EVM.ICS26Router, m.Core.Router) {
+		drifts = append(drifts, deploy.Drift{
+			Field:    "core.router",
+			Manifest: m.Core.Router,
+			Chain:    live.Core.Router,
+			Config:   chain.EVM.ICS26Router,
+		})
+	}
+
+	for _, conn := range cfg.Relayer.Connections {
+		for _, ends := range [][2]config.ClientEnd{{conn.ClientA, conn.ClientB}, {conn.ClientB, conn.ClientA}} {
+			end, other := ends[0], ends[1]
+			if end.ChainID != chainID || end.ClientID == "" {
+				continue
+			}
+			field := "clients[" + end.ClientID + "]"
+			recorded, inManifest := m.Client(end.ClientID)
+			registered, onChain := live.Client(end.ClientID)
+			if !inManifest || !onChain {
+				drifts = append(drifts, deploy.Drift{
+					Field:    field,
+					Manifest: deploy.Presence(inManifest, "recorded"),
+					Chain:    deploy.Presence(onChain, "registered"),
+					Config:   "connection " + conn.Alias,
+				})
+				continue
+			}
+			if other.ClientID != "" && (other.ClientID != recorded.CounterpartyClientID ||
+				other.ClientID != registered.CounterpartyClientID) {
+				drifts = append(drifts, deploy.Drift{
+					Field:    field + ".counterpartyClientId",
+					Manifest: recorded.CounterpartyClientID,
+					Chain:    registered.CounterpartyClientID,
+					Config:   other.ClientID,
+				})
+			}
+			if other.ChainID != recorded.CounterpartyChainID {
+				drifts = append(drifts, deploy.Drift{
+					Field:    field + ".counterpartyChainId",
+					Manifest: recorded.CounterpartyChainID,
+					Config:   other.ChainID,
+				})
+			}
+		}
+	}
+	return drifts
+}
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc/link/internal/config"
+	"github.com/cosmos/ibc/link/internal/deploy"
+	"github.com/cosmos/ibc/link/internal/deploy/manifest"
+)
+
+func TestConfigDrift(t *testing.T) {
+	cfg := config.Config{
+		Chains: []config.ChainConfig{{ChainID: "1", EVM: &config.EVMChainConfig{ICS26Router: "0xold"}}},
+		Relayer: config.RelayerConfig{Connections: []config.ConnectionConfig{
+			{
+				Alias:   "1-2",
+				ClientA: config.ClientEnd{ChainID: "1", ClientID: "link-1-2"},
+				ClientB: config.ClientEnd{ChainID: "2", ClientID: "link-1-2"},
+			},
+			{
+				Alias:   "3-1",
+				ClientA: config.ClientEnd{ChainID: "3", ClientID: "link-1-3"},
+				ClientB: config.ClientEnd{ChainID: "1", ClientID: "link-1-3"},
+			},
+			{
+				Alias:   "2-3",
+				ClientA: config.ClientEnd{ChainID: "2", ClientID: "link-2-3"},
+				ClientB: config.ClientEnd{ChainID: "3", ClientID: "link-2-3"},
+			},
+		}},
+	}
+	require.Equal(t, map[string]string{"link-1-2": "2", "link-1-3": "3"}, counterpartyChains(cfg, "1"))
+
+	m := manifest.New("1", "evm")
+	m.Core.Router = "0xrouter"
+	m.UpsertClient(manifest.Client{ClientID: "link-1-2", CounterpartyChainID: "2", CounterpartyClientID: "link-1-2"})
+	live := manifest.New("1", "evm")
+	live.Core.Router = "0xrouter"
+	live.UpsertClient(manifest.Client{ClientID: "link-1-2", CounterpartyChainID: "2", CounterpartyClientID: "stale"})
+	live.UpsertClient(manifest.Client{ClientID: "link-1-3", CounterpartyChainID: "3", CounterpartyClientID: "link-1-3"})
+
+	require.Equal(t, []deploy.Drift{
+		{Field: "core.router", Manifest: "0xrouter", Chain: "0xrouter", Config: "0xold"},
+		{
+			Field:    "clients[link-1-2].counterpartyClientId",
+			Manifest: "link-1-2",
+			Chain:    "stale",
+			Config:   "link-1-2",
+		},
+		{Field: "clients[link-1-3]", Chain: "registered", Config: "connection 3-1"},
+	}, configDrift(cfg, "1", m, live))
+}
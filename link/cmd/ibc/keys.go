@@ -3,8 +3,10 @@
 package main
 
 import (
+	"bytes"
 	"encoding/base64"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -12,6 +14,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/ibc/link/internal/config"
+	"github.com/cosmos/ibc/link/internal/pkg/hd"
 	"github.com/cosmos/ibc/link/internal/service/signer"
 	"github.com/cosmos/ibc/link/keyfile"
 )
@@ -20,8 +23,25 @@ var (
 	flagKeysShowPrivate      bool
 	flagKeysImportPrivateKey string
 	flagKeysPopulateConfig   bool
+	flagKeysEncrypt          bool
+	flagKeysKDF              string
+	flagKeysPassphraseEnv    string
+	flagKeysPassphraseFile   string
+	flagKeysMnemonic         bool
+	flagKeysMnemonicFile     string
+	flagKeysHDPath           string
+	flagKeysIndex            uint32
+
+	flagKeysImportKeystore         string
+	flagKeysExportFormat           string
+	flagKeysExportOut              string
+	flagKeysKeystorePassphraseEnv  string
+	flagKeysKeystorePassphraseFile string
 )
 
+// keysExportFormatKeystore is the only `keys export` format.
+const keysExportFormatKeystore = "keystore"
+
 var (
 	cmdKeys = &cobra.Command{
 		Use:   "keys",
@@ -36,10 +56,19 @@ var (
 		RunE:  keysNew,
 	}
 
+	cmdKeysRecover = &cobra.Command{
+		Use:   "recover [type] [name]",
+		Short: "Recover a signing key from a BIP-39 mnemonic",
+		Long: "Derives a key from a mnemonic at --hd-path/--index and saves it into <ibc-home>/keys/<name>, " +
+			"or prints its public details if name is not provided",
+		Args: cobra.RangeArgs(1, 2),
+		RunE: keysRecover,
+	}
+
 	cmdKeysImport = &cobra.Command{
 		Use:   "import [type] [name]",
 		Short: "Import a signing key",
-		Long:  "Import a private key into <ibc-home>/keys/<name>",
+		Long:  "Import a private key or an Ethereum V3 keystore into <ibc-home>/keys/<name>",
 		Args:  cobra.ExactArgs(2),
 		RunE:  keysImport,
 	}
@@ -58,6 +87,30 @@ var (
 		Long:  "Lists every key from <ibc-home>/keys/",
 		RunE:  keysList,
 	}
+
+	cmdKeysExport = &cobra.Command{
+		Use:   "export [name]",
+		Short: "Export a signing key",
+		Long:  "Export <ibc-home>/keys/<name> as an Ethereum V3 keystore to --out, or stdout",
+		Args:  cobra.ExactArgs(1),
+		RunE:  keysExport,
+	}
+
+	cmdKeysEncrypt = &cobra.Command{
+		Use:   "encrypt [name]",
+		Short: "Encrypt a plaintext key file with a passphrase",
+		Long:  "Rewrites <ibc-home>/keys/<name> in place, sealing the private key under a passphrase",
+		Args:  cobra.ExactArgs(1),
+		RunE:  keysEncrypt,
+	}
+
+	cmdKeysDecrypt = &cobra.Command{
+		Use:   "decrypt [name]",
+		Short: "Decrypt an encrypted key file back to plaintext",
+		Long:  "Rewrites <ibc-home>/keys/<name> in place as a plaintext key file",
+		Args:  cobra.ExactArgs(1),
+		RunE:  keysDecrypt,
+	}
 )
 
 //nolint:goconst // cli usage
@@ -74,7 +127,26 @@ func keysNew(_ *cobra.Command, args []string) error {
 		return err
 	}
 
-	key, err := signer.GenerateLocalKey(keyType)
+	var (
+		key      signer.LocalKey
+		mnemonic string
+		hdPath   string
+	)
+
+	switch {
+	case flagKeysMnemonic:
+		mnemonic, err = hd.NewMnemonic()
+		if err != nil {
+			return err
+		}
+
+		key, hdPath, err = signer.DeriveLocalKey(keyType, mnemonic, keysHDPath(keyType), flagKeysIndex)
+	case flagKeysMnemonicFile != "" || flagKeysHDPath != "" || flagKeysIndex != 0:
+		return fmt.Errorf("--mnemonic-file, --hd-path and --index require --mnemonic")
+	default:
+		key, err = signer.GenerateLocalKey(keyType)
+	}
+
 	if err != nil {
 		return err
 	}
@@ -85,48 +157,187 @@ func keysNew(_ *cobra.Command, args []string) error {
 		if flagKeysPopulateConfig {
 			return fmt.Errorf("--populate-config requires a key name")
 		}
+		if flagKeysEncrypt {
+			return fmt.Errorf("--encrypt requires a key name")
+		}
+
+		extra, err := mnemonicFields(mnemonic, hdPath)
+		if err != nil {
+			return err
+		}
 
 		// for ephemeral keys we print the key to stdout including the private key
-		return printKey(key, true, nil)
+		return printKey(key, true, extra)
 	}
 
-	keyPath, err := signer.KeyFilePath(globalFlags.Home, args[1])
+	key, keyPath, created, err := storeNamedKey(cfg, key, args[1])
+	if err != nil {
+		return err
+	}
+
+	extra := map[string]any{}
+	if created {
+		if extra, err = mnemonicFields(mnemonic, hdPath); err != nil {
+			return err
+		}
+	}
+
+	extra["path"] = keyPath
+
+	// note that we don't print the private key here to avoid leaking it to the user
+	return printKey(key, false, extra)
+}
+
+func keysRecover(_ *cobra.Command, args []string) error {
+	globalFlags.SkipConfigValidation()
+
+	cfg, err := setupHomeWithConfig()
+	if err != nil {
+		return err
+	}
+
+	keyType, err := signer.ParseKeyType(args[0])
+	if err != nil {
+		return err
+	}
+
+	mnemonic, err := readMnemonic()
+	if err != nil {
+		return err
+	}
+
+	key, hdPath, err := signer.DeriveLocalKey(keyType, mnemonic, keysHDPath(keyType), flagKeysIndex)
 	if err != nil {
 		return err
 	}
 
-	if err := key.StoreToFile(keyPath); err != nil {
+	if len(args) == 1 {
+		if flagKeysPopulateConfig {
+			return fmt.Errorf("--populate-config requires a key name")
+		}
+		if flagKeysEncrypt {
+			return fmt.Errorf("--encrypt requires a key name")
+		}
+
+		return printKey(key, false, map[string]any{"hdPath": hdPath})
+	}
+
+	key, keyPath, created, err := storeNamedKey(cfg, key, args[1])
+	if err != nil {
+		return err
+	}
+
+	extra := map[string]any{"path": keyPath}
+	if created {
+		extra["hdPath"] = hdPath
+	}
+
+	return printKey(key, false, extra)
+}
+
+// storeNamedKey stores key as <ibc-home>/keys/<name>, honouring --encrypt
+// and --populate-config. With --populate-config an existing key file is
+// kept and returned instead; created reports which happened.
+func storeNamedKey(cfg config.Config, key signer.LocalKey, name string) (signer.LocalKey, string, bool, error) {
+	keyPath, err := signer.KeyFilePath(globalFlags.Home, name)
+	if err != nil {
+		return nil, "", false, err
+	}
+
+	sc := keysSignerConfig(cfg, name)
+	created := true
+
+	if err := storeKey(key, keyPath, sc); err != nil {
 		switch {
 		case !os.IsExist(err):
-			return err
+			return nil, "", false, err
 		case !flagKeysPopulateConfig:
-			return fmt.Errorf("key already exists: %s", keyPath)
+			return nil, "", false, fmt.Errorf("key already exists: %s", keyPath)
 		}
 
 		// --populate-config: an already-existing key is fine, load what's
 		// actually on disk instead of erroring
-		key, err = signer.LocalKeyFromFile(keyPath)
+		key, err = signer.LocalKeyFromFileWithPassphrase(signer.PassphraseFromConfig(sc), keyPath)
 		if err != nil {
-			return err
+			return nil, "", false, err
 		}
+
+		created = false
 	}
 
 	if flagKeysPopulateConfig {
-		if err := addSignerToConfig(cfg, args[1]); err != nil {
-			return err
+		if err := addSignerToConfig(cfg, name); err != nil {
+			return nil, "", false, err
 		}
 	}
 
-	// note that we don't print the private key here to avoid leaking it to the user
-	return printKey(key, false, map[string]any{
-		"path": keyPath,
-	})
+	return key, keyPath, created, nil
+}
+
+func keysHDPath(keyType keyfile.Type) string {
+	if flagKeysHDPath != "" {
+		return flagKeysHDPath
+	}
+
+	return hd.DefaultPath(keyType)
+}
+
+// mnemonicFields reports a freshly generated mnemonic: written to
+// --mnemonic-file if given, otherwise printed, as it is the key's only
+// backup.
+func mnemonicFields(mnemonic, hdPath string) (map[string]any, error) {
+	if mnemonic == "" {
+		return map[string]any{}, nil
+	}
+
+	if flagKeysMnemonicFile == "" {
+		return map[string]any{"mnemonic": mnemonic, "hdPath": hdPath}, nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(flagKeysMnemonicFile), 0o700); err != nil {
+		return nil, err
+	}
+
+	file, err := os.OpenFile(flagKeysMnemonicFile, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := file.WriteString(mnemonic + "\n"); err != nil {
+		return nil, errors.Join(err, file.Close())
+	}
+
+	if err := file.Close(); err != nil {
+		return nil, err
+	}
+
+	return map[string]any{"mnemonicFile": flagKeysMnemonicFile, "hdPath": hdPath}, nil
+}
+
+// readMnemonic reads the mnemonic to recover from --mnemonic-file or a
+// prompt.
+func readMnemonic() (string, error) {
+	if flagKeysMnemonicFile != "" {
+		mnemonic, err := os.ReadFile(flagKeysMnemonicFile)
+		if err != nil {
+			return "", err
+		}
+
+		return string(mnemonic), nil
+	}
+
+	mnemonic, err := signer.ReadPassphrase("Mnemonic: ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(mnemonic), nil
 }
 
 func keysShow(_ *cobra.Command, args []string) error {
 	globalFlags.SkipConfigValidation()
 
-	_, err := setupHomeWithConfig()
+	cfg, err := setupHomeWithConfig()
 	if err != nil {
 		return err
 	}
@@ -136,7 +347,9 @@ func keysShow(_ *cobra.Command, args []string) error {
 		return err
 	}
 
-	key, err := signer.LocalKeyFromFile(keyPath)
+	passphrase := signer.PassphraseFromConfig(keysSignerConfig(cfg, args[0]))
+
+	key, err := signer.LocalKeyFromFileWithPassphrase(passphrase, keyPath)
 	if err != nil {
 		return err
 	}
@@ -159,7 +372,7 @@ func keysList(_ *cobra.Command, _ []string) error {
 		return err
 	}
 
-	keys, err := signer.LocalKeysFromDirectory(keyPath)
+	keys, err := signer.KeyFilesFromDirectory(keyPath)
 	if err != nil {
 		return err
 	}
@@ -168,9 +381,11 @@ func keysList(_ *cobra.Command, _ []string) error {
 
 	for _, key := range keys {
 		out = append(out, map[string]any{
-			"name": key.Name(),
-			"type": key.Type(),
-			"path": key.Path,
+			"name":      key.Name(),
+			"type":      key.Type,
+			"path":      key.Path,
+			"format":    key.Format,
+			"encrypted": key.Encrypted,
 		})
 	}
 
@@ -205,7 +420,8 @@ func keysImport(_ *cobra.Command, args []string) error {
 	case err == nil:
 		// --populate-config: reuse the existing keystore entry instead of
 		// erroring, no need to re-supply --private-key for it
-		key, loadErr := signer.LocalKeyFromFile(keyPath)
+		passphrase := signer.PassphraseFromConfig(keysSignerConfig(cfg, args[1]))
+		key, loadErr := signer.LocalKeyFromFileWithPassphrase(passphrase, keyPath)
 		if loadErr != nil {
 			return loadErr
 		}
@@ -219,13 +435,9 @@ func keysImport(_ *cobra.Command, args []string) error {
 		return err
 	}
 
-	if flagKeysImportPrivateKey == "" {
-		return fmt.Errorf("--private-key is required")
-	}
-
-	privateKey, err := signer.DecodeHex(flagKeysImportPrivateKey)
+	privateKey, err := importedPrivateKey()
 	if err != nil {
-		return fmt.Errorf("decode private key: %w", err)
+		return err
 	}
 
 	key, err := signer.NewLocalSecp256k1Signer(privateKey)
@@ -233,7 +445,7 @@ func keysImport(_ *cobra.Command, args []string) error {
 		return fmt.Errorf("create ecdsa key: %w", err)
 	}
 
-	if err := key.StoreToFile(keyPath); err != nil {
+	if err := storeKey(key, keyPath, keysSignerConfig(cfg, args[1])); err != nil {
 		if os.IsExist(err) {
 			return fmt.Errorf("key already exists: %s", keyPath)
 		}
@@ -251,6 +463,110 @@ func keysImport(_ *cobra.Command, args []string) error {
 	})
 }
 
+// importedPrivateKey reads the private key to import from --private-key, or
+// decrypts the V3 keystore given with --keystore.
+func importedPrivateKey() ([]byte, error) {
+	if flagKeysImportKeystore == "" {
+		if flagKeysImportPrivateKey == "" {
+			return nil, fmt.Errorf("--private-key or --keystore is required")
+		}
+
+		privateKey, err := signer.DecodeHex(flagKeysImportPrivateKey)
+		if err != nil {
+			return nil, fmt.Errorf("decode private key: %w", err)
+		}
+
+		return privateKey, nil
+	}
+
+	info, err := keyfile.Inspect(flagKeysImportKeystore)
+	switch {
+	case err != nil:
+		return nil, err
+	case info.Format != keyfile.FormatKeystore:
+		return nil, fmt.Errorf("not a V3 keystore: %s", flagKeysImportKeystore)
+	}
+
+	passphrase := func() ([]byte, error) { return signer.ReadPassphrase("Keystore passphrase: ") }
+	if flagKeysKeystorePassphraseEnv != "" || flagKeysKeystorePassphraseFile != "" {
+		passphrase = signer.PassphraseFromConfig(keystorePassphraseConfig())
+	}
+
+	_, privateKey, err := keyfile.LoadWithPassphrase(flagKeysImportKeystore, passphrase)
+
+	return privateKey, err
+}
+
+func keysExport(_ *cobra.Command, args []string) error {
+	globalFlags.SkipConfigValidation()
+
+	cfg, err := setupHomeWithConfig()
+	if err != nil {
+		return err
+	}
+
+	if flagKeysExportFormat != keysExportFormatKeystore {
+		return fmt.Errorf("unsupported export format %q, supported: %s", flagKeysExportFormat, keysExportFormatKeystore)
+	}
+
+	keyPath, err := signer.KeyFilePath(globalFlags.Home, args[0])
+	if err != nil {
+		return err
+	}
+
+	passphrase := signer.PassphraseFromConfig(keysSignerConfig(cfg, args[0]))
+
+	key, err := signer.LocalKeyFromFileWithPassphrase(passphrase, keyPath)
+	if err != nil {
+		return err
+	}
+
+	if key.Type() != keyfile.ECDSA {
+		return fmt.Errorf("only ecdsa keys can be exported as a keystore")
+	}
+
+	if flagKeysExportOut != "" {
+		if _, err := os.Stat(flagKeysExportOut); err == nil {
+			return fmt.Errorf("file already exists: %s", flagKeysExportOut)
+		}
+	}
+
+	keystorePassphrase, err := newPassphrase(keystorePassphraseConfig())
+	if err != nil {
+		return err
+	}
+
+	if flagKeysExportOut == "" {
+		data, err := keyfile.ExportKeystore(key.Type(), key.PrivateKey(), keystorePassphrase)
+		if err != nil {
+			return err
+		}
+
+		fmt.Println(string(data))
+
+		return nil
+	}
+
+	if err := keyfile.StoreKeystore(flagKeysExportOut, key.Type(), key.PrivateKey(), keystorePassphrase); err != nil {
+		return err
+	}
+
+	return printKey(key, false, map[string]any{
+		"path": flagKeysExportOut,
+	})
+}
+
+// keystorePassphraseConfig carries the --keystore-passphrase-* flags, which
+// apply to the V3 keystore rather than the key file under <ibc-home>/keys.
+func keystorePassphraseConfig() config.SignerConfig {
+	return config.SignerConfig{
+		Alias:          "keystore",
+		Type:           config.SignerLocal,
+		PassphraseEnv:  flagKeysKeystorePassphraseEnv,
+		PassphraseFile: flagKeysKeystorePassphraseFile,
+	}
+}
+
 // addSignerToConfig appends a local signer entry for the given key to the
 // config file, so a freshly created/imported key is immediately usable as a
 // `signers:` alias without a manual edit.
@@ -260,9 +576,11 @@ func addSignerToConfig(cfg config.Config, alias string) error {
 	}
 
 	cfg.Signers = append(cfg.Signers, config.SignerConfig{
-		Alias: alias,
-		Type:  config.SignerLocal,
-		File:  alias,
+		Alias:          alias,
+		Type:           config.SignerLocal,
+		File:           alias,
+		PassphraseEnv:  flagKeysPassphraseEnv,
+		PassphraseFile: flagKeysPassphraseFile,
 	})
 
 	configPath, err := globalFlags.ConfigPath()
@@ -273,6 +591,150 @@ func addSignerToConfig(cfg config.Config, alias string) error {
 	return cfg.StoreToFileWithComments(configPath)
 }
 
+func keysEncrypt(_ *cobra.Command, args []string) error {
+	globalFlags.SkipConfigValidation()
+
+	cfg, err := setupHomeWithConfig()
+	if err != nil {
+		return err
+	}
+
+	kdf, err := keyfile.ParseKDF(flagKeysKDF)
+	if err != nil {
+		return err
+	}
+
+	keyPath, err := signer.KeyFilePath(globalFlags.Home, args[0])
+	if err != nil {
+		return err
+	}
+
+	info, err := keyfile.Inspect(keyPath)
+	switch {
+	case err != nil:
+		return err
+	case info.Encrypted:
+		return fmt.Errorf("key is already encrypted: %s", keyPath)
+	}
+
+	passphrase, err := newPassphrase(keysSignerConfig(cfg, args[0]))
+	if err != nil {
+		return err
+	}
+
+	if err := keyfile.Encrypt(keyPath, passphrase, kdf); err != nil {
+		return err
+	}
+
+	return config.PrintJSON(map[string]any{
+		"path":      keyPath,
+		"encrypted": true,
+		"kdf":       kdf,
+	})
+}
+
+func keysDecrypt(_ *cobra.Command, args []string) error {
+	globalFlags.SkipConfigValidation()
+
+	cfg, err := setupHomeWithConfig()
+	if err != nil {
+		return err
+	}
+
+	keyPath, err := signer.KeyFilePath(globalFlags.Home, args[0])
+	if err != nil {
+		return err
+	}
+
+	info, err := keyfile.Inspect(keyPath)
+	switch {
+	case err != nil:
+		return err
+	case !info.Encrypted:
+		return fmt.Errorf("key is not encrypted: %s", keyPath)
+	}
+
+	passphrase, err := signer.PassphraseFromConfig(keysSignerConfig(cfg, args[0]))()
+	if err != nil {
+		return err
+	}
+
+	if err := keyfile.Decrypt(keyPath, passphrase); err != nil {
+		return err
+	}
+
+	return config.PrintJSON(map[string]any{
+		"path":      keyPath,
+		"encrypted": false,
+	})
+}
+
+// keysSignerConfig returns the signer entry whose passphrase settings apply
+// to key name: --passphrase-env/--passphrase-file if given, otherwise the
+// config's signer of the same alias, falling back to a prompt.
+func keysSignerConfig(cfg config.Config, name string) config.SignerConfig {
+	if flagKeysPassphraseEnv == "" && flagKeysPassphraseFile == "" {
+		if sc, ok := cfg.Signer(name); ok && sc.Type == config.SignerLocal {
+			return sc
+		}
+	}
+
+	return config.SignerConfig{
+		Alias:          name,
+		Type:           config.SignerLocal,
+		File:           name,
+		PassphraseEnv:  flagKeysPassphraseEnv,
+		PassphraseFile: flagKeysPassphraseFile,
+	}
+}
+
+// storeKey writes key to path, sealed under sc's passphrase with --encrypt.
+func storeKey(key signer.LocalKey, path string, sc config.SignerConfig) error {
+	if !flagKeysEncrypt {
+		return key.StoreToFile(path)
+	}
+
+	kdf, err := keyfile.ParseKDF(flagKeysKDF)
+	if err != nil {
+		return err
+	}
+
+	if _, err := os.Stat(path); err == nil {
+		return &os.PathError{Op: "open", Path: path, Err: os.ErrExist}
+	}
+
+	passphrase, err := newPassphrase(sc)
+	if err != nil {
+		return err
+	}
+
+	return key.StoreToEncryptedFile(path, passphrase, kdf)
+}
+
+// newPassphrase resolves the passphrase to encrypt a key with. Prompting
+// asks twice so a typo doesn't lock the key away.
+func newPassphrase(sc config.SignerConfig) ([]byte, error) {
+	if sc.PassphraseEnv != "" || sc.PassphraseFile != "" {
+		return signer.PassphraseFromConfig(sc)()
+	}
+
+	passphrase, err := signer.ReadPassphrase("New passphrase: ")
+	if err != nil {
+		return nil, err
+	}
+
+	confirm, err := signer.ReadPassphrase("Repeat passphrase: ")
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(passphrase, confirm) {
+		return nil, fmt.Errorf("passphrases do not match")
+	}
+
+	return passphrase, nil
+}
+
 func printKey(key signer.LocalKey, showPrivate bool, extra map[string]any) error {
 	kv := map[string]any{
 		"type":      key.Type(),
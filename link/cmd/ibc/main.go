@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/ibc/link/internal/config"
 	"github.com/cosmos/ibc/link/internal/deploy"
 	"github.com/cosmos/ibc/link/internal/pkg/logging"
+	"github.com/cosmos/ibc/link/keyfile"
 )
 
 // global globalFlags, loaded in config.DeclarePersistentFlags()
@@ -28,6 +29,10 @@ const useStatus = "status"
 // goconst across cmd/ibc.
 const useIFT = "ift"
 
+// useTransfer is the shared "transfer" subcommand name, factored out to
+// satisfy goconst across cmd/ibc.
+const useTransfer = "transfer"
+
 var rootCmd = &cobra.Command{
 	Use:   "ibc",
 	Short: "IBC Link",
@@ -59,6 +64,7 @@ func init() {
 		cmdConfig,
 		cmdRelayer,
 		cmdAttestor,
+		cmdAttestors,
 		cmdQuery,
 		cmdMigrate,
 		cmdKeys,
@@ -82,12 +88,69 @@ func init() {
 	}
 
 	// Keys commands
-	cmdKeys.AddCommand(cmdKeysNew, cmdKeysShow, cmdKeysImport, cmdKeysList)
+	cmdKeys.AddCommand(
+		cmdKeysNew,
+		cmdKeysRecover,
+		cmdKeysShow,
+		cmdKeysImport,
+		cmdKeysList,
+		cmdKeysExport,
+		cmdKeysEncrypt,
+		cmdKeysDecrypt,
+	)
 	cmdKeysShow.Flags().BoolVarP(&flagKeysShowPrivate, "private", "", false, "show private key")
 	cmdKeysImport.Flags().StringVar(&flagKeysImportPrivateKey, "private-key", "", "hex-encoded private key")
-	for _, c := range []*cobra.Command{cmdKeysNew, cmdKeysImport} {
+	cmdKeysImport.Flags().StringVar(&flagKeysImportKeystore, "keystore", "", "Ethereum V3 keystore file to import")
+	cmdKeysImport.MarkFlagsMutuallyExclusive("private-key", "keystore")
+	cmdKeysExport.Flags().
+		StringVar(&flagKeysExportFormat, "format", keysExportFormatKeystore, "export format [keystore]")
+	cmdKeysExport.Flags().StringVar(&flagKeysExportOut, "out", "", "write the export to this file instead of stdout")
+	for _, c := range []*cobra.Command{cmdKeysImport, cmdKeysExport} {
+		c.Flags().StringVar(
+			&flagKeysKeystorePassphraseEnv, "keystore-passphrase-env", "",
+			"read the V3 keystore passphrase from this environment variable",
+		)
+		c.Flags().StringVar(
+			&flagKeysKeystorePassphraseFile, "keystore-passphrase-file", "",
+			"read the V3 keystore passphrase from this file",
+		)
+		c.MarkFlagsMutuallyExclusive("keystore-passphrase-env", "keystore-passphrase-file")
+	}
+	cmdKeysNew.Flags().
+		BoolVar(&flagKeysMnemonic, "mnemonic", false, "derive the key from a newly generated BIP-39 mnemonic")
+	cmdKeysNew.Flags().StringVar(
+		&flagKeysMnemonicFile, "mnemonic-file", "",
+		"write the generated mnemonic to this file instead of printing it",
+	)
+	cmdKeysRecover.Flags().
+		StringVar(&flagKeysMnemonicFile, "mnemonic-file", "", "read the mnemonic from this file instead of prompting")
+	for _, c := range []*cobra.Command{cmdKeysNew, cmdKeysRecover} {
+		c.Flags().StringVar(
+			&flagKeysHDPath, "hd-path", "",
+			"account derivation path, the index is appended (default m/44'/60'/0'/0, fully hardened for eddsa)",
+		)
+		c.Flags().Uint32Var(&flagKeysIndex, "index", 0, "address index appended to --hd-path")
+	}
+	for _, c := range []*cobra.Command{cmdKeysNew, cmdKeysRecover, cmdKeysImport} {
 		c.Flags().
 			BoolVar(&flagKeysPopulateConfig, "populate-config", false, "append the resulting key as a signers entry in the config file")
+		c.Flags().BoolVar(&flagKeysEncrypt, "encrypt", false, "encrypt the key file with a passphrase")
+	}
+	for _, c := range []*cobra.Command{cmdKeysNew, cmdKeysRecover, cmdKeysImport, cmdKeysEncrypt} {
+		c.Flags().StringVar(
+			&flagKeysKDF, "kdf", string(keyfile.Argon2id),
+			"passphrase key derivation function [scrypt, argon2id]",
+		)
+	}
+	for _, c := range []*cobra.Command{
+		cmdKeysNew, cmdKeysRecover, cmdKeysImport, cmdKeysShow, cmdKeysExport, cmdKeysEncrypt, cmdKeysDecrypt,
+	} {
+		c.Flags().StringVar(
+			&flagKeysPassphraseEnv, "passphrase-env", "",
+			"read the key passphrase from this environment variable",
+		)
+		c.Flags().StringVar(&flagKeysPassphraseFile, "passphrase-file", "", "read the key passphrase from this file")
+		c.MarkFlagsMutuallyExclusive("passphrase-env", "passphrase-file")
 	}
 
 	// Relayer commands
@@ -108,6 +171,51 @@ func init() {
 	}
 	cmdAttestorStateAttestation.Flags().Uint64Var(&flagAttestorHeight, "height", 0, "height to attest")
 
+	// Attestors commands
+	cmdAttestors.AddCommand(cmdAttestorsEvidence, cmdAttestorsRotate)
+	cmdAttestorsEvidence.AddCommand(cmdAttestorsEvidenceClear)
+	cmdAttestorsEvidence.PersistentFlags().
+		StringVar(&flagRelayerHost, "host", "", "dial this address instead of resolving from config")
+	cmdAttestorsEvidence.Flags().
+		BoolVar(&flagAttestorsEvidenceIncludeCleared, "include-cleared", false, "also list evidence that was cleared")
+	rf := cmdAttestorsRotate.Flags()
+	rf.StringVar(
+		&flagAttestorsRotateSigner, "signer", "",
+		"signers[] alias of the new key (created with --generate/--keystore)",
+	)
+	rf.BoolVar(&flagAttestorsRotateGenerate, "generate", false, "generate the new key and add it as a signers entry")
+	rf.StringVar(&flagKeysImportKeystore, "keystore", "", "import the new key from this Ethereum V3 keystore")
+	rf.StringVar(
+		&flagKeysKeystorePassphraseEnv, "keystore-passphrase-env", "",
+		"read the V3 keystore passphrase from this environment variable",
+	)
+	rf.StringVar(
+		&flagKeysKeystorePassphraseFile, "keystore-passphrase-file", "",
+		"read the V3 keystore passphrase from this file",
+	)
+	rf.BoolVar(&flagKeysEncrypt, "encrypt", false, "encrypt the new key file with a passphrase")
+	rf.StringVar(&flagKeysKDF, "kdf", string(keyfile.Argon2id), "passphrase key derivation function [scrypt, argon2id]")
+	rf.StringVar(
+		&flagKeysPassphraseEnv, "passphrase-env", "",
+		"read the new key's passphrase from this environment variable",
+	)
+	rf.StringVar(&flagKeysPassphraseFile, "passphrase-file", "", "read the new key's passphrase from this file")
+	rf.Uint8Var(
+		&flagAttestorsRotateThreshold, "threshold", 0,
+		"attestation signature threshold for the migrated clients (default: keep each client's threshold)",
+	)
+	rf.BoolVar(
+		&flagAttestorsRotateDualSign, "dual-sign", false,
+		"stop after staging the new key so the attestor can be restarted serving both keys",
+	)
+	rf.StringVar(&flagDeployManifestDir, "manifest-dir", "deployments", "manifest directory relative to home")
+	rf.StringVar(&flagDeployDeployer, "deployer", "", "signer alias override for the migration transactions")
+	rf.BoolVar(&flagDeployDryRun, "dry-run", false, "print the migration plan without submitting transactions")
+	rf.BoolVar(&flagDeployYes, "yes", false, "skip confirmation prompts")
+	cmdAttestorsRotate.MarkFlagsMutuallyExclusive("generate", "keystore")
+	cmdAttestorsRotate.MarkFlagsMutuallyExclusive("keystore-passphrase-env", "keystore-passphrase-file")
+	cmdAttestorsRotate.MarkFlagsMutuallyExclusive("passphrase-env", "passphrase-file")
+
 	// Query commands
 	cmdQuery.AddCommand(cmdQueryIFT)
 	cmdQueryIFT.AddCommand(cmdQueryIFTBalance)
@@ -121,6 +229,20 @@ func init() {
 		StringVar(&flagQueryIFTAccount, "address", "", "account address, or a configured signer alias")
 	_ = cmdQueryIFTBalance.MarkFlagRequired("address")
 
+	cmdQuery.AddCommand(cmdQueryTransfer)
+	cmdQueryTransfer.AddCommand(cmdQueryTransferDenom)
+	qtf := cmdQueryTransfer.PersistentFlags()
+	qtf.StringVar(&flagQueryTransferChain, "chain", "", "chain ID the transfer app is deployed on")
+	qtf.StringVar(&flagQueryTransferApp, useTransfer, "", "transfer app address (default: from the manifest)")
+	qtf.StringVar(&flagDeployManifestDir, "manifest-dir", "deployments", "manifest directory relative to home")
+	_ = cmdQueryTransfer.MarkPersistentFlagRequired("chain")
+	cmdQueryTransferDenom.Flags().
+		StringVar(&flagQueryTransferDenom, "denom", "", "full denom path, e.g. transfer/link-1-2/uatom")
+	_ = cmdQueryTransferDenom.MarkFlagRequired("denom")
+	cmdQueryTransferDenom.Flags().
+		StringVar(&flagQueryTransferAccount, "address", "",
+			"account address, or a configured signer alias, to report the balance of")
+
 	// Migrate commands
 	cmdMigrate.AddCommand(cmdMigrateUp, cmdMigrateDown, cmdMigrateStatus)
 
@@ -128,15 +250,45 @@ func init() {
 	cmdDeploy.AddCommand(
 		cmdDeployCore, cmdDeployClient,
 		cmdDeployStatus, cmdDeployShow, cmdDeployRenderConfig,
-		cmdDeployGMP, cmdDeployIFT, cmdDeployIFTBridge,
+		cmdDeployGMP, cmdDeployTransfer, cmdDeployIFT, cmdDeployIFTBridge, cmdDeployRoles,
+		cmdDeployUpgrade, cmdDeployApply, cmdDeployReconcile, cmdDeployImport, cmdDeployDiff,
+		cmdDeployManifest,
 	)
+	cmdDeployManifest.AddCommand(cmdDeployManifestMigrate)
+	cmdDeploy.PersistentPreRunE = deployPersistentPreRun
 	dpf := cmdDeploy.PersistentFlags()
 	dpf.StringVar(&flagDeployManifestDir, "manifest-dir", "deployments", "manifest directory relative to home")
 	dpf.StringVar(&flagDeployDeployer, "deployer", "", "signer alias override for deployment transactions")
 	dpf.StringVar(&flagDeployChain, "chain", "", "chain ID for the chain being deployed to")
 	dpf.BoolVar(&flagDeployDryRun, "dry-run", false, "print the step plan without submitting transactions")
 	dpf.BoolVar(&flagDeployYes, "yes", false, "skip confirmation prompts")
+	dpf.StringVar(&flagDeploySalt, "salt", "",
+		"deploy through a CREATE2 factory under this salt, at the same addresses on every chain")
+	dpf.StringVar(&flagDeployCreate2Factory, "create2-factory", "",
+		"CREATE2 factory address (default: the deterministic deployment proxy)")
+	dpf.StringVar(&flagDeployExport, "export", "",
+		"record the plan's transactions to this directory instead of submitting them")
+	dpf.StringVar(&flagDeployExportFormat, "export-format", exportFormatSafe,
+		"exported plan format: safe (Transaction Builder batch) or bundle (unsigned transactions)")
+	dpf.StringVar(&flagDeployExportFrom, "export-from", "",
+		"address or signer alias executing the exported plan (default for bundles: deployer)")
+
+	cmdDeployImport.Flags().
+		StringVar(&flagDeployImportRouter, "router", "", "router address (default: from the manifest or config)")
+	cmdDeployImport.Flags().
+		StringSliceVar(&flagDeployImportIFTs, useIFT, nil, "IFT token addresses to import with their bridges")
+	cmdDeployImport.Flags().
+		BoolVar(&flagDeployImportForce, "force", false, "replace an existing manifest, keeping a .bak copy")
+	for _, c := range []*cobra.Command{cmdDeployImport, cmdDeployDiff} {
+		c.Flags().Uint64Var(&flagDeployFromBlock, "from-block", 0, "block to scan the router's events from")
+	}
+
+	cmdDeployApply.Flags().StringVarP(&flagDeployApplyFile, "file", "f", "", "topology file")
+	_ = cmdDeployApply.MarkFlagRequired("file")
+	cmdDeployApply.Flags().
+		StringVar(&flagDeployApplyOutput, "output", "relayer.yml", "relayer config to write, relative to home")
 
+	cmdDeployClient.AddCommand(cmdDeployClientUpdate)
 	cmdDeployClient.Flags().
 		StringVar(&flagDeployCounterparty, "counterparty-chain", "", "counterparty chain id the client tracks")
 	_ = cmdDeployClient.MarkFlagRequired("counterparty-chain")
@@ -148,17 +300,49 @@ func init() {
 	cmdDeployClient.Flags().
 		StringVar(&flagDeployClientID, "client-id", "", "client id (default: link-<a>-<b>, chain ids sorted)")
 	cmdDeployClient.Flags().
-		StringVar(&flagDeployCounterpartyCID, "counterparty-client-id", "", "counterparty's client id (default: link-<a>-<b>, chain ids sorted)")
+		StringVar(&flagDeployCounterpartyCID, "counterparty-client-id", "",
+			"counterparty's client id (default: link-<a>-<b>, chain ids sorted; "+
+				"the recorded one where its chain assigns ids)")
 	cmdDeployClient.Flags().
 		Uint64Var(&flagDeployHeight, "height", 0, "initial trusted height (default: counterparty head)")
 	cmdDeployClient.Flags().
 		Uint64Var(&flagDeployTimestamp, "timestamp", 0, "initial trusted timestamp seconds (default: counterparty head)")
 
+	cmdDeployClientUpdate.Flags().StringVar(&flagDeployUpdateClientID, "client-id", "", "client id to update")
+	_ = cmdDeployClientUpdate.MarkFlagRequired("client-id")
+	cmdDeployClientUpdate.Flags().
+		StringSliceVar(&flagDeployUpdateAdd, "add-attestor", nil,
+			"attestors to add: addresses, attestor names, or signer aliases")
+	cmdDeployClientUpdate.Flags().
+		StringSliceVar(&flagDeployUpdateRemove, "remove-attestor", nil,
+			"attestors to remove: addresses, attestor names, or signer aliases")
+	cmdDeployClientUpdate.Flags().
+		Uint8Var(&flagDeployUpdateThreshold, "threshold", 0, "new attestation signature threshold (default: keep)")
+
 	cmdDeployRenderConfig.Flags().
 		StringVar(&flagDeployRenderSignerA, "signer-a", "", "signers[] alias submitting relay txs on chainA")
 	cmdDeployRenderConfig.Flags().
 		StringVar(&flagDeployRenderSignerB, "signer-b", "", "signers[] alias submitting relay txs on chainB")
 
+	cmdDeployRoles.Flags().
+		StringSliceVar(&flagDeployRelayers, "relayers", nil,
+			"relayer addresses or signer aliases granted the relayer role (default: connection signers on --chain)")
+	cmdDeployRoles.Flags().
+		Uint64Var(&flagDeployRelayerRole, "relayer-role", deploy.DefaultRelayerRole, "role id relaying is restricted to")
+	cmdDeployRoles.Flags().
+		StringVar(&flagDeployAdmin, "admin", "",
+			"multisig or timelock address, or signer alias, to hand the admin role to")
+	cmdDeployRoles.Flags().
+		DurationVar(&flagDeployAdminDelay, "admin-delay", 0, "execution delay of the new admin's operations")
+
+	cmdDeployUpgrade.Flags().
+		StringVar(&flagDeployComponent, "component", "", "component to upgrade: router, gmp, transfer or ift")
+	_ = cmdDeployUpgrade.MarkFlagRequired("component")
+	cmdDeployUpgrade.Flags().
+		StringVar(&flagDeployUpgradeIFT, useIFT, "", "IFT token address to upgrade (default: every recorded token)")
+	cmdDeployUpgrade.Flags().
+		BoolVar(&flagDeployRollback, "rollback", false, "restore the implementation the last upgrade replaced")
+
 	// IFT commands
 	cmdDeployIFT.Flags().StringVar(&flagDeployIFTName, "name", "", "ERC20 token name")
 	cmdDeployIFT.Flags().StringVar(&flagDeployIFTSymbol, "symbol", "", "ERC20 token symbol (need not be unique)")
@@ -206,4 +390,25 @@ func init() {
 	for _, req := range []string{"client-id", "to", "amount"} {
 		_ = cmdTxIFTSend.MarkFlagRequired(req)
 	}
+
+	cmdTx.AddCommand(cmdTxTransfer)
+	cmdTxTransfer.AddCommand(cmdTxTransferSend)
+	ttf := cmdTxTransfer.PersistentFlags()
+	ttf.StringVar(&flagTxTransferChain, "chain", "", "chain ID the transfer app is deployed on")
+	ttf.StringVar(&flagTxTransferApp, useTransfer, "", "transfer app address (default: from the manifest)")
+	ttf.StringVar(&flagTxTransferFrom, "from", "", "signer alias to submit the transactions with")
+	ttf.StringVar(&flagDeployManifestDir, "manifest-dir", "deployments", "manifest directory relative to home")
+	for _, req := range []string{"chain", "from"} {
+		_ = cmdTxTransfer.MarkPersistentFlagRequired(req)
+	}
+	sf := cmdTxTransferSend.Flags()
+	sf.StringVar(&flagTxTransferClientID, "client-id", "", "source client id to send over")
+	sf.StringVar(&flagTxTransferDenom, "denom", "", "address of the ERC20 token to send")
+	sf.StringVar(&flagTxTransferAmount, "amount", "", "amount to send, in the token's base unit")
+	sf.StringVar(&flagTxTransferTo, "to", "", "receiver on the counterparty chain, or a configured signer alias")
+	sf.DurationVar(&flagTxTransferTimeout, "timeout", 15*time.Minute, "relative send timeout")
+	sf.StringVar(&flagTxTransferMemo, "memo", "", "ICS20 packet memo")
+	for _, req := range []string{"client-id", "denom", "to", "amount"} {
+		_ = cmdTxTransferSend.MarkFlagRequired(req)
+	}
 }
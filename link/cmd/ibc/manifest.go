@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/ibc/link/internal/config"
+	"github.com/cosmos/ibc/link/internal/deploy/manifest"
+)
+
+var (
+	cmdDeployManifest = &cobra.Command{
+		Use:   "manifest",
+		Short: "Manage deployment manifest files",
+	}
+
+	cmdDeployManifestMigrate = &cobra.Command{
+		Use:   "migrate",
+		Short: "Upgrade the manifests in the manifest directory to the current schema",
+		Long: "Rewrites every manifest of an older schema, or only --chain's, in the current one, keeping " +
+			"the original as <chain-id>.json.v<version>.bak. Current manifests are left as they are. " +
+			"Other deploy commands migrate a manifest whenever they save it; this migrates them all at once, " +
+			"for instance before committing them. With --dry-run, only the versions are reported.",
+		RunE: deployManifestMigrate,
+	}
+)
+
+func deployManifestMigrate(_ *cobra.Command, _ []string) error {
+	if _, err := setupHomeWithConfig(); err != nil {
+		return err
+	}
+	chains := []string{flagDeployChain}
+	if flagDeployChain == "" {
+		var err error
+		if chains, err = manifestChains(flagDeployManifestDir); err != nil {
+			return err
+		}
+	}
+	out := make([]manifest.Migration, 0, len(chains))
+	for _, chainID := range chains {
+		migration, err := manifest.Migrate(flagDeployManifestDir, chainID, flagDeployDryRun)
+		if err != nil {
+			return errors.Wrapf(err, "migrate manifest of chain %s", chainID)
+		}
+		out = append(out, migration)
+	}
+	return config.PrintJSON(out)
+}
+
+// manifestChains lists the chains with a manifest in dir, sorted.
+func manifestChains(dir string) ([]string, error) {
+	matches, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	chains := make([]string, 0, len(matches))
+	for _, path := range matches {
+		chains = append(chains, strings.TrimSuffix(filepath.Base(path), ".json"))
+	}
+	sort.Strings(chains)
+	return chains, nil
+}
@@ -82,8 +82,14 @@ func relayerRun(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
+	if err := app.Balances.Start(); err != nil {
+		_ = app.Server.Stop()
+		return err
+	}
+
 	if err := app.RelayerService.Start(); err != nil {
 		app.Logger.Error("Failed to start relayer dispatch loop", "err", err)
+		_ = app.Balances.Stop()
 		_ = app.Server.Stop()
 		return err
 	}
@@ -98,6 +104,7 @@ func relayerRun(cmd *cobra.Command, _ []string) error {
 		HTTP:            address.String(),
 	}); err != nil {
 		_ = app.RelayerService.Stop()
+		_ = app.Balances.Stop()
 		_ = app.Server.Stop()
 		return err
 	}
@@ -105,6 +112,7 @@ func relayerRun(cmd *cobra.Command, _ []string) error {
 	// executes from last to first
 	graceful.AddCallback(app.Store.Close)
 	graceful.AddCallback(app.Server.Stop)
+	graceful.AddCallback(app.Balances.Stop)
 	graceful.AddCallback(app.RelayerService.Stop)
 
 	// blocking
@@ -0,0 +1,236 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/cosmos/solidity-ibc-eureka/packages/go-abigen/ibcerc20"
+	"github.com/cosmos/solidity-ibc-eureka/packages/go-abigen/ics20transfer"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/ibc/link/internal/config"
+	"github.com/cosmos/ibc/link/internal/deploy"
+	"github.com/cosmos/ibc/link/internal/deploy/manifest"
+	"github.com/cosmos/ibc/link/internal/service/signer"
+)
+
+var (
+	cmdTxTransfer = &cobra.Command{
+		Use:   useTransfer,
+		Short: "ICS20 transfer transaction subcommands",
+	}
+
+	cmdTxTransferSend = &cobra.Command{
+		Use:   "send",
+		Short: "Send ERC20 tokens over ICS20 to a counterparty chain",
+		Long: "Approve the ICS20 transfer app to spend --amount of the ERC20 token at --denom, then send it " +
+			"over the client --client-id to --to on the counterparty chain. --transfer defaults to the " +
+			"transfer app recorded in the chain's deployment manifest.",
+		Example: "  ibc tx transfer send --chain 1 --client-id link-1-2 --denom 0xERC20Address... \\\n" +
+			"    --to cosmos1receiver... --amount 1000000 --from deployer",
+		RunE: txTransferSend,
+	}
+
+	cmdQueryTransfer = &cobra.Command{
+		Use:   useTransfer,
+		Short: "ICS20 transfer query subcommands",
+	}
+
+	cmdQueryTransferDenom = &cobra.Command{
+		Use:   "denom",
+		Short: "Query the ERC20 token the transfer app minted for a received denom",
+		Long: "Look up the IBCERC20 token the ICS20 transfer app created for the full denom path --denom " +
+			"(port/client/base denom). With --address, also report that account's balance of it.",
+		Example: "  ibc query transfer denom --chain 1 --denom transfer/link-1-2/uatom \\\n" +
+			"    --address 0xAccount...",
+		RunE: queryTransferDenom,
+	}
+)
+
+var (
+	flagTxTransferChain    string
+	flagTxTransferApp      string
+	flagTxTransferFrom     string
+	flagTxTransferClientID string
+	flagTxTransferDenom    string
+	flagTxTransferAmount   string
+	flagTxTransferTo       string
+	flagTxTransferTimeout  time.Duration
+	flagTxTransferMemo     string
+
+	flagQueryTransferChain   string
+	flagQueryTransferApp     string
+	flagQueryTransferDenom   string
+	flagQueryTransferAccount string
+)
+
+func txTransferSend(cmd *cobra.Command, _ []string) error {
+	amount := parseIFTAmount(flagTxTransferAmount)
+	if amount == nil || amount.Sign() == 0 {
+		return errors.Errorf("invalid --amount %q", flagTxTransferAmount)
+	}
+	if !common.IsHexAddress(flagTxTransferDenom) {
+		return errors.Errorf("invalid --denom %q: want the ERC20 token address", flagTxTransferDenom)
+	}
+	if flagTxTransferTimeout <= 0 {
+		return errors.Errorf("invalid --timeout %s: must be positive", flagTxTransferTimeout)
+	}
+
+	backend, from, chainID, cfg, err := dialTxChain(cmd.Context(), flagTxTransferChain, flagTxTransferFrom)
+	if err != nil {
+		return err
+	}
+
+	app, err := transferApp(flagTxTransferApp, flagTxTransferChain)
+	if err != nil {
+		return err
+	}
+
+	receiver, err := resolveReceiver(cmd.Context(), cfg, flagTxTransferTo)
+	if err != nil {
+		return err
+	}
+
+	opts, err := signer.NewTransactor(cmd.Context(), from, chainID)
+	if err != nil {
+		return err
+	}
+
+	token, err := ibcerc20.NewContract(common.HexToAddress(flagTxTransferDenom), backend)
+	if err != nil {
+		return err
+	}
+	tx, err := token.Approve(opts, common.HexToAddress(app), amount)
+	if err != nil {
+		return errors.Wrap(err, "approve")
+	}
+	if err := awaitTx(cmd.Context(), backend, "approve", tx); err != nil {
+		return err
+	}
+
+	contract, err := ics20transfer.NewContract(common.HexToAddress(app), backend)
+	if err != nil {
+		return err
+	}
+	tx, err = contract.SendTransfer(opts, ics20transfer.IICS20TransferMsgsSendTransferMsg{
+		Denom:            common.HexToAddress(flagTxTransferDenom),
+		Amount:           amount,
+		Receiver:         receiver,
+		SourceClient:     flagTxTransferClientID,
+		DestPort:         deploy.TransferPortID,
+		TimeoutTimestamp: uint64(time.Now().Add(flagTxTransferTimeout).Unix()),
+		Memo:             flagTxTransferMemo,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "sendTransfer %q", flagTxTransferClientID)
+	}
+	if err := awaitTx(cmd.Context(), backend, "sendTransfer", tx); err != nil {
+		return err
+	}
+
+	return printTxHash(tx.Hash().Hex())
+}
+
+func queryTransferDenom(cmd *cobra.Command, _ []string) error {
+	backend, cfg, err := dialQueryChain(cmd.Context(), flagQueryTransferChain)
+	if err != nil {
+		return err
+	}
+
+	app, err := transferApp(flagQueryTransferApp, flagQueryTransferChain)
+	if err != nil {
+		return err
+	}
+
+	contract, err := ics20transfer.NewContractCaller(common.HexToAddress(app), backend)
+	if err != nil {
+		return err
+	}
+
+	opts := &bind.CallOpts{Context: cmd.Context()}
+
+	erc20, err := contract.IbcERC20Contract(opts, flagQueryTransferDenom)
+	if err != nil {
+		return errors.Wrapf(err, "ibcERC20Contract %q (denom not received yet?)", flagQueryTransferDenom)
+	}
+
+	out := map[string]string{
+		"denom": flagQueryTransferDenom,
+		"erc20": erc20.Hex(),
+	}
+	if flagQueryTransferAccount == "" {
+		return config.PrintJSON(out)
+	}
+
+	account, err := resolveAddress(cmd.Context(), cfg, flagQueryTransferAccount)
+	if err != nil {
+		return err
+	}
+	token, err := ibcerc20.NewContractCaller(erc20, backend)
+	if err != nil {
+		return err
+	}
+	balance, err := token.BalanceOf(opts, common.HexToAddress(account))
+	if err != nil {
+		return errors.Wrap(err, "balanceOf")
+	}
+	symbol, err := token.Symbol(opts)
+	if err != nil {
+		return errors.Wrap(err, "symbol")
+	}
+	out["address"] = account
+	out["symbol"] = symbol
+	out["balance"] = balance.String()
+
+	return config.PrintJSON(out)
+}
+
+// transferApp returns address, or the transfer app recorded in chainID's
+// deployment manifest when it is empty.
+func transferApp(address, chainID string) (string, error) {
+	if address != "" {
+		if !common.IsHexAddress(address) {
+			return "", errors.Errorf("invalid transfer app address %q", address)
+		}
+		return address, nil
+	}
+	m, err := manifest.Load(flagDeployManifestDir, chainID)
+	if err != nil {
+		return "", err
+	}
+	if m == nil || m.Transfer == nil || m.Transfer.Address == "" {
+		return "", errors.Errorf(
+			"no transfer app recorded for chain %s in %s: pass --transfer or run `ibc deploy transfer`",
+			chainID, flagDeployManifestDir,
+		)
+	}
+	return m.Transfer.Address, nil
+}
+
+// resolveReceiver resolves a configured signer alias to its derived EVM
+// address and passes anything else through, since the receiver is an
+// address on the counterparty chain in its own format.
+func resolveReceiver(ctx context.Context, cfg config.Config, value string) (string, error) {
+	if sc, ok := cfg.Signer(value); ok {
+		return signer.EVMAddressOf(ctx, sc)
+	}
+	return value, nil
+}
+
+func awaitTx(ctx context.Context, backend *ethclient.Client, label string, tx *types.Transaction) error {
+	receipt, err := bind.WaitMined(ctx, backend, tx)
+	if err != nil {
+		return errors.Wrapf(err, "%s: wait mined", label)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return errors.Errorf("%s: transaction %s reverted", label, tx.Hash())
+	}
+	return nil
+}
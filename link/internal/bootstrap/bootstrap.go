@@ -6,6 +6,9 @@ import (
 	"context"
 	"log/slog"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+
 	"github.com/cosmos/ibc/link/internal/chains"
 	"github.com/cosmos/ibc/link/internal/config"
 	"github.com/cosmos/ibc/link/internal/relay/dispatch"
@@ -31,6 +34,9 @@ type Services struct {
 
 	RelayerService  *relayer.Service
 	AttestorService *attestor.Service
+
+	// Balances watches the relayer's wallet balances; nil for an attestor.
+	Balances *txsubmitter.BalanceMonitor
 }
 
 // BuildRelayer converts config into a runnable relayer process with all of the deps provisioned
@@ -79,7 +85,7 @@ func BuildRelayer(cfg config.Config) (*Services, error) {
 	}
 
 	// Proof generators
-	proofGenerators, err := proofgen.NewSetFromConfig(ctx, cfg, clientSet, append(local, remote...))
+	proofGenerators, err := proofgen.NewSetFromConfig(ctx, cfg, clientSet, append(local, remote...), db)
 	if err != nil {
 		return nil, err
 	}
@@ -116,9 +122,19 @@ func BuildRelayer(cfg config.Config) (*Services, error) {
 	// Handlers
 	relayerHandler := server.NewRelayerHandler(relayerService)
 
+	// Metrics
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		txSubmitters.Balances(),
+	)
+
 	// Server
 	srv := server.New(cfg.Server.ListenAddress, true)
 	srv.Register(relayerHandler)
+	srv.RegisterHealth(txSubmitters.Balances())
+	srv.RegisterMetrics(registry)
 
 	if attestorHandler != nil {
 		srv.Register(attestorHandler)
@@ -132,6 +148,7 @@ func BuildRelayer(cfg config.Config) (*Services, error) {
 		Signers:         signers,
 		RelayerService:  relayerService,
 		AttestorService: attestorService,
+		Balances:        txSubmitters.Balances(),
 	}, nil
 }
 
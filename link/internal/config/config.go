@@ -4,11 +4,14 @@
 package config
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/goccy/go-yaml"
 	"github.com/pkg/errors"
@@ -16,6 +19,8 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	"github.com/cosmos/ibc/link/internal/network"
+	"github.com/cosmos/ibc/link/internal/pkg/hd"
+	"github.com/cosmos/ibc/link/keyfile"
 )
 
 // Database type
@@ -25,9 +30,13 @@ const (
 )
 
 // Signer type. Local represents a private key file. Remote connects to cosmos/KMS.
+// Mnemonic derives a private key from a BIP-39 mnemonic and an index.
+// PKCS11 signs with a key held on a PKCS#11 token such as an HSM.
 const (
-	SignerLocal  = "local"
-	SignerRemote = "remote"
+	SignerLocal    = "local"
+	SignerRemote   = "remote"
+	SignerMnemonic = "mnemonic"
+	SignerPKCS11   = "pkcs11"
 )
 
 const sqliteInMemory = ":memory:"
@@ -74,14 +83,38 @@ type AttestorConfig struct {
 	// attestations.
 	Signer string `yaml:"signer"`
 
+	// NextSigner local only -- the signer an in-progress key rotation moves
+	// to. While set, the attestor also serves the next key under NextName,
+	// so attestations stay available whichever key the on-chain set holds.
+	NextSigner string `yaml:"nextSigner,omitempty"`
+
+	// Scheme local only -- how attestations are encoded and signed [evm,
+	// eddsa], defaults to evm. The signer's key type must match: ecdsa for
+	// evm, eddsa for eddsa.
+	Scheme AttestationScheme `yaml:"scheme,omitempty"`
+
 	// FinalityOffset local only. Zero attests up to the chain's "finalized"
 	// tag; n > 0 attests up to "latest" - n instead.
 	FinalityOffset uint `yaml:"finalityOffset"`
 
+	// CacheSize local only. Bounds each attestation cache (state attestations
+	// by height, commitments by height and path, packet signatures by
+	// attested data). Zero uses the default; negative disables caching.
+	CacheSize int `yaml:"cacheSize,omitempty"`
+
 	// GRPC required for type: remote only. Bare host:port.
 	GRPC string `yaml:"grpc,omitempty"`
 }
 
+// NextAttestorSuffix is appended to a local attestor's name to form the
+// name its next key is served under during a key rotation.
+const NextAttestorSuffix = "-next"
+
+// NextName returns the name the attestor's next key is served under.
+func (c AttestorConfig) NextName() string {
+	return c.Name + NextAttestorSuffix
+}
+
 // Signers is the list of configured signer backends.
 type Signers []SignerConfig
 
@@ -90,17 +123,82 @@ type SignerConfig struct {
 	// Alias unique name for a signer
 	Alias string `yaml:"alias"`
 
-	// Type [local, remote]
+	// Type [local, remote, mnemonic, pkcs11]
 	Type string `yaml:"type"`
 
 	// File key file path for a local signer
 	File string `yaml:"file,omitempty"`
 
+	// PassphraseEnv environment variable holding the passphrase of an
+	// encrypted local key file, or the user PIN of a pkcs11 signer's token
+	PassphraseEnv string `yaml:"passphraseEnv,omitempty"`
+
+	// PassphraseFile file holding the passphrase of an encrypted local key
+	// file, or the user PIN of a pkcs11 signer's token
+	PassphraseFile string `yaml:"passphraseFile,omitempty"`
+
 	// GRPC address for a remote signer
 	GRPC string `yaml:"grpc,omitempty"`
 
 	// RemoteKeyID KMS key ID for a remote signer
 	RemoteKeyID string `yaml:"remoteKeyId,omitempty"`
+
+	// Timeout deadline of each request to a remote signer's KMS
+	Timeout *time.Duration `yaml:"timeout,omitempty"`
+
+	// Retries how often a remote signer retries a sign request that failed
+	// transiently; 0 disables retries
+	Retries *int `yaml:"retries,omitempty"`
+
+	// KeyCheckInterval how often a remote signer re-reads its KMS key to
+	// check the public key is unchanged; 0 disables the check
+	KeyCheckInterval *time.Duration `yaml:"keyCheckInterval,omitempty"`
+
+	// MnemonicFile file holding the BIP-39 mnemonic of a mnemonic signer
+	MnemonicFile string `yaml:"mnemonicFile,omitempty"`
+
+	// MnemonicEnv environment variable holding the BIP-39 mnemonic of a
+	// mnemonic signer
+	MnemonicEnv string `yaml:"mnemonicEnv,omitempty"`
+
+	// KeyType [ecdsa, eddsa] of a mnemonic signer, defaults to ecdsa
+	KeyType string `yaml:"keyType,omitempty"`
+
+	// HDPath account derivation path of a mnemonic signer, Index is appended
+	HDPath string `yaml:"hdPath,omitempty"`
+
+	// Index address index of a mnemonic signer
+	Index uint32 `yaml:"index,omitempty"`
+
+	// PKCS11Module path to the PKCS#11 library of a pkcs11 signer's token
+	PKCS11Module string `yaml:"pkcs11Module,omitempty"`
+
+	// TokenLabel label of the token holding a pkcs11 signer's key
+	TokenLabel string `yaml:"tokenLabel,omitempty"`
+
+	// KeyLabel CKA_LABEL of a pkcs11 signer's key
+	KeyLabel string `yaml:"keyLabel,omitempty"`
+
+	// KeyID hex CKA_ID of a pkcs11 signer's key
+	KeyID string `yaml:"keyId,omitempty"`
+}
+
+// MnemonicKeyType returns the key type of a mnemonic signer.
+func (c SignerConfig) MnemonicKeyType() keyfile.Type {
+	if c.KeyType == "" {
+		return keyfile.ECDSA
+	}
+
+	return keyfile.Type(c.KeyType)
+}
+
+// MnemonicHDPath returns the account derivation path of a mnemonic signer.
+func (c SignerConfig) MnemonicHDPath() string {
+	if c.HDPath == "" {
+		return hd.DefaultPath(c.MnemonicKeyType())
+	}
+
+	return c.HDPath
 }
 
 // ChainType the execution environment of a chain.
@@ -108,22 +206,42 @@ type ChainType string
 
 // Chain types
 const (
-	ChainTypeEVM ChainType = "evm"
+	ChainTypeEVM    ChainType = "evm"
+	ChainTypeCosmos ChainType = "cosmos"
 )
 
 // ChainConfig chain information shared by the attestor and relayer.
 type ChainConfig struct {
-	ChainID string          `yaml:"chainId"`
-	EVM     *EVMChainConfig `yaml:"evm,omitempty"`
+	ChainID string             `yaml:"chainId"`
+	EVM     *EVMChainConfig    `yaml:"evm,omitempty"`
+	Cosmos  *CosmosChainConfig `yaml:"cosmos,omitempty"`
 
 	// Deployer optional signer alias used by `ibc deploy` for this chain.
 	Deployer string `yaml:"deployer,omitempty"`
+
+	// Create2 optional deterministic deployment settings used by
+	// `ibc deploy` for this chain.
+	Create2 *Create2Config `yaml:"create2,omitempty"`
+}
+
+// Create2Config deploys contracts through a CREATE2 factory, at addresses
+// that depend only on the factory, the salt and the contracts.
+type Create2Config struct {
+	// Salt 0x-prefixed 32 bytes, or any string, which is hashed.
+	Salt string `yaml:"salt"`
+
+	// Factory optional factory address; defaults to the deterministic
+	// deployment proxy at 0x4e59b44847b379578588920cA78FbF26c0B4956C.
+	Factory string `yaml:"factory,omitempty"`
 }
 
 // Type returns the chain type implied by the configured settings.
 func (c ChainConfig) Type() ChainType {
-	if c.EVM != nil {
+	switch {
+	case c.EVM != nil:
 		return ChainTypeEVM
+	case c.Cosmos != nil:
+		return ChainTypeCosmos
 	}
 
 	return ""
@@ -135,6 +253,21 @@ type EVMChainConfig struct {
 	ICS26Router string `yaml:"ics26Router"`
 }
 
+// CosmosChainConfig Cosmos SDK chain details. Cosmos chains are deployment
+// targets only: `ibc deploy` provisions their clients, the relayer and
+// attestor do not run against them.
+type CosmosChainConfig struct {
+	// GRPC the node's gRPC endpoint, host:port.
+	GRPC string `yaml:"grpc"`
+
+	// AccountPrefix optional bech32 prefix of account addresses; defaults
+	// to "cosmos".
+	AccountPrefix string `yaml:"accountPrefix,omitempty"`
+
+	// GasPrice the fee paid per unit of gas, like "0.025stake".
+	GasPrice string `yaml:"gasPrice"`
+}
+
 // DefaultConfig sample config using default values and Sqlite.
 func DefaultConfig() Config {
 	return Config{
@@ -236,6 +369,9 @@ func (c Config) crossValidate() error {
 		if _, exists := signerSet[a.Signer]; !exists {
 			return errors.Errorf(".attestors[%d].signer references unknown signer: %q", i, a.Signer)
 		}
+		if _, exists := signerSet[a.NextSigner]; a.NextSigner != "" && !exists {
+			return errors.Errorf(".attestors[%d].nextSigner references unknown signer: %q", i, a.NextSigner)
+		}
 	}
 
 	for _, chain := range c.Chains {
@@ -247,6 +383,18 @@ func (c Config) crossValidate() error {
 		}
 	}
 
+	for _, chain := range c.Relayer.ChainOverrides {
+		if chain.Balance == nil || chain.Balance.TopUp == nil {
+			continue
+		}
+		if _, exists := signerSet[chain.Balance.TopUp.Treasury]; !exists {
+			return errors.Errorf(
+				".relayer.chainOverrides[%s].balance.topUp.treasury references unknown signer: %q",
+				chain.ChainID, chain.Balance.TopUp.Treasury,
+			)
+		}
+	}
+
 	if err := c.validateChainReferences(); err != nil {
 		return err
 	}
@@ -274,7 +422,7 @@ type ChainSignerPair struct {
 }
 
 // RelayerChainSignerPairs resolves the unique (chain, signer) pairs across
-// every configured connection's two client ends.
+// every configured connection's two client ends, one per pool member.
 func RelayerChainSignerPairs(c Config) []ChainSignerPair {
 	seen := make(map[ChainSignerPair]struct{})
 
@@ -282,13 +430,15 @@ func RelayerChainSignerPairs(c Config) []ChainSignerPair {
 
 	for _, conn := range c.Relayer.Connections {
 		for _, end := range []ClientEnd{conn.ClientA, conn.ClientB} {
-			pair := ChainSignerPair{ChainID: end.ChainID, SignerAlias: end.Signer}
-			if _, dup := seen[pair]; dup {
-				continue
+			for _, alias := range end.Signer {
+				pair := ChainSignerPair{ChainID: end.ChainID, SignerAlias: alias}
+				if _, dup := seen[pair]; dup {
+					continue
+				}
+
+				seen[pair] = struct{}{}
+				pairs = append(pairs, pair)
 			}
-
-			seen[pair] = struct{}{}
-			pairs = append(pairs, pair)
 		}
 	}
 
@@ -318,16 +468,18 @@ func (c Config) validateChainReferences() error {
 	return nil
 }
 
-// validateConnectionSigners ensures every client end's signer resolves to a
-// configured signer.
+// validateConnectionSigners ensures every client end's signer, or each
+// member of its signer pool, resolves to a configured signer.
 func (c Config) validateConnectionSigners(signerSet map[string]struct{}) error {
 	for _, conn := range c.Relayer.Connections {
 		for _, end := range connectionEnds(conn) {
-			if _, exists := signerSet[end.cfg.Signer]; !exists {
-				return errors.Errorf(
-					"connection %q %s references unknown signer %q",
-					conn.Alias, end.label, end.cfg.Signer,
-				)
+			for _, alias := range end.cfg.Signer {
+				if _, exists := signerSet[alias]; !exists {
+					return errors.Errorf(
+						"connection %q %s references unknown signer %q",
+						conn.Alias, end.label, alias,
+					)
+				}
 			}
 		}
 	}
@@ -384,10 +536,29 @@ func (c ChainConfig) Validate() error {
 		return errors.New(".chainId required")
 	}
 
+	if c.EVM != nil && c.Cosmos != nil {
+		return errors.New(".evm and .cosmos are mutually exclusive")
+	}
+
 	if c.Type() == ChainTypeEVM && c.EVM.RPC == "" {
 		return errors.New(".evm.rpc required")
 	}
 
+	if c.Type() == ChainTypeCosmos {
+		switch {
+		case c.Cosmos.GRPC == "":
+			return errors.New(".cosmos.grpc required")
+		case c.Cosmos.GasPrice == "":
+			return errors.New(".cosmos.gasPrice required")
+		case c.Create2 != nil:
+			return errors.New(".create2 applies to evm chains only")
+		}
+	}
+
+	if c.Create2 != nil && c.Create2.Salt == "" {
+		return errors.New(".create2.salt required")
+	}
+
 	return nil
 }
 
@@ -487,16 +658,27 @@ func (a Attestors) Validate() error {
 			continue
 		}
 
-		if _, exists := localNames[attestor.Name]; exists {
-			return errors.Errorf("duplicate local attestor name: %q", attestor.Name)
+		// a rotating attestor also serves its next key under NextName
+		names, signers := []string{attestor.Name}, []string{attestor.Signer}
+		if attestor.NextSigner != "" {
+			names = append(names, attestor.NextName())
+			signers = append(signers, attestor.NextSigner)
+		}
+
+		for _, name := range names {
+			if _, exists := localNames[name]; exists {
+				return errors.Errorf("duplicate local attestor name: %q", name)
+			}
+			localNames[name] = struct{}{}
 		}
-		localNames[attestor.Name] = struct{}{}
 
-		chainSigner := attestor.ChainID + "/" + attestor.Signer
-		if _, exists := localChainSigners[chainSigner]; exists {
-			return errors.Errorf("duplicate local attestor signer %q on chain %q", attestor.Signer, attestor.ChainID)
+		for _, signer := range signers {
+			chainSigner := attestor.ChainID + "/" + signer
+			if _, exists := localChainSigners[chainSigner]; exists {
+				return errors.Errorf("duplicate local attestor signer %q on chain %q", signer, attestor.ChainID)
+			}
+			localChainSigners[chainSigner] = struct{}{}
 		}
-		localChainSigners[chainSigner] = struct{}{}
 	}
 
 	return nil
@@ -519,6 +701,8 @@ func (c AttestorConfig) Validate() error {
 			return errors.New(".signer required for local attestors")
 		case c.GRPC != "":
 			return errors.New(".grpc must not be set for local attestors")
+		case c.Scheme != "" && c.Scheme != AttestationSchemeEVM && c.Scheme != AttestationSchemeEdDSA:
+			return errors.Errorf(".scheme unknown attestation scheme: %q", c.Scheme)
 		}
 	case AttestorTypeRemote:
 		switch {
@@ -532,6 +716,12 @@ func (c AttestorConfig) Validate() error {
 			return errors.New(".signer must not be set for remote attestors")
 		case c.FinalityOffset != 0:
 			return errors.New(".finalityOffset must not be set for remote attestors")
+		case c.CacheSize != 0:
+			return errors.New(".cacheSize must not be set for remote attestors")
+		case c.NextSigner != "":
+			return errors.New(".nextSigner must not be set for remote attestors")
+		case c.Scheme != "":
+			return errors.New(".scheme must not be set for remote attestors")
 		}
 	}
 
@@ -562,14 +752,95 @@ func (c SignerConfig) Validate() error {
 		return errors.New(".alias required")
 	case c.Type == "":
 		return errors.New(".type required")
-	case c.Type != SignerLocal && c.Type != SignerRemote:
-		return errors.Errorf(".type must be one of [%q, %q], got %q", SignerLocal, SignerRemote, c.Type)
+	case c.Type != SignerLocal && c.Type != SignerRemote && c.Type != SignerMnemonic && c.Type != SignerPKCS11:
+		return errors.Errorf(
+			".type must be one of [%q, %q, %q, %q], got %q",
+			SignerLocal, SignerRemote, SignerMnemonic, SignerPKCS11, c.Type,
+		)
 	case c.Type == SignerLocal && c.File == "":
 		return errors.New(".file required for local signer")
 	case c.Type == SignerRemote && c.GRPC == "":
 		return errors.New(".grpc required for remote signer")
 	case c.Type == SignerRemote && c.RemoteKeyID == "":
 		return errors.New(".remoteKeyId required for remote signer")
+	case c.Type != SignerRemote && (c.Timeout != nil || c.Retries != nil || c.KeyCheckInterval != nil):
+		return errors.New(".timeout, .retries and .keyCheckInterval only apply to remote signers")
+	case c.Timeout != nil && *c.Timeout <= 0:
+		return errors.New(".timeout must be positive")
+	case c.Retries != nil && *c.Retries < 0:
+		return errors.New(".retries must not be negative")
+	case c.KeyCheckInterval != nil && *c.KeyCheckInterval < 0:
+		return errors.New(".keyCheckInterval must not be negative")
+	case c.Type != SignerLocal && c.Type != SignerPKCS11 && (c.PassphraseEnv != "" || c.PassphraseFile != ""):
+		return errors.New(".passphraseEnv and .passphraseFile only apply to local and pkcs11 signers")
+	case c.PassphraseEnv != "" && c.PassphraseFile != "":
+		return errors.New(".passphraseEnv and .passphraseFile are mutually exclusive")
+	case c.Type == SignerMnemonic && c.MnemonicFile == "" && c.MnemonicEnv == "":
+		return errors.New(".mnemonicFile or .mnemonicEnv required for mnemonic signer")
+	case c.MnemonicFile != "" && c.MnemonicEnv != "":
+		return errors.New(".mnemonicFile and .mnemonicEnv are mutually exclusive")
+	case c.Type != SignerMnemonic && (c.MnemonicFile != "" || c.MnemonicEnv != "" ||
+		c.KeyType != "" || c.HDPath != "" || c.Index != 0):
+		return errors.New(".mnemonicFile, .mnemonicEnv, .keyType, .hdPath and .index only apply to mnemonic signers")
+	case c.Type == SignerPKCS11 && c.PKCS11Module == "":
+		return errors.New(".pkcs11Module required for pkcs11 signer")
+	case c.Type == SignerPKCS11 && c.TokenLabel == "":
+		return errors.New(".tokenLabel required for pkcs11 signer")
+	case c.Type == SignerPKCS11 && c.KeyLabel == "" && c.KeyID == "":
+		return errors.New(".keyLabel or .keyId required for pkcs11 signer")
+	case c.Type != SignerPKCS11 && (c.PKCS11Module != "" || c.TokenLabel != "" || c.KeyLabel != "" || c.KeyID != ""):
+		return errors.New(".pkcs11Module, .tokenLabel, .keyLabel and .keyId only apply to pkcs11 signers")
+	}
+
+	if c.Type == SignerPKCS11 {
+		if _, err := hex.DecodeString(strings.TrimPrefix(c.KeyID, "0x")); err != nil {
+			return errors.Wrap(err, ".keyId")
+		}
+
+		path, err := ExpandHome(c.PKCS11Module)
+		if err != nil {
+			return errors.Wrap(err, ".pkcs11Module")
+		}
+
+		if err := fileExists(path); err != nil {
+			return errors.Wrapf(err, ".pkcs11Module %s", path)
+		}
+	}
+
+	if c.Type == SignerMnemonic {
+		if _, err := keyfile.ParseType(string(c.MnemonicKeyType())); err != nil {
+			return errors.Wrap(err, ".keyType")
+		}
+
+		path, err := hd.ParsePath(c.MnemonicHDPath())
+		if err != nil {
+			return errors.Wrap(err, ".hdPath")
+		}
+
+		if c.MnemonicKeyType() == keyfile.EDDSA && slices.ContainsFunc(path, hd.NotHardened) {
+			return errors.New(".hdPath must be fully hardened for eddsa")
+		}
+
+		if c.Index >= hd.HardenedOffset {
+			return errors.Errorf(".index must be below %d", hd.HardenedOffset)
+		}
+
+		if c.MnemonicFile != "" {
+			path, err := ExpandHome(c.MnemonicFile)
+			if err != nil {
+				return errors.Wrap(err, ".mnemonicFile")
+			}
+
+			if err := fileExists(path); err != nil {
+				return errors.Wrapf(err, ".mnemonicFile %s", path)
+			}
+		}
+	}
+
+	if c.Type == SignerLocal || c.Type == SignerPKCS11 {
+		if err := c.validatePassphraseFile(); err != nil {
+			return err
+		}
 	}
 
 	if c.Type == SignerLocal {
@@ -588,6 +859,23 @@ func (c SignerConfig) Validate() error {
 	return nil
 }
 
+func (c SignerConfig) validatePassphraseFile() error {
+	if c.PassphraseFile == "" {
+		return nil
+	}
+
+	path, err := ExpandHome(c.PassphraseFile)
+	if err != nil {
+		return errors.Wrap(err, ".passphraseFile")
+	}
+
+	if err := fileExists(path); err != nil {
+		return errors.Wrapf(err, ".passphraseFile %s", path)
+	}
+
+	return nil
+}
+
 // KeyFileFallbacks returns the paths tried for a local signer key file.
 func KeyFileFallbacks(keyPath string) []string {
 	fallbacks := []string{keyPath}
@@ -681,11 +969,11 @@ func CollectComments(cfg Config) map[string]string {
 	}
 
 	for i, conn := range cfg.Relayer.Connections {
-		if conn.ClientA.Signer == "" {
+		if conn.ClientA.Signer.String() == "" {
 			path := fmt.Sprintf("$.relayer.connections[%d].clientA.signer", i)
 			comments[path] = "TODO: signers[] alias that submits relay txs on chainA"
 		}
-		if conn.ClientB.Signer == "" {
+		if conn.ClientB.Signer.String() == "" {
 			path := fmt.Sprintf("$.relayer.connections[%d].clientB.signer", i)
 			comments[path] = "TODO: signers[] alias that submits relay txs on chainB"
 		}
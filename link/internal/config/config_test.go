@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -402,6 +403,14 @@ func TestAttestorsValidate(t *testing.T) {
 			}},
 			errContains: ".finalityOffset must not be set for remote attestors",
 		},
+		{
+			name: "remote with cacheSize set",
+			attestors: Attestors{{
+				Name: "attestor-a", Type: AttestorTypeRemote,
+				GRPC: "attestor-a.example.com:3000", CacheSize: 16,
+			}},
+			errContains: ".cacheSize must not be set for remote attestors",
+		},
 		{
 			name: "duplicate local name",
 			attestors: Attestors{
@@ -425,6 +434,59 @@ func TestAttestorsValidate(t *testing.T) {
 				{Name: "attestor-b", ChainID: "chain-b", Type: AttestorTypeLocal, Signer: "same"},
 			},
 		},
+		{
+			name: "local rotating to a next signer",
+			attestors: Attestors{
+				{Name: "attestor-a", ChainID: "chain-a", Type: AttestorTypeLocal, Signer: "old", NextSigner: "new"},
+			},
+		},
+		{
+			name: "next signer same as signer",
+			attestors: Attestors{
+				{Name: "attestor-a", ChainID: "chain-a", Type: AttestorTypeLocal, Signer: "same", NextSigner: "same"},
+			},
+			errContains: `duplicate local attestor signer "same" on chain "chain-a"`,
+		},
+		{
+			name: "next name collides with another attestor",
+			attestors: Attestors{
+				{Name: "attestor-a", ChainID: "chain-a", Type: AttestorTypeLocal, Signer: "old", NextSigner: "new"},
+				{Name: "attestor-a-next", ChainID: "chain-b", Type: AttestorTypeLocal, Signer: "other"},
+			},
+			errContains: `duplicate local attestor name: "attestor-a-next"`,
+		},
+		{
+			name: "remote with nextSigner set",
+			attestors: Attestors{{
+				Name: "attestor-a", Type: AttestorTypeRemote,
+				GRPC: "attestor-a.example.com:3000", NextSigner: "new",
+			}},
+			errContains: ".nextSigner must not be set for remote attestors",
+		},
+		{
+			name: "local eddsa scheme",
+			attestors: Attestors{
+				{
+					Name: "attestor-a", ChainID: "chain-a", Type: AttestorTypeLocal,
+					Signer: "ed", Scheme: AttestationSchemeEdDSA,
+				},
+			},
+		},
+		{
+			name: "unknown scheme",
+			attestors: Attestors{
+				{Name: "attestor-a", ChainID: "chain-a", Type: AttestorTypeLocal, Signer: "ed", Scheme: "schnorr"},
+			},
+			errContains: `.scheme unknown attestation scheme: "schnorr"`,
+		},
+		{
+			name: "remote with scheme set",
+			attestors: Attestors{{
+				Name: "attestor-a", Type: AttestorTypeRemote,
+				GRPC: "attestor-a.example.com:3000", Scheme: AttestationSchemeEdDSA,
+			}},
+			errContains: ".scheme must not be set for remote attestors",
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			// ACT
@@ -508,6 +570,138 @@ func TestSignerConfigValidate(t *testing.T) {
 			}},
 			errContains: ".file",
 		},
+		{
+			name: "valid encrypted local",
+			signers: Signers{{
+				Alias:          "local",
+				Type:           SignerLocal,
+				File:           keyFile,
+				PassphraseFile: keyFile,
+			}},
+		},
+		{
+			name: "passphrase file must exist",
+			signers: Signers{{
+				Alias:          "local",
+				Type:           SignerLocal,
+				File:           keyFile,
+				PassphraseFile: filepath.Join(t.TempDir(), "missing.txt"),
+			}},
+			errContains: ".passphraseFile",
+		},
+		{
+			name: "passphrase sources exclusive",
+			signers: Signers{{
+				Alias:          "local",
+				Type:           SignerLocal,
+				File:           keyFile,
+				PassphraseEnv:  "IBC_PASSPHRASE",
+				PassphraseFile: keyFile,
+			}},
+			errContains: "mutually exclusive",
+		},
+		{
+			name: "passphrase only for local",
+			signers: Signers{{
+				Alias:         "remote",
+				Type:          SignerRemote,
+				GRPC:          "https://kms.example.com",
+				RemoteKeyID:   "key-1",
+				PassphraseEnv: "IBC_PASSPHRASE",
+			}},
+			errContains: "only apply to local and pkcs11 signers",
+		},
+		{
+			name: "valid mnemonic",
+			signers: Signers{{
+				Alias:        "mnemonic",
+				Type:         SignerMnemonic,
+				MnemonicFile: keyFile,
+				Index:        3,
+			}},
+		},
+		{
+			name: "mnemonic source required",
+			signers: Signers{{
+				Alias: "mnemonic",
+				Type:  SignerMnemonic,
+			}},
+			errContains: ".mnemonicFile or .mnemonicEnv required",
+		},
+		{
+			name: "mnemonic eddsa path must be hardened",
+			signers: Signers{{
+				Alias:       "mnemonic",
+				Type:        SignerMnemonic,
+				MnemonicEnv: "IBC_MNEMONIC",
+				KeyType:     "eddsa",
+				HDPath:      "m/44'/60'/0'/0",
+			}},
+			errContains: "fully hardened",
+		},
+		{
+			name: "mnemonic fields only for mnemonic",
+			signers: Signers{{
+				Alias: "local",
+				Type:  SignerLocal,
+				File:  keyFile,
+				Index: 1,
+			}},
+			errContains: "only apply to mnemonic signers",
+		},
+		{
+			name: "valid pkcs11",
+			signers: Signers{{
+				Alias:          "hsm",
+				Type:           SignerPKCS11,
+				PKCS11Module:   keyFile,
+				TokenLabel:     "ibc",
+				KeyID:          "0x01",
+				PassphraseFile: keyFile,
+			}},
+		},
+		{
+			name: "pkcs11 key required",
+			signers: Signers{{
+				Alias:        "hsm",
+				Type:         SignerPKCS11,
+				PKCS11Module: keyFile,
+				TokenLabel:   "ibc",
+			}},
+			errContains: ".keyLabel or .keyId required",
+		},
+		{
+			name: "pkcs11 module must exist",
+			signers: Signers{{
+				Alias:        "hsm",
+				Type:         SignerPKCS11,
+				PKCS11Module: filepath.Join(t.TempDir(), "missing.so"),
+				TokenLabel:   "ibc",
+				KeyLabel:     "attestor",
+			}},
+			errContains: ".pkcs11Module",
+		},
+		{
+			name: "pkcs11 key id must be hex",
+			signers: Signers{{
+				Alias:        "hsm",
+				Type:         SignerPKCS11,
+				PKCS11Module: keyFile,
+				TokenLabel:   "ibc",
+				KeyID:        "attestor",
+			}},
+			errContains: ".keyId",
+		},
+		{
+			name: "pkcs11 fields only for pkcs11",
+			signers: Signers{{
+				Alias:    "local",
+				Type:     SignerLocal,
+				File:     keyFile,
+				KeyLabel: "attestor",
+			}},
+			errContains: "only apply to pkcs11 signers",
+		},
 		{
 			name: "remote grpc required",
 			signers: Signers{{
@@ -526,6 +720,50 @@ func TestSignerConfigValidate(t *testing.T) {
 			}},
 			errContains: ".remoteKeyId required",
 		},
+		{
+			name: "valid remote resilience",
+			signers: Signers{{
+				Alias:            "remote",
+				Type:             SignerRemote,
+				GRPC:             "https://kms.example.com",
+				RemoteKeyID:      "key-1",
+				Timeout:          new(2 * time.Second),
+				Retries:          new(0),
+				KeyCheckInterval: new(time.Duration(0)),
+			}},
+		},
+		{
+			name: "resilience remote only",
+			signers: Signers{{
+				Alias:   "local",
+				Type:    SignerLocal,
+				File:    keyFile,
+				Retries: new(2),
+			}},
+			errContains: "only apply to remote signers",
+		},
+		{
+			name: "remote timeout positive",
+			signers: Signers{{
+				Alias:       "remote",
+				Type:        SignerRemote,
+				GRPC:        "https://kms.example.com",
+				RemoteKeyID: "key-1",
+				Timeout:     new(time.Duration(0)),
+			}},
+			errContains: ".timeout must be positive",
+		},
+		{
+			name: "remote retries not negative",
+			signers: Signers{{
+				Alias:       "remote",
+				Type:        SignerRemote,
+				GRPC:        "https://kms.example.com",
+				RemoteKeyID: "key-1",
+				Retries:     new(-1),
+			}},
+			errContains: ".retries must not be negative",
+		},
 		{
 			name: "duplicate alias",
 			signers: Signers{
@@ -638,7 +876,7 @@ func TestCollectComments(t *testing.T) {
 			{ChainID: "2", EVM: &EVMChainConfig{ICS26Router: "0xabc"}},
 		},
 		Relayer: RelayerConfig{Connections: []ConnectionConfig{
-			{ClientA: ClientEnd{ChainID: "1"}, ClientB: ClientEnd{ChainID: "2", Signer: "relayer-key"}},
+			{ClientA: ClientEnd{ChainID: "1"}, ClientB: ClientEnd{ChainID: "2", Signer: SignerAliases{"relayer-key"}}},
 		}},
 		Attestors: Attestors{
 			{ChainID: "2", Name: "attestor-2-0xabc", Type: AttestorTypeLocal},
@@ -661,7 +899,10 @@ func TestCollectComments(t *testing.T) {
 	}, CollectComments(Config{
 		Chains: []ChainConfig{{ChainID: "1", EVM: &EVMChainConfig{ICS26Router: "0xabc"}}},
 		Relayer: RelayerConfig{Connections: []ConnectionConfig{
-			{ClientA: ClientEnd{ChainID: "1", Signer: "a"}, ClientB: ClientEnd{ChainID: "2", Signer: "b"}},
+			{
+				ClientA: ClientEnd{ChainID: "1", Signer: SignerAliases{"a"}},
+				ClientB: ClientEnd{ChainID: "2", Signer: SignerAliases{"b"}},
+			},
 		}},
 		Attestors: Attestors{{ChainID: "1", Name: "a", Type: AttestorTypeLocal, Signer: "watcher"}},
 	}))
@@ -3,8 +3,12 @@
 package config
 
 import (
+	"math/big"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/goccy/go-yaml"
 	"github.com/pkg/errors"
 )
 
@@ -25,6 +29,72 @@ const (
 	AttestorTypeLocal  AttestorType = "local"
 )
 
+// AttestationScheme how a local attestor encodes and signs attestations.
+type AttestationScheme string
+
+// Attestation schemes
+const (
+	// AttestationSchemeEVM ABI encoding signed with secp256k1, for
+	// counterparty clients on EVM chains. The default.
+	AttestationSchemeEVM AttestationScheme = "evm"
+	// AttestationSchemeEdDSA compact binary encoding signed with ed25519,
+	// for counterparty clients that verify ed25519, such as on Solana or
+	// Cosmos chains.
+	AttestationSchemeEdDSA AttestationScheme = "eddsa"
+)
+
+// SignerStrategy how a signer pool picks the wallet for each submission.
+type SignerStrategy string
+
+// Signer strategies
+const (
+	SignerStrategyLeastPending SignerStrategy = "least-pending"
+	SignerStrategyRoundRobin   SignerStrategy = "round-robin"
+)
+
+// SignerAliases the signers a client end submits with. In YAML it is
+// either one alias or a list of aliases forming a pool that submissions
+// are spread across.
+type SignerAliases []string
+
+// UnmarshalYAML accepts a single alias or a list of aliases.
+func (s *SignerAliases) UnmarshalYAML(data []byte) error {
+	var alias string
+	if err := yaml.Unmarshal(data, &alias); err == nil {
+		*s = SignerAliases{alias}
+		if alias == "" {
+			*s = nil
+		}
+
+		return nil
+	}
+
+	var aliases []string
+	if err := yaml.Unmarshal(data, &aliases); err != nil {
+		return errors.New("signer must be an alias or a list of aliases")
+	}
+
+	*s = aliases
+
+	return nil
+}
+
+// MarshalYAML writes a single alias as a plain string.
+func (s SignerAliases) MarshalYAML() (any, error) {
+	if len(s) <= 1 {
+		return s.String(), nil
+	}
+
+	return []string(s), nil
+}
+
+// String returns the alias, or a pool's aliases sorted and joined by
+// commas. It keys the client end's tx submitter, so a pool listed in any
+// order is one submitter.
+func (s SignerAliases) String() string {
+	return strings.Join(slices.Sorted(slices.Values(s)), ",")
+}
+
 // RelayerConfig the relayer block of the config.
 type RelayerConfig struct {
 	DispatchPollInterval *time.Duration         `yaml:"dispatchPollInterval,omitempty"`
@@ -39,6 +109,55 @@ type RelayerChainOverride struct {
 	TxSubmissionDelay  *time.Duration    `yaml:"txSubmissionDelay,omitempty"`
 	PacketBatchSize    *int              `yaml:"packetBatchSize,omitempty"`
 	PacketBatchTimeout *time.Duration    `yaml:"packetBatchTimeout,omitempty"`
+
+	// SignerStrategy how signer pools on the chain pick a wallet:
+	// least-pending (default) or round-robin.
+	SignerStrategy SignerStrategy `yaml:"signerStrategy,omitempty"`
+	// SignerCooldown how long a pool wallet that ran out of funds or hit
+	// a stuck nonce is taken out of rotation, doubling while it keeps
+	// failing.
+	SignerCooldown *time.Duration `yaml:"signerCooldown,omitempty"`
+
+	// Balance relayer wallet balance thresholds and top-up on the chain.
+	Balance *BalanceConfig `yaml:"balance,omitempty"`
+}
+
+// Wei an amount of a chain's native token in its smallest unit, written
+// as an integer such as "1500000000000000000" or "1.5e18".
+type Wei string
+
+// Int the amount; ok is false unless it is a non-negative integer.
+func (w Wei) Int() (amount *big.Int, ok bool) {
+	const precision = 256
+
+	f, _, err := big.ParseFloat(string(w), 10, precision, big.ToNearestEven)
+	if err != nil || f.Sign() < 0 || !f.IsInt() {
+		return nil, false
+	}
+
+	amount, _ = f.Int(nil)
+
+	return amount, true
+}
+
+// BalanceConfig balance monitoring of the relayer wallets on one chain.
+type BalanceConfig struct {
+	// Warn a wallet at or below Warn is reported low.
+	Warn Wei `yaml:"warn,omitempty"`
+	// Critical a wallet at or below Critical stops submitting until its
+	// balance recovers.
+	Critical Wei `yaml:"critical,omitempty"`
+	// CheckInterval how often wallet balances are read.
+	CheckInterval *time.Duration `yaml:"checkInterval,omitempty"`
+	// TopUp refills wallets from a treasury signer.
+	TopUp *TopUpConfig `yaml:"topUp,omitempty"`
+}
+
+// TopUpConfig moves funds from a treasury signer to relayer wallets on the
+// chain that fall below Target, bringing them back to Target.
+type TopUpConfig struct {
+	Treasury string `yaml:"treasury"`
+	Target   Wei    `yaml:"target"`
 }
 
 // RelayerEVMConfig EVM relaying settings.
@@ -59,14 +178,33 @@ type ConnectionConfig struct {
 // ClientEnd one side of a connection: a light client on chainId,
 // tracking the connection's other end as its counterparty
 type ClientEnd struct {
-	ChainID  string     `yaml:"chainId"`
-	Signer   string     `yaml:"signer"`
-	ClientID string     `yaml:"clientId"`
-	Type     ClientType `yaml:"type"`
+	ChainID  string        `yaml:"chainId"`
+	Signer   SignerAliases `yaml:"signer"`
+	ClientID string        `yaml:"clientId"`
+	Type     ClientType    `yaml:"type"`
 
 	// AutoRelay configures auto-relay for packets flowing FROM this end's
 	// chain TOWARD the counterparty end.
 	AutoRelay AutoRelayConfig `yaml:"autoRelay,omitempty"`
+
+	// Quorum tunes how attestation quorums for this client's proofs are
+	// collected.
+	Quorum QuorumConfig `yaml:"quorum,omitempty"`
+}
+
+// QuorumConfig attestation quorum collection settings.
+type QuorumConfig struct {
+	// Hedge when set queries only threshold + Hedge attestors (healthiest
+	// first) up front, bringing in the rest once one of them fails or
+	// HedgeDelay passes without a quorum. Unset queries every attestor at
+	// once.
+	Hedge *int `yaml:"hedge,omitempty"`
+	// HedgeDelay how long a hedged query waits for a quorum before querying
+	// the remaining attestors.
+	HedgeDelay *time.Duration `yaml:"hedgeDelay,omitempty"`
+	// ExcludeMisbehaving leaves attestors with uncleared misbehaviour
+	// evidence out of quorums until an operator clears it.
+	ExcludeMisbehaving bool `yaml:"excludeMisbehaving,omitempty"`
 }
 
 // AutoRelayConfig automatic relaying settings.
@@ -183,10 +321,18 @@ func (c ClientEnd) Validate() error {
 		return errors.New(".chainId required")
 	case c.ClientID == "":
 		return errors.New(".clientId required")
-	case c.Signer == "":
+	case len(c.Signer) == 0:
 		return errors.New(".signer required")
+	case slices.Contains(c.Signer, ""):
+		return errors.New(".signer must not contain empty aliases")
+	case len(slices.Compact(slices.Sorted(slices.Values(c.Signer)))) != len(c.Signer):
+		return errors.New(".signer must not repeat an alias")
 	case c.Type != ClientTypeAttestation:
 		return errors.Errorf(".type unknown client type: %q", c.Type)
+	case c.Quorum.Hedge != nil && *c.Quorum.Hedge < 0:
+		return errors.New(".quorum.hedge must not be negative")
+	case c.Quorum.HedgeDelay != nil && *c.Quorum.HedgeDelay <= 0:
+		return errors.New(".quorum.hedgeDelay must be positive")
 	}
 
 	return nil
@@ -202,6 +348,14 @@ func (c RelayerChainOverride) Validate() error {
 		return errors.New(".packetBatchSize must be positive")
 	case c.PacketBatchTimeout != nil && *c.PacketBatchTimeout <= 0:
 		return errors.New(".packetBatchTimeout must be positive")
+	case c.SignerStrategy != "" && c.SignerStrategy != SignerStrategyLeastPending &&
+		c.SignerStrategy != SignerStrategyRoundRobin:
+		return errors.Errorf(
+			".signerStrategy must be one of [%q, %q], got %q",
+			SignerStrategyLeastPending, SignerStrategyRoundRobin, c.SignerStrategy,
+		)
+	case c.SignerCooldown != nil && *c.SignerCooldown <= 0:
+		return errors.New(".signerCooldown must be positive")
 	}
 
 	if c.EVM != nil {
@@ -210,6 +364,55 @@ func (c RelayerChainOverride) Validate() error {
 		}
 	}
 
+	if c.Balance != nil {
+		if err := c.Balance.Validate(); err != nil {
+			return errors.Wrap(err, ".balance")
+		}
+	}
+
+	return nil
+}
+
+func (c BalanceConfig) Validate() error {
+	amounts := make(map[string]*big.Int)
+
+	for field, value := range map[string]Wei{"warn": c.Warn, "critical": c.Critical} {
+		if value == "" {
+			continue
+		}
+
+		amount, ok := value.Int()
+		if !ok {
+			return errors.Errorf(".%s must be a non-negative integer amount in wei, got %q", field, value)
+		}
+
+		amounts[field] = amount
+	}
+
+	warn, critical := amounts["warn"], amounts["critical"]
+
+	switch {
+	case warn != nil && critical != nil && critical.Cmp(warn) >= 0:
+		return errors.New(".critical must be below .warn")
+	case c.CheckInterval != nil && *c.CheckInterval <= 0:
+		return errors.New(".checkInterval must be positive")
+	case c.TopUp == nil:
+		return nil
+	case c.TopUp.Treasury == "":
+		return errors.New(".topUp.treasury required")
+	}
+
+	target, ok := c.TopUp.Target.Int()
+
+	switch {
+	case !ok || target.Sign() == 0:
+		return errors.Errorf(".topUp.target must be a positive integer amount in wei, got %q", c.TopUp.Target)
+	case warn != nil && target.Cmp(warn) <= 0:
+		return errors.New(".topUp.target must be above .warn")
+	case critical != nil && target.Cmp(critical) <= 0:
+		return errors.New(".topUp.target must be above .critical")
+	}
+
 	return nil
 }
 
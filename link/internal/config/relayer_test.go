@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/goccy/go-yaml"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -43,7 +44,7 @@ func TestRelayerConfig(t *testing.T) {
 		clientA := conn.ClientA
 		assert.Equal(t, "1", clientA.ChainID)
 		assert.Equal(t, "base-0", clientA.ClientID)
-		assert.Equal(t, "relayer-key", clientA.Signer)
+		assert.Equal(t, SignerAliases{"relayer-key"}, clientA.Signer)
 		assert.Equal(t, ClientTypeAttestation, clientA.Type)
 
 		assert.False(t, *clientA.AutoRelay.Enabled)
@@ -57,6 +58,52 @@ func TestRelayerConfig(t *testing.T) {
 		assert.Nil(t, clientB.AutoRelay.Enabled)
 	})
 
+	t.Run("SignerPool", func(t *testing.T) {
+		for name, tc := range map[string]struct {
+			yaml     string
+			expected SignerAliases
+		}{
+			"single": {yaml: "signer: relayer-0\n", expected: SignerAliases{"relayer-0"}},
+			"pool":   {yaml: "signer: [relayer-0, relayer-1]\n", expected: SignerAliases{"relayer-0", "relayer-1"}},
+		} {
+			t.Run(name, func(t *testing.T) {
+				// ACT
+				var end ClientEnd
+				err := yaml.Unmarshal([]byte(tc.yaml), &end)
+
+				// ASSERT
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, end.Signer)
+
+				out, err := yaml.Marshal(ClientEnd{Signer: end.Signer})
+				require.NoError(t, err)
+
+				var roundTrip ClientEnd
+				require.NoError(t, yaml.Unmarshal(out, &roundTrip))
+				assert.Equal(t, tc.expected, roundTrip.Signer)
+			})
+		}
+
+		t.Run("singleMarshalsAsString", func(t *testing.T) {
+			out, err := yaml.Marshal(ClientEnd{Signer: SignerAliases{"relayer-0"}})
+			require.NoError(t, err)
+			assert.Contains(t, string(out), "signer: relayer-0\n")
+		})
+
+		t.Run("keyIgnoresOrder", func(t *testing.T) {
+			pool := SignerAliases{"relayer-1", "relayer-0"}
+			assert.Equal(t, "relayer-0,relayer-1", pool.String())
+			assert.Equal(t, SignerAliases{"relayer-0", "relayer-1"}.String(), pool.String())
+			assert.Equal(t, SignerAliases{"relayer-1", "relayer-0"}, pool)
+		})
+
+		t.Run("invalid", func(t *testing.T) {
+			var end ClientEnd
+			err := yaml.Unmarshal([]byte("signer: {alias: relayer-0}\n"), &end)
+			require.ErrorContains(t, err, "alias or a list of aliases")
+		})
+	})
+
 	t.Run("Helpers", func(t *testing.T) {
 		// ARRANGE
 		path := filepath.Join("testdata", "sample.yml")
@@ -110,6 +157,28 @@ func TestRelayerConfig(t *testing.T) {
 				},
 				errContains: ".evm.rpc required",
 			},
+			{
+				name: "chain create2 missing salt",
+				patch: func(c *Config) {
+					c.Chains[0].Create2 = &Create2Config{Factory: "0x4e59b44847b379578588920cA78FbF26c0B4956C"}
+				},
+				errContains: ".create2.salt required",
+			},
+			{
+				name: "chain both evm and cosmos",
+				patch: func(c *Config) {
+					c.Chains[0].Cosmos = &CosmosChainConfig{GRPC: "localhost:9090", GasPrice: "0stake"}
+				},
+				errContains: ".evm and .cosmos are mutually exclusive",
+			},
+			{
+				name: "cosmos chain missing gas price",
+				patch: func(c *Config) {
+					c.Chains[0].EVM = nil
+					c.Chains[0].Cosmos = &CosmosChainConfig{GRPC: "localhost:9090"}
+				},
+				errContains: ".cosmos.gasPrice required",
+			},
 			{
 				name: "duplicate top-level chainId",
 				patch: func(c *Config) {
@@ -238,24 +307,104 @@ func TestRelayerConfig(t *testing.T) {
 			{
 				name: "clientA signer unknown",
 				patch: func(c *Config) {
-					c.Relayer.Connections[0].ClientA.Signer = "ghost"
+					c.Relayer.Connections[0].ClientA.Signer = SignerAliases{"ghost"}
 				},
 				errContains: `references unknown signer "ghost"`,
 			},
 			{
 				name: "clientB signer unknown",
 				patch: func(c *Config) {
-					c.Relayer.Connections[0].ClientB.Signer = "ghost"
+					c.Relayer.Connections[0].ClientB.Signer = SignerAliases{"ghost"}
 				},
 				errContains: `references unknown signer "ghost"`,
 			},
+			{
+				name: "clientA signer pool member unknown",
+				patch: func(c *Config) {
+					c.Relayer.Connections[0].ClientA.Signer = SignerAliases{"relayer-key", "ghost"}
+				},
+				errContains: `references unknown signer "ghost"`,
+			},
+			{
+				name: "clientA signer pool repeats alias",
+				patch: func(c *Config) {
+					c.Relayer.Connections[0].ClientA.Signer = SignerAliases{"relayer-key", "relayer-key"}
+				},
+				errContains: ".signer must not repeat an alias",
+			},
+			{
+				name: "invalid signer strategy",
+				patch: func(c *Config) {
+					c.Relayer.ChainOverrides[0].SignerStrategy = "random"
+				},
+				errContains: ".signerStrategy must be one of",
+			},
+			{
+				name: "balance thresholds with top-up",
+				patch: func(c *Config) {
+					c.Relayer.ChainOverrides[0].Balance = &BalanceConfig{
+						Warn:     "1e18",
+						Critical: "200000000000000000",
+						TopUp:    &TopUpConfig{Treasury: "attestor-dan-key", Target: "5e18"},
+					}
+				},
+			},
+			{
+				name: "balance critical not below warn",
+				patch: func(c *Config) {
+					c.Relayer.ChainOverrides[0].Balance = &BalanceConfig{Warn: "1e18", Critical: "1e18"}
+				},
+				errContains: ".balance: .critical must be below .warn",
+			},
+			{
+				name: "balance amount not an integer",
+				patch: func(c *Config) {
+					c.Relayer.ChainOverrides[0].Balance = &BalanceConfig{Warn: "0.5"}
+				},
+				errContains: `.warn must be a non-negative integer amount in wei, got "0.5"`,
+			},
+			{
+				name: "top-up target not above warn",
+				patch: func(c *Config) {
+					c.Relayer.ChainOverrides[0].Balance = &BalanceConfig{
+						Warn:  "1e18",
+						TopUp: &TopUpConfig{Treasury: "attestor-dan-key", Target: "1e17"},
+					}
+				},
+				errContains: ".topUp.target must be above .warn",
+			},
+			{
+				name: "top-up treasury unknown",
+				patch: func(c *Config) {
+					c.Relayer.ChainOverrides[0].Balance = &BalanceConfig{
+						TopUp: &TopUpConfig{Treasury: "ghost", Target: "1e18"},
+					}
+				},
+				errContains: `.balance.topUp.treasury references unknown signer: "ghost"`,
+			},
 			{
 				name: "clientA missing signer",
 				patch: func(c *Config) {
-					c.Relayer.Connections[0].ClientA.Signer = ""
+					c.Relayer.Connections[0].ClientA.Signer = nil
 				},
 				errContains: ".signer required",
 			},
+			{
+				name: "negative quorum hedge",
+				patch: func(c *Config) {
+					hedge := -1
+					c.Relayer.Connections[0].ClientA.Quorum.Hedge = &hedge
+				},
+				errContains: ".clientA: .quorum.hedge must not be negative",
+			},
+			{
+				name: "non-positive quorum hedge delay",
+				patch: func(c *Config) {
+					delay := time.Duration(0)
+					c.Relayer.Connections[0].ClientB.Quorum.HedgeDelay = &delay
+				},
+				errContains: ".clientB: .quorum.hedgeDelay must be positive",
+			},
 		} {
 			t.Run(tt.name, func(t *testing.T) {
 				// ARRANGE
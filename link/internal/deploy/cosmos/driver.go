@@ -0,0 +1,342 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Package cosmos implements the deploy Target for Cosmos SDK chains running
+// ibc-go's IBC v2. The core stack is the chain's ibc module, so deployment
+// provisions only light clients, through transactions and queries over the
+// node's gRPC endpoint.
+package cosmos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	clienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
+	clientv2types "github.com/cosmos/ibc-go/v11/modules/core/02-client/v2/types"
+	"github.com/cosmos/ibc-go/v11/modules/core/exported"
+	"github.com/cosmos/ibc-go/v11/modules/light-clients/attestations"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/ibc/link/internal/deploy"
+	"github.com/cosmos/ibc/link/internal/service/signer"
+)
+
+// TargetName is the target name Cosmos manifests record.
+const TargetName = "cosmos"
+
+// Router is what Cosmos manifests record as the core router: the ibc module,
+// which routes for every client and app of the chain.
+const Router = "ibc"
+
+// DefaultAccountPrefix is the bech32 prefix of simd's account addresses.
+const DefaultAccountPrefix = "cosmos"
+
+// nanosPerSecond converts the seconds of deploy's trusted timestamps to the
+// nanoseconds of ibc-go consensus states.
+const nanosPerSecond = uint64(time.Second)
+
+// ibcMerklePrefix is the prefix of ibc-go's commitments: the ibc store, at
+// its root.
+var ibcMerklePrefix = [][]byte{[]byte(exported.StoreKey), {}}
+
+// evmMerklePrefix is the default counterparty prefix, as in deploy.ClientSpec.
+var evmMerklePrefix = [][]byte{{}}
+
+var (
+	_ deploy.Target           = (*Driver)(nil)
+	_ deploy.ClientIDAssigner = (*Driver)(nil)
+)
+
+// Driver implements deploy.Target for Cosmos SDK chains.
+type Driver struct {
+	chainID  string
+	deployer signer.Signer
+	// account is the deployer's bech32 account address.
+	account  string
+	gasPrice sdk.DecCoin
+
+	node      cmtservice.ServiceClient
+	auth      authtypes.QueryClient
+	txs       txtypes.ServiceClient
+	clients   clienttypes.QueryClient
+	clientsV2 clientv2types.QueryClient
+}
+
+// Options configures a Cosmos driver.
+type Options struct {
+	ChainID string
+	GRPC    string
+	// AccountPrefix is the bech32 prefix of account addresses, defaulting
+	// to DefaultAccountPrefix.
+	AccountPrefix string
+	// GasPrice is the fee paid per unit of gas, like "0.025stake".
+	GasPrice string
+	// Deployer signs transactions from the secp256k1 account of its public
+	// key. Any ecdsa signer works, local, remote or pkcs11.
+	Deployer signer.Signer
+}
+
+// New connects to the chain's gRPC endpoint and validates its ID. A nil
+// Deployer builds a read-only driver: queries only, no provisioning.
+func New(ctx context.Context, opts Options) (*Driver, error) {
+	if opts.Deployer != nil && opts.Deployer.Type() != signer.ECDSA {
+		return nil, fmt.Errorf("deployer signer must be %s, got %s", signer.ECDSA, opts.Deployer.Type())
+	}
+	gasPrice, err := sdk.ParseDecCoin(opts.GasPrice)
+	if err != nil {
+		return nil, fmt.Errorf("invalid gas price %q: %w", opts.GasPrice, err)
+	}
+	conn, err := grpc.NewClient(
+		opts.GRPC,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodecV2(gogoCodec{})),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("dial %s: %w", opts.GRPC, err)
+	}
+	d := &Driver{
+		chainID:   opts.ChainID,
+		deployer:  opts.Deployer,
+		gasPrice:  gasPrice,
+		node:      cmtservice.NewServiceClient(conn),
+		auth:      authtypes.NewQueryClient(conn),
+		txs:       txtypes.NewServiceClient(conn),
+		clients:   clienttypes.NewQueryClient(conn),
+		clientsV2: clientv2types.NewQueryClient(conn),
+	}
+	head, err := d.latestBlock(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query chain id: %w", err)
+	}
+	if head.ChainID != opts.ChainID {
+		return nil, fmt.Errorf("grpc %s reports chain id %s, config says %s", opts.GRPC, head.ChainID, opts.ChainID)
+	}
+	if opts.Deployer != nil {
+		prefix := opts.AccountPrefix
+		if prefix == "" {
+			prefix = DefaultAccountPrefix
+		}
+		d.account, err = AccountAddress(prefix, opts.Deployer.PublicKey())
+		if err != nil {
+			return nil, fmt.Errorf("deployer account: %w", err)
+		}
+	}
+	return d, nil
+}
+
+func (d *Driver) Name() string { return TargetName }
+
+// MerklePrefix is the ibc store's prefix.
+func (d *Driver) MerklePrefix() [][]byte { return ibcMerklePrefix }
+
+func (d *Driver) SupportedClientTypes() []string {
+	return []string{deploy.ClientTypeAttestation}
+}
+
+// AssignsClientIDs reports true: ibc-go names clients "<type>-<sequence>".
+func (d *Driver) AssignsClientIDs() bool { return true }
+
+func (d *Driver) requireSigner() error {
+	if d.deployer == nil {
+		return errors.New("read-only driver: no deployer signer configured")
+	}
+	return nil
+}
+
+// ProvisionCore deploys nothing: it checks the chain's ibc module accepts
+// attestation clients and returns it as the router.
+func (d *Driver) ProvisionCore(ctx context.Context, _ deploy.CoreParams) (deploy.CoreRef, error) {
+	res, err := d.clients.ClientParams(ctx, &clienttypes.QueryClientParamsRequest{})
+	if err != nil {
+		return deploy.CoreRef{}, fmt.Errorf("query ibc client params: %w", err)
+	}
+	if res.Params == nil || !res.Params.IsAllowedClient(attestations.ModuleName) {
+		return deploy.CoreRef{}, fmt.Errorf(
+			"chain %s does not allow %s clients: add them to the ibc client params' allowed clients",
+			d.chainID, attestations.ModuleName,
+		)
+	}
+	return deploy.CoreRef{Router: Router}, nil
+}
+
+// ProvisionClient creates an attestation client with MsgCreateClient and
+// returns the client ID the chain assigned as its address.
+func (d *Driver) ProvisionClient(ctx context.Context, _ string, spec deploy.ClientSpec) (deploy.ClientRef, error) {
+	if spec.Type != deploy.ClientTypeAttestation {
+		return deploy.ClientRef{}, fmt.Errorf("client type %q not supported", spec.Type)
+	}
+	p, ok := spec.Params.(deploy.AttestationParams)
+	if !ok {
+		return deploy.ClientRef{}, fmt.Errorf("client %q: params type %T, want AttestationParams",
+			spec.ClientID, spec.Params)
+	}
+	if err := d.requireSigner(); err != nil {
+		return deploy.ClientRef{}, err
+	}
+	clientState, err := anyOf(&attestations.ClientState{
+		AttestorAddresses: p.Attestors,
+		MinRequiredSigs:   uint32(p.Threshold),
+		LatestHeight:      p.InitialHeight,
+	})
+	if err != nil {
+		return deploy.ClientRef{}, err
+	}
+	consensusState, err := anyOf(&attestations.ConsensusState{Timestamp: p.InitialTimestamp * nanosPerSecond})
+	if err != nil {
+		return deploy.ClientRef{}, err
+	}
+	res, err := d.send(ctx, "create client", &clienttypes.MsgCreateClient{
+		ClientState:    clientState,
+		ConsensusState: consensusState,
+		Signer:         d.account,
+	})
+	if err != nil {
+		return deploy.ClientRef{}, err
+	}
+	clientID, ok := eventAttribute(res, clienttypes.EventTypeCreateClient, clienttypes.AttributeKeyClientID)
+	if !ok {
+		return deploy.ClientRef{}, fmt.Errorf("create client: tx %s has no %s event",
+			res.TxHash, clienttypes.EventTypeCreateClient)
+	}
+	return deploy.ClientRef{Address: clientID}, nil
+}
+
+// RegisterClient registers the created client's counterparty with
+// MsgRegisterCounterparty, which only the client's creator may send.
+func (d *Driver) RegisterClient(
+	ctx context.Context,
+	_ string,
+	spec deploy.ClientSpec,
+	ref deploy.ClientRef,
+) (string, error) {
+	if err := d.requireSigner(); err != nil {
+		return "", err
+	}
+	prefix := spec.CounterpartyMerklePrefix
+	if prefix == nil {
+		prefix = evmMerklePrefix
+	}
+	_, err := d.send(ctx, "register counterparty of "+ref.Address, &clientv2types.MsgRegisterCounterparty{
+		ClientId:                 ref.Address,
+		CounterpartyMerklePrefix: prefix,
+		CounterpartyClientId:     spec.CounterpartyClientID,
+		Signer:                   d.account,
+	})
+	if err != nil {
+		return "", err
+	}
+	return ref.Address, nil
+}
+
+// ClientRegistered reports whether clientID exists with its counterparty
+// registered, returning clientID as the client's address.
+func (d *Driver) ClientRegistered(ctx context.Context, _, clientID string) (string, bool, error) {
+	counterparty, found, err := d.counterparty(ctx, clientID)
+	if err != nil || !found || counterparty.ClientId == "" {
+		return "", false, err
+	}
+	return clientID, true, nil
+}
+
+// counterparty reads clientID's registered counterparty.
+func (d *Driver) counterparty(ctx context.Context, clientID string) (*clientv2types.CounterpartyInfo, bool, error) {
+	res, err := d.clientsV2.CounterpartyInfo(ctx, &clientv2types.QueryCounterpartyInfoRequest{ClientId: clientID})
+	if status.Code(err) == codes.NotFound {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("query counterparty of %q: %w", clientID, err)
+	}
+	if res.CounterpartyInfo == nil {
+		return nil, false, nil
+	}
+	return res.CounterpartyInfo, true, nil
+}
+
+// attestationClient reads clientID's attestation client state, failing for
+// a client of another type.
+func (d *Driver) attestationClient(ctx context.Context, clientID string) (*attestations.ClientState, error) {
+	res, err := d.clients.ClientState(ctx, &clienttypes.QueryClientStateRequest{ClientId: clientID})
+	if err != nil {
+		return nil, fmt.Errorf("query client state of %q: %w", clientID, err)
+	}
+	var clientState attestations.ClientState
+	if err = unpack(res.ClientState, &clientState); err != nil {
+		return nil, fmt.Errorf("client %q: %w", clientID, err)
+	}
+	return &clientState, nil
+}
+
+// AttestationState reads the attestation client's attestor set and its
+// latest trusted height and timestamp.
+func (d *Driver) AttestationState(ctx context.Context, _, clientID string) (deploy.AttestationState, error) {
+	clientState, err := d.attestationClient(ctx, clientID)
+	if err != nil {
+		return deploy.AttestationState{}, err
+	}
+	if clientState.IsFrozen {
+		return deploy.AttestationState{}, fmt.Errorf("client %q is frozen", clientID)
+	}
+	res, err := d.clients.ConsensusState(ctx, &clienttypes.QueryConsensusStateRequest{
+		ClientId:       clientID,
+		RevisionHeight: clientState.LatestHeight,
+	})
+	if err != nil {
+		return deploy.AttestationState{}, fmt.Errorf("query consensus state of %q: %w", clientID, err)
+	}
+	var consensusState attestations.ConsensusState
+	if err = unpack(res.ConsensusState, &consensusState); err != nil {
+		return deploy.AttestationState{}, fmt.Errorf("client %q: %w", clientID, err)
+	}
+	return deploy.AttestationState{
+		Attestors:       clientState.AttestorAddresses,
+		Threshold:       uint8(clientState.MinRequiredSigs),
+		LatestHeight:    clientState.LatestHeight,
+		LatestTimestamp: consensusState.Timestamp / nanosPerSecond,
+	}, nil
+}
+
+// HasCode reports whether the ibc module serves client queries, for the
+// router, or whether a client exists, for a client ID.
+func (d *Driver) HasCode(ctx context.Context, address string) (bool, error) {
+	if address == Router {
+		_, err := d.clients.ClientParams(ctx, &clienttypes.QueryClientParamsRequest{})
+		if status.Code(err) == codes.Unimplemented {
+			return false, nil
+		}
+		return err == nil, err
+	}
+	_, err := d.clients.ClientState(ctx, &clienttypes.QueryClientStateRequest{ClientId: address})
+	if status.Code(err) == codes.NotFound {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// Head returns the latest block height and time in seconds.
+func (d *Driver) Head(ctx context.Context) (uint64, uint64, error) {
+	head, err := d.latestBlock(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	return uint64(head.Height), uint64(head.Time.Unix()), nil
+}
+
+func (d *Driver) latestBlock(ctx context.Context) (cmtservice.Header, error) {
+	res, err := d.node.GetLatestBlock(ctx, &cmtservice.GetLatestBlockRequest{})
+	if err != nil {
+		return cmtservice.Header{}, err
+	}
+	if res.SdkBlock == nil {
+		return cmtservice.Header{}, errors.New("node returned no block")
+	}
+	return res.SdkBlock.Header, nil
+}
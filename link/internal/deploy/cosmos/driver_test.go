@@ -0,0 +1,156 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package cosmos
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc/link/internal/deploy"
+	"github.com/cosmos/ibc/link/internal/deploy/manifest"
+	"github.com/cosmos/ibc/link/internal/service/signer"
+	"github.com/cosmos/ibc/link/internal/tests"
+)
+
+// cosmosHDPath is the account path of Cosmos SDK keys, coin type 118.
+const cosmosHDPath = "m/44'/118'/0'/0"
+
+func TestEventAttribute(t *testing.T) {
+	res := &sdk.TxResponse{Events: []abci.Event{
+		{Type: "message", Attributes: []abci.EventAttribute{{Key: "client_id", Value: "wrong"}}},
+		{Type: "create_client", Attributes: []abci.EventAttribute{
+			{Key: "client_type", Value: "attestations"},
+			{Key: "client_id", Value: "attestations-0"},
+		}},
+	}}
+	got, ok := eventAttribute(res, "create_client", "client_id")
+	require.True(t, ok)
+	require.Equal(t, "attestations-0", got)
+
+	_, ok = eventAttribute(res, "create_client", "consensus_height")
+	require.False(t, ok)
+	_, ok = eventAttribute(res, "update_client", "client_id")
+	require.False(t, ok)
+}
+
+func TestAccountAddress(t *testing.T) {
+	key, _, err := signer.DeriveLocalKey(signer.ECDSA, strings.Repeat("abandon ", 11)+"about", cosmosHDPath, 0)
+	require.NoError(t, err)
+	address, err := AccountAddress("osmo", key.PublicKey())
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(address, "osmo1"), address)
+
+	// an uncompressed key is not an account key
+	_, err = AccountAddress(DefaultAccountPrefix, make([]byte, 65))
+	require.ErrorContains(t, err, "want a 33-byte compressed secp256k1 key")
+}
+
+func TestReadOnlyDriverGuards(t *testing.T) {
+	ctx := context.Background()
+	d := &Driver{chainID: "simd"}
+	spec := deploy.ClientSpec{
+		Type: deploy.ClientTypeAttestation,
+		Params: deploy.AttestationParams{
+			Attestors:        []string{"0x00000000000000000000000000000000000000aa"},
+			Threshold:        1,
+			InitialHeight:    1,
+			InitialTimestamp: 1,
+		},
+	}
+	_, err := d.ProvisionClient(ctx, Router, spec)
+	require.ErrorContains(t, err, "no deployer signer configured")
+	_, err = d.RegisterClient(ctx, Router, spec, deploy.ClientRef{Address: "attestations-0"})
+	require.ErrorContains(t, err, "no deployer signer configured")
+
+	spec.Type = "tendermint"
+	_, err = d.ProvisionClient(ctx, Router, spec)
+	require.ErrorContains(t, err, `client type "tendermint" not supported`)
+
+	// apps and access control are chain modules, not deployments
+	_, err = deploy.Require[deploy.AppDeployer](d, "gmp app deployment")
+	require.ErrorIs(t, err, errors.ErrUnsupported)
+	require.ErrorContains(t, err, "on cosmos chains: gmp app deployment")
+	_, err = deploy.Require[deploy.ClientMigrator](d, "client migration")
+	require.ErrorIs(t, err, errors.ErrUnsupported)
+}
+
+// TestSimdDeploy provisions an attestation client on a local single-node
+// simd, built from the pinned ibc-go by `make test-simd`.
+func TestSimdDeploy(t *testing.T) {
+	tests.GuardSimdTests(t)
+
+	node := tests.NewSimdNode(t)
+	grpcAddr, chainID := node.GRPC, node.ChainID
+
+	ctx := context.Background()
+	key, _, err := signer.DeriveLocalKey(signer.ECDSA, node.Mnemonic, cosmosHDPath, 0)
+	require.NoError(t, err)
+	d, err := New(ctx, Options{ChainID: chainID, GRPC: grpcAddr, GasPrice: "0.025stake", Deployer: key})
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	attestors := []string{"0x00000000000000000000000000000000000000aa"}
+	spec := deploy.ClientSpec{
+		ClientID:             "link-1",
+		Type:                 deploy.ClientTypeAttestation,
+		CounterpartyChainID:  "1",
+		CounterpartyClientID: "link-1",
+		Params: deploy.AttestationParams{
+			Attestors:        attestors,
+			Threshold:        1,
+			InitialHeight:    1,
+			InitialTimestamp: uint64(time.Now().Unix()),
+		},
+	}
+	plan := func() []deploy.Step {
+		return slices.Concat(deploy.CoreSteps(d, dir, chainID), deploy.ClientSteps(d, dir, chainID, spec))
+	}
+	res, err := deploy.RunSteps(ctx, slog.Default(), false, plan())
+	require.NoError(t, err)
+	require.Equal(t, deploy.ActionExecuted, res[1].Action)
+
+	m, err := manifest.Load(dir, chainID)
+	require.NoError(t, err)
+	require.Equal(t, TargetName, m.Target)
+	require.Equal(t, Router, m.Core.Router)
+	client, ok := m.ClientTracking("1", "link-1")
+	require.True(t, ok)
+	require.True(t, strings.HasPrefix(client.ClientID, "attestations-"), client.ClientID)
+
+	state, err := d.AttestationState(ctx, Router, client.ClientID)
+	require.NoError(t, err)
+	require.Equal(t, uint8(1), state.Threshold)
+	require.Equal(t, uint64(1), state.LatestHeight)
+
+	// the chain-assigned ID is found again on a rerun
+	res, err = deploy.RunSteps(ctx, slog.Default(), false, plan())
+	require.NoError(t, err)
+	for _, r := range res {
+		require.Equal(t, deploy.ActionSkipped, r.Action, r.Name)
+	}
+
+	report, err := d.Verify(ctx, m)
+	require.NoError(t, err)
+	require.Empty(t, report.Failed())
+
+	// verification catches drift
+	broken := *m
+	broken.Clients = []manifest.Client{{
+		ClientID:             client.ClientID,
+		Type:                 deploy.ClientTypeAttestation,
+		CounterpartyChainID:  "1",
+		CounterpartyClientID: "link-2",
+	}}
+	report, err = d.Verify(ctx, &broken)
+	require.NoError(t, err)
+	require.NotEmpty(t, report.Failed())
+}
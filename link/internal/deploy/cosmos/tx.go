@@ -0,0 +1,254 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package cosmos
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"log/slog"
+	"math"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/mem"
+	"google.golang.org/grpc/status"
+)
+
+// gasAdjustment scales simulated gas into the limit a transaction pays for.
+const gasAdjustment = 1.5
+
+// txPollInterval and txTimeout bound the wait for a broadcast transaction to
+// be included in a block.
+const (
+	txPollInterval = time.Second
+	txTimeout      = time.Minute
+)
+
+// AccountAddress derives the bech32 account address of a compressed
+// secp256k1 public key.
+func AccountAddress(prefix string, publicKey []byte) (string, error) {
+	if len(publicKey) != secp256k1.PubKeySize {
+		return "", fmt.Errorf("public key is %d bytes, want a %d-byte compressed secp256k1 key",
+			len(publicKey), secp256k1.PubKeySize)
+	}
+	return bech32.ConvertAndEncode(prefix, (&secp256k1.PubKey{Key: publicKey}).Address())
+}
+
+// send signs msgs into one transaction from the deployer's account,
+// broadcasts it and waits for its inclusion, erroring unless it succeeded.
+func (d *Driver) send(ctx context.Context, label string, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	res, err := d.auth.AccountInfo(ctx, &authtypes.QueryAccountInfoRequest{Address: d.account})
+	if status.Code(err) == codes.NotFound || (err == nil && res.Info == nil) {
+		return nil, fmt.Errorf("%s: deployer account %s does not exist on chain %s: fund it first",
+			label, d.account, d.chainID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: query account %s: %w", label, d.account, err)
+	}
+	account := res.Info
+
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		if anys[i], err = anyOf(msg); err != nil {
+			return nil, err
+		}
+	}
+	bodyBytes, err := (&txtypes.TxBody{Messages: anys}).Marshal()
+	if err != nil {
+		return nil, err
+	}
+	publicKey, err := anyOf(&secp256k1.PubKey{Key: d.deployer.PublicKey()})
+	if err != nil {
+		return nil, err
+	}
+	simulated, err := d.signTx(ctx, account, bodyBytes, publicKey, 0)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", label, err)
+	}
+	sim, err := d.txs.Simulate(ctx, &txtypes.SimulateRequest{TxBytes: simulated})
+	if err != nil {
+		return nil, fmt.Errorf("%s: simulate: %w", label, err)
+	}
+	gas := uint64(math.Ceil(float64(sim.GasInfo.GasUsed) * gasAdjustment))
+	txBytes, err := d.signTx(ctx, account, bodyBytes, publicKey, gas)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", label, err)
+	}
+	broadcast, err := d.txs.BroadcastTx(ctx, &txtypes.BroadcastTxRequest{
+		TxBytes: txBytes,
+		Mode:    txtypes.BroadcastMode_BROADCAST_MODE_SYNC,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: broadcast: %w", label, err)
+	}
+	if broadcast.TxResponse.Code != 0 {
+		return nil, fmt.Errorf("%s: transaction rejected (code %d): %s",
+			label, broadcast.TxResponse.Code, broadcast.TxResponse.RawLog)
+	}
+	return d.awaitTx(ctx, label, broadcast.TxResponse.TxHash)
+}
+
+// signTx signs the transaction of bodyBytes with gas as its limit, in
+// SIGN_MODE_DIRECT, returning its raw bytes.
+func (d *Driver) signTx(
+	ctx context.Context,
+	account *authtypes.BaseAccount,
+	bodyBytes []byte,
+	publicKey *codectypes.Any,
+	gas uint64,
+) ([]byte, error) {
+	authInfoBytes, err := (&txtypes.AuthInfo{
+		SignerInfos: []*txtypes.SignerInfo{{
+			PublicKey: publicKey,
+			ModeInfo: &txtypes.ModeInfo{Sum: &txtypes.ModeInfo_Single_{
+				Single: &txtypes.ModeInfo_Single{Mode: signing.SignMode_SIGN_MODE_DIRECT},
+			}},
+			Sequence: account.Sequence,
+		}},
+		Fee: &txtypes.Fee{Amount: d.fee(gas), GasLimit: gas},
+	}).Marshal()
+	if err != nil {
+		return nil, err
+	}
+	signDoc, err := (&txtypes.SignDoc{
+		BodyBytes:     bodyBytes,
+		AuthInfoBytes: authInfoBytes,
+		ChainId:       d.chainID,
+		AccountNumber: account.AccountNumber,
+	}).Marshal()
+	if err != nil {
+		return nil, err
+	}
+	digest := sha256.Sum256(signDoc)
+	signature, err := d.deployer.Sign(ctx, digest[:])
+	if err != nil {
+		return nil, fmt.Errorf("sign: %w", err)
+	}
+	// ecdsa signers return the low-s [R || S || V] of Ethereum; Cosmos
+	// takes [R || S]
+	if len(signature) < 64 {
+		return nil, fmt.Errorf("sign: %d-byte signature, want at least 64", len(signature))
+	}
+	return (&txtypes.TxRaw{
+		BodyBytes:     bodyBytes,
+		AuthInfoBytes: authInfoBytes,
+		Signatures:    [][]byte{signature[:64]},
+	}).Marshal()
+}
+
+// fee is the fee of gas at the configured gas price, rounded up.
+func (d *Driver) fee(gas uint64) sdk.Coins {
+	amount := d.gasPrice.Amount.MulInt64(int64(gas)).Ceil().TruncateInt()
+	return sdk.NewCoins(sdk.NewCoin(d.gasPrice.Denom, amount))
+}
+
+// awaitTx polls for the transaction hash until it is included, erroring if it
+// failed. Included transactions are logged with their hash; manifests record
+// only client IDs.
+func (d *Driver) awaitTx(ctx context.Context, label, hash string) (*sdk.TxResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, txTimeout)
+	defer cancel()
+	ticker := time.NewTicker(txPollInterval)
+	defer ticker.Stop()
+	for {
+		res, err := d.txs.GetTx(ctx, &txtypes.GetTxRequest{Hash: hash})
+		if err == nil {
+			if res.TxResponse.Code != 0 {
+				return nil, fmt.Errorf("%s: transaction %s failed (code %d): %s",
+					label, hash, res.TxResponse.Code, res.TxResponse.RawLog)
+			}
+			slog.Info("transaction included",
+				"label", label,
+				"tx", hash,
+				"height", res.TxResponse.Height,
+				"chain", d.chainID,
+			)
+			return res.TxResponse, nil
+		}
+		if status.Code(err) != codes.NotFound {
+			return nil, fmt.Errorf("%s: query transaction %s: %w", label, hash, err)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("%s: wait for transaction %s: %w", label, hash, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// eventAttribute returns the value of key in the first event of type typ the
+// transaction emitted.
+func eventAttribute(res *sdk.TxResponse, typ, key string) (string, bool) {
+	for _, event := range res.Events {
+		if event.Type != typ {
+			continue
+		}
+		for _, attribute := range event.Attributes {
+			if attribute.Key == key {
+				return attribute.Value, true
+			}
+		}
+	}
+	return "", false
+}
+
+// anyOf packs msg for a message or state field of type Any.
+func anyOf(msg sdk.Msg) (*codectypes.Any, error) {
+	packed, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, fmt.Errorf("pack %T: %w", msg, err)
+	}
+	return packed, nil
+}
+
+// unpack decodes packed into msg, which must be of packed's type.
+func unpack(packed *codectypes.Any, msg gogoMessage) error {
+	if packed == nil {
+		return fmt.Errorf("no %s", sdk.MsgTypeURL(msg))
+	}
+	if packed.TypeUrl != sdk.MsgTypeURL(msg) {
+		return fmt.Errorf("%s is not a %s", packed.TypeUrl, sdk.MsgTypeURL(msg))
+	}
+	return msg.Unmarshal(packed.Value)
+}
+
+// gogoMessage is a gogoproto message, as cosmos-sdk and ibc-go generate.
+type gogoMessage interface {
+	sdk.Msg
+	Marshal() ([]byte, error)
+	Unmarshal(bz []byte) error
+}
+
+// gogoCodec encodes gogoproto messages with their own methods: grpc's default
+// codec handles protobuf-go messages only.
+type gogoCodec struct{}
+
+func (gogoCodec) Marshal(v any) (mem.BufferSlice, error) {
+	msg, ok := v.(gogoMessage)
+	if !ok {
+		return nil, fmt.Errorf("%T is not a gogoproto message", v)
+	}
+	bz, err := msg.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return mem.BufferSlice{mem.SliceBuffer(bz)}, nil
+}
+
+func (gogoCodec) Unmarshal(data mem.BufferSlice, v any) error {
+	msg, ok := v.(gogoMessage)
+	if !ok {
+		return fmt.Errorf("%T is not a gogoproto message", v)
+	}
+	return msg.Unmarshal(data.Materialize())
+}
+
+func (gogoCodec) Name() string { return "proto" }
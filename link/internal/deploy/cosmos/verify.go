@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package cosmos
+
+import (
+	"context"
+	"fmt"
+
+	clienttypes "github.com/cosmos/ibc-go/v11/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v11/modules/core/exported"
+
+	"github.com/cosmos/ibc/link/internal/deploy"
+	"github.com/cosmos/ibc/link/internal/deploy/manifest"
+)
+
+// Verify checks the manifest's clients against the chain's ibc module: each
+// is active, registered with the recorded counterparty and trusts the
+// recorded attestor set.
+func (d *Driver) Verify(ctx context.Context, m *manifest.Manifest) (deploy.Report, error) {
+	var report deploy.Report
+	check := func(name string, ok bool, detail string) {
+		status := deploy.CheckOK
+		if !ok {
+			status = deploy.CheckFailed
+		} else {
+			detail = ""
+		}
+		report.Checks = append(report.Checks, deploy.Check{Name: name, Status: status, Detail: detail})
+	}
+
+	check("manifest chain id matches connected chain", m.ChainID == d.chainID,
+		fmt.Sprintf("manifest is for chain %s, connected chain is %s", m.ChainID, d.chainID))
+	if m.ChainID != d.chainID {
+		return report, nil
+	}
+	if m.Core.Router == "" {
+		check("core router recorded", false, "manifest has no router")
+		return report, nil
+	}
+	present, err := d.HasCode(ctx, Router)
+	if err != nil {
+		return report, err
+	}
+	check("ibc module present", present, "node serves no ibc client queries")
+	if !present {
+		return report, nil
+	}
+
+	for _, c := range m.Clients {
+		counterparty, found, err := d.counterparty(ctx, c.ClientID)
+		if err != nil {
+			return report, err
+		}
+		check("client "+c.ClientID+" registered", found && counterparty.ClientId != "",
+			"no counterparty registered for the client")
+		if !found {
+			continue
+		}
+		check(
+			"client "+c.ClientID+" counterparty matches",
+			counterparty.ClientId == c.CounterpartyClientID,
+			fmt.Sprintf("chain has %q, manifest has %q", counterparty.ClientId, c.CounterpartyClientID),
+		)
+		res, err := d.clients.ClientStatus(ctx, &clienttypes.QueryClientStatusRequest{ClientId: c.ClientID})
+		if err != nil {
+			return report, fmt.Errorf("query status of client %q: %w", c.ClientID, err)
+		}
+		check("client "+c.ClientID+" active", res.Status == string(exported.Active), "client is "+res.Status)
+		if c.Type != deploy.ClientTypeAttestation {
+			continue
+		}
+		clientState, err := d.attestationClient(ctx, c.ClientID)
+		if err != nil {
+			return report, err
+		}
+		recorded, live := deploy.ParamStrings(c.Params["attestors"]), clientState.AttestorAddresses
+		threshold := fmt.Sprint(clientState.MinRequiredSigs)
+		check(
+			"client "+c.ClientID+" attestor set matches",
+			deploy.SameAddresses(recorded, live) && fmt.Sprint(c.Params["threshold"]) == threshold,
+			fmt.Sprintf("chain has %v threshold %s, manifest has %v threshold %v",
+				live, threshold, recorded, c.Params["threshold"]),
+		)
+	}
+	return report, nil
+}
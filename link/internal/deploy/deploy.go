@@ -6,6 +6,9 @@ package deploy
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math"
 
 	"github.com/cosmos/ibc/link/internal/deploy/manifest"
 )
@@ -18,6 +21,10 @@ const ClientTypeAttestation = "attestation"
 // so the app is only reachable when registered here.
 const GMPPortID = "gmpport"
 
+// TransferPortID is the IBC port the ICS20 transfer app registers under
+// (ICS20Lib.DEFAULT_PORT_ID).
+const TransferPortID = "transfer"
+
 // CoreParams parameterizes core-stack provisioning.
 type CoreParams struct {
 	ChainID string
@@ -31,14 +38,26 @@ type AttestationParams struct {
 	InitialTimestamp uint64
 }
 
+// AttestationState is an attestation client's live attestor set and latest
+// trusted state.
+type AttestationState struct {
+	Attestors       []string
+	Threshold       uint8
+	LatestHeight    uint64
+	LatestTimestamp uint64
+}
+
 // ClientSpec describes one light client to provision and register.
 // Params carries type-specific parameters (AttestationParams for "attestation").
+// CounterpartyMerklePrefix is the counterparty's MerklePrefix; nil takes the
+// empty prefix of an EVM counterparty.
 type ClientSpec struct {
-	ClientID             string
-	Type                 string
-	CounterpartyChainID  string
-	CounterpartyClientID string
-	Params               any
+	ClientID                 string
+	Type                     string
+	CounterpartyChainID      string
+	CounterpartyClientID     string
+	CounterpartyMerklePrefix [][]byte
+	Params                   any
 }
 
 // CoreRef is the result of provisioning the core stack.
@@ -58,6 +77,13 @@ type GMPRef struct {
 	AccountLogic string // beacon logic impl
 }
 
+// TransferRef is the result of provisioning the ICS20 transfer app.
+type TransferRef struct {
+	Address       string // proxy
+	EscrowLogic   string // beacon logic impl of the per-client escrows
+	IBCERC20Logic string // beacon logic impl of the IBC-minted ERC20s
+}
+
 // IFTSpec describes one IFT token to deploy.
 type IFTSpec struct {
 	Owner  string
@@ -65,6 +91,17 @@ type IFTSpec struct {
 	Symbol string
 }
 
+// Discovery is a router's deployment as read back from chain state.
+type Discovery struct {
+	Core CoreRef
+	// Clients are the clients registered on the router. Their counterparty
+	// chain is not recorded on chain, and Type is empty for a client of a
+	// type the target does not recognise.
+	Clients []manifest.Client
+	// Apps maps each port registered on the router to its app.
+	Apps map[string]string
+}
+
 // IFTRef is the result of provisioning an IFT token.
 type IFTRef struct {
 	Address string // proxy
@@ -77,6 +114,48 @@ type BridgeSpec struct {
 	SendCallConstructor string
 }
 
+// Upgradeable components: contracts deployed behind a proxy whose
+// implementation `ibc deploy upgrade` replaces.
+const (
+	ComponentRouter   = "router"
+	ComponentGMP      = "gmp"
+	ComponentTransfer = "transfer"
+	ComponentIFT      = "ift"
+)
+
+// Role IDs of the AccessManager governing a core stack, as in OpenZeppelin's
+// AccessManager.
+const (
+	AdminRole  uint64 = 0
+	PublicRole uint64 = math.MaxUint64
+	// DefaultRelayerRole the role relaying is restricted to unless another
+	// is requested.
+	DefaultRelayerRole uint64 = 1
+)
+
+// RolesSpec describes the access control a core deployment is hardened to.
+type RolesSpec struct {
+	// RelayerRole the role the router's relaying entry points are restricted
+	// to.
+	RelayerRole uint64
+	// Relayers the accounts granted RelayerRole. Empty leaves relaying as it
+	// is.
+	Relayers []string
+	// Admin the account, a multisig or timelock, the admin role is handed
+	// to. Empty leaves the deployer admin.
+	Admin string
+	// AdminDelay seconds every operation of Admin must be scheduled ahead.
+	AdminDelay uint32
+}
+
+// RoleGrant is an account's membership of a role.
+type RoleGrant struct {
+	Member bool
+	// ExecutionDelay seconds the member's restricted calls must be
+	// scheduled ahead.
+	ExecutionDelay uint32
+}
+
 // Check statuses.
 const (
 	CheckOK     = "ok"
@@ -106,21 +185,32 @@ func (r Report) Failed() []Check {
 	return failed
 }
 
-// Target deploys and wires IBC on one deployment-target ecosystem.
-// Provision* verbs use the target's idiomatic tooling; the rest are IBC
-// wiring and reads.
+// Target deploys and wires IBC on one deployment-target ecosystem: the core
+// routing stack and the light clients registered on it. Provision* verbs use
+// the target's idiomatic tooling; the rest are IBC wiring and reads. What
+// only some targets deploy is an optional interface embedding Target, which
+// Require asserts.
 type Target interface {
+	// Name is the target name manifests record, like "evm".
+	Name() string
+	// MerklePrefix is the prefix counterparty clients prove this chain's IBC
+	// commitments under.
+	MerklePrefix() [][]byte
 	// ProvisionCore deploys the core routing stack.
 	ProvisionCore(ctx context.Context, p CoreParams) (CoreRef, error)
 	// ProvisionClient deploys a light client governed by router, without
 	// registering it.
 	ProvisionClient(ctx context.Context, router string, spec ClientSpec) (ClientRef, error)
 	// RegisterClient registers a provisioned client on the router and returns
-	// the registered client ID.
+	// the registered client ID, spec.ClientID unless the target is a
+	// ClientIDAssigner.
 	RegisterClient(ctx context.Context, router string, spec ClientSpec, client ClientRef) (string, error)
 	// ClientRegistered reports whether clientID is registered on router,
 	// returning the registered client address when it is.
 	ClientRegistered(ctx context.Context, router, clientID string) (string, bool, error)
+	// AttestationState reads the attestor set and latest trusted state of the
+	// attestation client registered as clientID on router.
+	AttestationState(ctx context.Context, router, clientID string) (AttestationState, error)
 	// HasCode reports whether an on-chain artifact exists at address.
 	HasCode(ctx context.Context, address string) (bool, error)
 	// Head returns the chain's current height and timestamp (seconds).
@@ -131,13 +221,37 @@ type Target interface {
 	// SupportedClientTypes lists the client type names ProvisionClient
 	// accepts in ClientSpec.Type.
 	SupportedClientTypes() []string
+}
+
+// ClientMigrator is implemented by targets whose deployer can move a
+// registered client onto another, like the EVM router's admin path.
+type ClientMigrator interface {
+	Target
+	// MigrateClient points clientID at the client registered as
+	// substituteClientID, keeping clientID's counterparty and packet state.
+	MigrateClient(ctx context.Context, router, clientID, substituteClientID string) error
+}
+
+// AppDeployer is implemented by targets whose IBC apps are deployments
+// registered on the router, rather than chain modules.
+type AppDeployer interface {
+	Target
 	// ProvisionGMP deploys the ICS27-GMP app (account logic + impl + proxy).
 	ProvisionGMP(ctx context.Context, router, accessManager string) (GMPRef, error)
+	// ProvisionTransfer deploys the ICS20 transfer app (escrow and IBCERC20
+	// logic + impl + proxy).
+	ProvisionTransfer(ctx context.Context, router, accessManager string) (TransferRef, error)
 	// RegisterApp registers app on the router under port.
 	RegisterApp(ctx context.Context, router, app, port string) error
 	// AppRegistered reports whether an app is registered at port, returning its
 	// address when it is.
 	AppRegistered(ctx context.Context, router, port string) (string, bool, error)
+}
+
+// IFTDeployer is implemented by targets that deploy IFT tokens and register
+// their bridges.
+type IFTDeployer interface {
+	Target
 	// ProvisionIFT deploys an IFT token governed by the GMP app.
 	ProvisionIFT(ctx context.Context, gmp string, spec IFTSpec) (IFTRef, error)
 	// ProvisionSendCallConstructor deploys the stateless EVM IFT send-call
@@ -150,3 +264,94 @@ type Target interface {
 	// does.
 	IFTBridge(ctx context.Context, ift, clientID string) (counterparty, constructor string, registered bool, err error)
 }
+
+// Discoverer is implemented by targets that can read a deployment back from
+// chain state, for Import.
+type Discoverer interface {
+	Target
+	// Discover reads router's deployment back from chain state: the core
+	// stack behind it and the clients and apps its events announce, scanned
+	// from fromBlock, each looked up on the router.
+	Discover(ctx context.Context, router string, fromBlock uint64) (Discovery, error)
+	// IFTToken reads the owner, name and symbol of the IFT token at address.
+	IFTToken(ctx context.Context, address string) (IFTSpec, error)
+	// IFTBridge is IFTDeployer.IFTBridge.
+	IFTBridge(ctx context.Context, ift, clientID string) (counterparty, constructor string, registered bool, err error)
+}
+
+// RoleManager is implemented by targets whose access control is an
+// AccessManager deployment the deployer administers.
+type RoleManager interface {
+	Target
+	// RelayingRoles reads the role each of router's relaying entry points is
+	// bound to on accessManager, keyed by entry point.
+	RelayingRoles(ctx context.Context, router, accessManager string) (map[string]uint64, error)
+	// Role reads account's membership of roleID on accessManager.
+	Role(ctx context.Context, accessManager string, roleID uint64, account string) (RoleGrant, error)
+	// RestrictRelaying grants roleID to grant, revokes it from revoke, then
+	// binds router's relaying entry points to it.
+	RestrictRelaying(ctx context.Context, router, accessManager string, roleID uint64, grant, revoke []string) error
+	// HandOffAdmin grants accessManager's admin role to admin with
+	// executionDelay, then has the deployer renounce its own, returning the
+	// deployer's address.
+	HandOffAdmin(ctx context.Context, accessManager, admin string, executionDelay uint32) (string, error)
+}
+
+// Upgrader is implemented by targets whose components sit behind upgradeable
+// proxies.
+type Upgrader interface {
+	Target
+	// ContractsVersion is the version of the contracts ProvisionImplementation
+	// deploys.
+	ContractsVersion() string
+	// Implementation reads the implementation behind proxy.
+	Implementation(ctx context.Context, proxy string) (string, error)
+	// ProvisionImplementation deploys a new implementation of component.
+	ProvisionImplementation(ctx context.Context, component string) (string, error)
+	// UpgradeProxy checks implementation is a compatible replacement of
+	// proxy's current one, then points proxy at it.
+	UpgradeProxy(ctx context.Context, component, proxy, accessManager, implementation string) error
+}
+
+// Require returns t as the optional capability C, or an error wrapping
+// errors.ErrUnsupported that names what needed it when t's target lacks it.
+func Require[C Target](t Target, what string) (C, error) {
+	c, ok := t.(C)
+	if !ok {
+		return c, fmt.Errorf("%w on %s chains: %s", errors.ErrUnsupported, t.Name(), what)
+	}
+	return c, nil
+}
+
+// Predictor is implemented by targets that can deploy at addresses known
+// before anything is sent, like the EVM target's CREATE2 mode. Its
+// predictions hold only while Deterministic reports true.
+type Predictor interface {
+	// Deterministic reports whether the target deploys at predictable
+	// addresses.
+	Deterministic() bool
+	// PredictCore is the core stack ProvisionCore deploys.
+	PredictCore() (CoreRef, error)
+	// PredictGMP is the GMP app ProvisionGMP deploys.
+	PredictGMP(router, accessManager string) (GMPRef, error)
+	// PredictTransfer is the transfer app ProvisionTransfer deploys.
+	PredictTransfer(router, accessManager string) (TransferRef, error)
+	// PredictIFT is the IFT token ProvisionIFT deploys.
+	PredictIFT(gmp string, spec IFTSpec) (IFTRef, error)
+}
+
+// ClientIDAssigner is implemented by targets whose chain assigns client IDs on
+// creation, like ibc-go's "<type>-<sequence>", so ClientSpec.ClientID cannot
+// be chosen there. ProvisionClient returns the assigned ID as the client's
+// address; ClientSteps records the client under it before registering and
+// recognises it on reruns by the counterparty client it tracks.
+type ClientIDAssigner interface {
+	// AssignsClientIDs reports whether the chain assigns client IDs.
+	AssignsClientIDs() bool
+}
+
+// AssignsClientIDs reports whether t's chain assigns client IDs on creation.
+func AssignsClientIDs(t Target) bool {
+	a, ok := t.(ClientIDAssigner)
+	return ok && a.AssignsClientIDs()
+}
@@ -0,0 +1,346 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package evm
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/cosmos/solidity-ibc-eureka/packages/go-abigen/erc1967proxy"
+	"github.com/cosmos/solidity-ibc-eureka/packages/go-abigen/ibcerc20"
+	"github.com/cosmos/solidity-ibc-eureka/packages/go-abigen/ics20transfer"
+	"github.com/cosmos/solidity-ibc-eureka/packages/go-abigen/ics26router"
+	"github.com/cosmos/solidity-ibc-eureka/packages/go-abigen/ics27account"
+	"github.com/cosmos/solidity-ibc-eureka/packages/go-abigen/ics27gmp"
+	"github.com/cosmos/solidity-ibc-eureka/packages/go-abigen/ift"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/cosmos/ibc/gen/go/solidity-abi/accessmanager"
+	"github.com/cosmos/ibc/gen/go/solidity-abi/escrow"
+	"github.com/cosmos/ibc/link/internal/deploy"
+	"github.com/cosmos/ibc/link/internal/service/signer"
+)
+
+// DefaultCreate2Factory is the deterministic deployment proxy
+// (github.com/Arachnid/deterministic-deployment-proxy), present at this
+// address on most EVM chains. Its calldata is a 32-byte salt followed by init
+// code; it creates the contract with CREATE2 and reverts if that fails.
+const DefaultCreate2Factory = "0x4e59b44847b379578588920cA78FbF26c0B4956C"
+
+// The default factory's keyless deployment: a transaction without EIP-155
+// replay protection whose made-up signature recovers to a one-use sender, so
+// it deploys the factory at the same address on every chain that accepts it.
+var (
+	factoryDeployer = common.HexToAddress("0x3fAB184622Dc19b6109349B94811493BF2a45362")
+	factoryDeployTx = common.FromHex("0xf8a58085174876e800830186a08080b853604580600e600039806000f350fe7f" +
+		"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe03601600081602082378035828234f5801515" +
+		"6039578182fd5b8082525050506014600cf31ba0222222222222222222222222222222222222222222222222222222222222" +
+		"2222a02222222222222222222222222222222222222222222222222222222222222222")
+	// factoryDeployCost is what the sender must hold: 100k gas at 100 gwei.
+	factoryDeployCost = new(big.Int).Mul(big.NewInt(100_000), big.NewInt(100_000_000_000))
+)
+
+// create2 is the deterministic mode of a driver: contracts are created by
+// factory under salt, at addresses that depend only on those and the
+// contracts' init code.
+type create2 struct {
+	factory common.Address
+	salt    common.Hash
+
+	mu    sync.Mutex
+	ready bool // factory code seen or deployed
+}
+
+// ParseSalt reads a CREATE2 salt: 32 bytes of 0x-prefixed hex as is, any
+// other string hashed, so the same string gives the same salt everywhere.
+func ParseSalt(salt string) common.Hash {
+	if b, err := hexutil.Decode(salt); err == nil && len(b) == common.HashLength {
+		return common.BytesToHash(b)
+	}
+	return crypto.Keccak256Hash([]byte(salt))
+}
+
+// address is where the factory creates a contract from code.
+func (c *create2) address(code []byte) common.Address {
+	return crypto.CreateAddress2(c.factory, c.salt, crypto.Keccak256(code))
+}
+
+// initCode is the creation bytecode of meta's contract followed by its packed
+// constructor args.
+func initCode(meta *bind.MetaData, args ...any) ([]byte, error) {
+	if strings.Contains(meta.Bin, "__$") {
+		return nil, fmt.Errorf("bytecode needs linked libraries")
+	}
+	code := common.FromHex(meta.Bin)
+	if len(code) == 0 {
+		return nil, fmt.Errorf("bindings carry no creation bytecode")
+	}
+	parsed, err := meta.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	packed, err := parsed.Pack("", args...)
+	if err != nil {
+		return nil, err
+	}
+	return append(code, packed...), nil
+}
+
+// Deterministic reports whether the driver deploys through a CREATE2 factory.
+func (d *Driver) Deterministic() bool {
+	return d.create2 != nil
+}
+
+// sender is the account deployment transactions are sent from.
+func (d *Driver) sender() (common.Address, error) {
+	if err := d.requireSigner(); err != nil {
+		return common.Address{}, err
+	}
+	if d.recorder != nil {
+		return d.from, nil
+	}
+	address, err := signer.EVMAddress(d.deployer)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return common.HexToAddress(address), nil
+}
+
+// deployContract deploys meta's contract with constructor args: through the
+// CREATE2 factory in deterministic mode, else by a plain creation
+// transaction. label names it in logs and errors.
+func (d *Driver) deployContract(
+	ctx context.Context, opts *bind.TransactOpts, label string,
+	meta *bind.MetaData, args ...any,
+) (common.Address, error) {
+	if d.create2 != nil {
+		return d.deployDeterministic(ctx, opts, label, meta, args...)
+	}
+	parsed, err := meta.GetAbi()
+	if err != nil {
+		return common.Address{}, err
+	}
+	addr, tx, _, err := bind.DeployContract(opts, *parsed, common.FromHex(meta.Bin), d.backend, args...)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("deploy %s: %w", label, err)
+	}
+	return addr, d.awaitMined(ctx, "deploy "+label, tx)
+}
+
+// deployDeterministic has the factory create meta's contract, unless code
+// already sits at its address: reruns, and other deployments of the same
+// contract under the same salt, reuse it.
+func (d *Driver) deployDeterministic(
+	ctx context.Context, opts *bind.TransactOpts, label string,
+	meta *bind.MetaData, args ...any,
+) (common.Address, error) {
+	code, err := initCode(meta, args...)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("%s init code: %w", label, err)
+	}
+	addr := d.create2.address(code)
+	exists, err := d.HasCode(ctx, addr.Hex())
+	if err != nil {
+		return common.Address{}, err
+	}
+	if exists {
+		slog.Info("contract already deployed", "label", label, "address", addr.Hex(), "chain", d.chainID)
+		return addr, nil
+	}
+	if err := d.ensureFactory(ctx, opts); err != nil {
+		return common.Address{}, err
+	}
+	factory := bind.NewBoundContract(d.create2.factory, abi.ABI{}, d.backend, d.backend, d.backend)
+	tx, err := factory.RawTransact(opts, append(d.create2.salt.Bytes(), code...))
+	if err != nil {
+		return common.Address{}, fmt.Errorf("deploy %s: %w", label, err)
+	}
+	if err := d.awaitMined(ctx, "deploy "+label, tx); err != nil {
+		return common.Address{}, err
+	}
+	if b, ok := d.backend.(*exportBackend); ok {
+		b.markCreated(addr)
+		return addr, nil
+	}
+	if exists, err = d.HasCode(ctx, addr.Hex()); err != nil {
+		return common.Address{}, err
+	}
+	if !exists {
+		return common.Address{}, fmt.Errorf("deploy %s: factory created no contract at %s", label, addr.Hex())
+	}
+	return addr, nil
+}
+
+// ensureFactory checks the factory is deployed. The default factory is
+// deployed if missing, by funding its one-use sender and replaying its
+// keyless deployment; another factory must already exist.
+func (d *Driver) ensureFactory(ctx context.Context, opts *bind.TransactOpts) error {
+	c := d.create2
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.ready {
+		return nil
+	}
+	exists, err := d.HasCode(ctx, c.factory.Hex())
+	if err != nil {
+		return err
+	}
+	if exists {
+		c.ready = true
+		return nil
+	}
+	if c.factory != common.HexToAddress(DefaultCreate2Factory) {
+		return fmt.Errorf("no CREATE2 factory at %s on chain %s", c.factory.Hex(), d.chainID)
+	}
+	if d.recorder != nil {
+		return fmt.Errorf("no CREATE2 factory at %s on chain %s: an export cannot deploy it, "+
+			"deploy it with a deployer key first", c.factory.Hex(), d.chainID)
+	}
+
+	balance, err := d.backend.BalanceAt(ctx, factoryDeployer, nil)
+	if err != nil {
+		return fmt.Errorf("read factory deployer balance: %w", err)
+	}
+	if missing := new(big.Int).Sub(factoryDeployCost, balance); missing.Sign() > 0 {
+		funding := *opts
+		funding.Value = missing
+		tx, err := bind.NewBoundContract(factoryDeployer, abi.ABI{}, d.backend, d.backend, d.backend).
+			Transfer(&funding)
+		if err != nil {
+			return fmt.Errorf("fund CREATE2 factory deployer: %w", err)
+		}
+		if err := d.awaitMined(ctx, "fund CREATE2 factory deployer", tx); err != nil {
+			return err
+		}
+	}
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(factoryDeployTx); err != nil {
+		return err
+	}
+	if err := d.backend.SendTransaction(ctx, tx); err != nil {
+		return fmt.Errorf("deploy CREATE2 factory (the RPC must accept transactions without "+
+			"EIP-155 replay protection): %w", err)
+	}
+	if err := d.awaitMined(ctx, "deploy CREATE2 factory", tx); err != nil {
+		return err
+	}
+	c.ready = true
+	return nil
+}
+
+// predict is the address meta's contract with constructor args gets in
+// deterministic mode.
+func (d *Driver) predict(meta *bind.MetaData, args ...any) (common.Address, error) {
+	if d.create2 == nil {
+		return common.Address{}, fmt.Errorf("addresses are only predictable in deterministic mode")
+	}
+	code, err := initCode(meta, args...)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return d.create2.address(code), nil
+}
+
+// predictProxy is the address of the ERC1967 proxy deployProxy deploys.
+func (d *Driver) predictProxy(meta *bind.MetaData, impl common.Address, initArgs ...any) (common.Address, error) {
+	init, err := proxyInit(meta, initArgs...)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return d.predict(erc1967proxy.ContractMetaData, impl, init)
+}
+
+// PredictCore is the core stack ProvisionCore deploys in deterministic mode.
+func (d *Driver) PredictCore() (deploy.CoreRef, error) {
+	from, err := d.sender()
+	if err != nil {
+		return deploy.CoreRef{}, err
+	}
+	am, err := d.predict(accessmanager.AccessManagerMetaData, from)
+	if err != nil {
+		return deploy.CoreRef{}, err
+	}
+	impl, err := d.predict(ics26router.ContractMetaData)
+	if err != nil {
+		return deploy.CoreRef{}, err
+	}
+	router, err := d.predictProxy(ics26router.ContractMetaData, impl, am)
+	if err != nil {
+		return deploy.CoreRef{}, err
+	}
+	return d.coreRef(router, am, impl), nil
+}
+
+// PredictGMP is the GMP app ProvisionGMP deploys in deterministic mode.
+func (d *Driver) PredictGMP(router, accessManager string) (deploy.GMPRef, error) {
+	logic, err := d.predict(ics27account.ContractMetaData)
+	if err != nil {
+		return deploy.GMPRef{}, err
+	}
+	impl, err := d.predict(ics27gmp.ContractMetaData)
+	if err != nil {
+		return deploy.GMPRef{}, err
+	}
+	proxy, err := d.predictProxy(ics27gmp.ContractMetaData, impl,
+		common.HexToAddress(router), logic, common.HexToAddress(accessManager))
+	if err != nil {
+		return deploy.GMPRef{}, err
+	}
+	return deploy.GMPRef{Address: proxy.Hex(), AccountLogic: logic.Hex()}, nil
+}
+
+// PredictTransfer is the transfer app ProvisionTransfer deploys in
+// deterministic mode.
+func (d *Driver) PredictTransfer(router, accessManager string) (deploy.TransferRef, error) {
+	escrowLogic, err := d.predict(escrow.EscrowMetaData)
+	if err != nil {
+		return deploy.TransferRef{}, err
+	}
+	erc20Logic, err := d.predict(ibcerc20.ContractMetaData)
+	if err != nil {
+		return deploy.TransferRef{}, err
+	}
+	impl, err := d.predict(ics20transfer.ContractMetaData)
+	if err != nil {
+		return deploy.TransferRef{}, err
+	}
+	proxy, err := d.predictProxy(ics20transfer.ContractMetaData, impl, common.HexToAddress(router),
+		escrowLogic, erc20Logic, common.Address{}, common.HexToAddress(accessManager))
+	if err != nil {
+		return deploy.TransferRef{}, err
+	}
+	return deploy.TransferRef{
+		Address:       proxy.Hex(),
+		EscrowLogic:   escrowLogic.Hex(),
+		IBCERC20Logic: erc20Logic.Hex(),
+	}, nil
+}
+
+// PredictIFT is the IFT token ProvisionIFT deploys in deterministic mode.
+func (d *Driver) PredictIFT(gmp string, spec deploy.IFTSpec) (deploy.IFTRef, error) {
+	if spec.Name == "" || spec.Symbol == "" {
+		return deploy.IFTRef{}, fmt.Errorf("ift name and symbol required")
+	}
+	if !common.IsHexAddress(spec.Owner) {
+		return deploy.IFTRef{}, fmt.Errorf("invalid owner address %q", spec.Owner)
+	}
+	impl, err := d.predict(ift.ContractMetaData)
+	if err != nil {
+		return deploy.IFTRef{}, err
+	}
+	proxy, err := d.predictProxy(ift.ContractMetaData, impl,
+		common.HexToAddress(spec.Owner), spec.Name, spec.Symbol, common.HexToAddress(gmp))
+	if err != nil {
+		return deploy.IFTRef{}, err
+	}
+	return deploy.IFTRef{Address: proxy.Hex()}, nil
+}
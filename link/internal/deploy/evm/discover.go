@@ -0,0 +1,203 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package evm
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/cosmos/solidity-ibc-eureka/packages/go-abigen/attestation"
+	"github.com/cosmos/solidity-ibc-eureka/packages/go-abigen/ics26router"
+	"github.com/cosmos/solidity-ibc-eureka/packages/go-abigen/ift"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/ibc/link/internal/deploy"
+	"github.com/cosmos/ibc/link/internal/deploy/manifest"
+)
+
+// Router events announcing a client or an app. Each carries the client id or
+// port as its first string argument; the rest is looked up on the router.
+const (
+	clientAddedEvent = "ICS02ClientAdded"
+	appAddedEvent    = "IBCAppAdded"
+)
+
+// logRange bounds the blocks of each eth_getLogs request: providers refuse
+// wider ranges.
+const logRange = 10_000
+
+// Discover reads the router at address back from chain state.
+func (d *Driver) Discover(ctx context.Context, router string, fromBlock uint64) (deploy.Discovery, error) {
+	if !common.IsHexAddress(router) {
+		return deploy.Discovery{}, fmt.Errorf("invalid router address %q", router)
+	}
+	hasCode, err := d.HasCode(ctx, router)
+	if err != nil {
+		return deploy.Discovery{}, err
+	}
+	if !hasCode {
+		return deploy.Discovery{}, fmt.Errorf("no contract code at %s", router)
+	}
+	routerAddr := common.HexToAddress(router)
+	contract, err := ics26router.NewContract(routerAddr, d.backend)
+	if err != nil {
+		return deploy.Discovery{}, err
+	}
+	opts := &bind.CallOpts{Context: ctx}
+	accessManager, err := contract.Authority(opts)
+	if err != nil {
+		return deploy.Discovery{}, fmt.Errorf("read router authority: %w", err)
+	}
+	impl, err := d.Implementation(ctx, router)
+	if err != nil {
+		return deploy.Discovery{}, err
+	}
+	disc := deploy.Discovery{
+		Core: deploy.CoreRef{
+			Router: routerAddr.Hex(),
+			TargetData: map[string]string{
+				"accessManager":             accessManager.Hex(),
+				"ics26RouterImplementation": impl,
+			},
+		},
+		Apps: map[string]string{},
+	}
+
+	clientIDs, err := d.eventStrings(ctx, routerAddr, clientAddedEvent, fromBlock)
+	if err != nil {
+		return deploy.Discovery{}, err
+	}
+	for _, clientID := range clientIDs {
+		address, registered, err := d.ClientRegistered(ctx, router, clientID)
+		if err != nil {
+			return deploy.Discovery{}, err
+		}
+		if !registered {
+			continue
+		}
+		cp, err := contract.GetCounterparty(opts, clientID)
+		if err != nil {
+			return deploy.Discovery{}, fmt.Errorf("getCounterparty %q: %w", clientID, err)
+		}
+		c := manifest.Client{ClientID: clientID, Address: address, CounterpartyClientID: cp.ClientId}
+		// only attestation clients answer getAttestationSet; others keep an
+		// empty type
+		if client, err := attestation.NewContract(common.HexToAddress(address), d.backend); err == nil {
+			if set, err := client.GetAttestationSet(opts); err == nil {
+				attestors := make([]string, len(set.AttestorAddresses))
+				for i, a := range set.AttestorAddresses {
+					attestors[i] = a.Hex()
+				}
+				c.Type = deploy.ClientTypeAttestation
+				c.Params = map[string]any{"attestors": attestors, "threshold": set.MinRequiredSigs}
+			}
+		}
+		disc.Clients = append(disc.Clients, c)
+	}
+
+	ports, err := d.eventStrings(ctx, routerAddr, appAddedEvent, fromBlock)
+	if err != nil {
+		return deploy.Discovery{}, err
+	}
+	for _, port := range ports {
+		app, registered, err := d.AppRegistered(ctx, router, port)
+		if err != nil {
+			return deploy.Discovery{}, err
+		}
+		if registered {
+			disc.Apps[port] = app
+		}
+	}
+	return disc, nil
+}
+
+// eventStrings scans address's logs from fromBlock for the named router event
+// and returns its first string argument, once each, in emission order.
+func (d *Driver) eventStrings(
+	ctx context.Context,
+	address common.Address,
+	name string,
+	fromBlock uint64,
+) ([]string, error) {
+	parsed, err := ics26router.ContractMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	event, ok := parsed.Events[name]
+	if !ok {
+		return nil, fmt.Errorf("router ABI has no %s event", name)
+	}
+	args := event.Inputs.NonIndexed()
+	arg := -1
+	for i, input := range args {
+		if input.Type.T == abi.StringTy {
+			arg = i
+			break
+		}
+	}
+	if arg < 0 {
+		return nil, fmt.Errorf("router event %s has no unindexed string argument", name)
+	}
+
+	head, err := d.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("read chain head: %w", err)
+	}
+	latest := head.Number.Uint64()
+	seen := map[string]struct{}{}
+	var out []string
+	for from := fromBlock; from <= latest; from += logRange {
+		to := min(from+logRange-1, latest)
+		logs, err := d.backend.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(from),
+			ToBlock:   new(big.Int).SetUint64(to),
+			Addresses: []common.Address{address},
+			Topics:    [][]common.Hash{{event.ID}},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("scan %s events in blocks %d-%d: %w", name, from, to, err)
+		}
+		for _, log := range logs {
+			values, err := args.Unpack(log.Data)
+			if err != nil {
+				return nil, fmt.Errorf("decode %s event in tx %s: %w", name, log.TxHash, err)
+			}
+			value, _ := values[arg].(string)
+			if _, dup := seen[value]; dup {
+				continue
+			}
+			seen[value] = struct{}{}
+			out = append(out, value)
+		}
+	}
+	return out, nil
+}
+
+// IFTToken reads the IFT token at address.
+func (d *Driver) IFTToken(ctx context.Context, address string) (deploy.IFTSpec, error) {
+	if !common.IsHexAddress(address) {
+		return deploy.IFTSpec{}, fmt.Errorf("invalid ift address %q", address)
+	}
+	token, err := ift.NewContract(common.HexToAddress(address), d.backend)
+	if err != nil {
+		return deploy.IFTSpec{}, err
+	}
+	opts := &bind.CallOpts{Context: ctx}
+	owner, err := token.Owner(opts)
+	if err != nil {
+		return deploy.IFTSpec{}, fmt.Errorf("read owner: %w", err)
+	}
+	name, err := token.Name(opts)
+	if err != nil {
+		return deploy.IFTSpec{}, fmt.Errorf("read name: %w", err)
+	}
+	symbol, err := token.Symbol(opts)
+	if err != nil {
+		return deploy.IFTSpec{}, fmt.Errorf("read symbol: %w", err)
+	}
+	return deploy.IFTSpec{Owner: owner.Hex(), Name: name, Symbol: symbol}, nil
+}
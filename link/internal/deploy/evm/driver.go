@@ -7,16 +7,16 @@ package evm
 
 import (
 	"context"
-	"crypto/ecdsa"
 	"fmt"
 	"log/slog"
 	"math"
 	"math/big"
-	"strings"
 
 	"github.com/cosmos/solidity-ibc-eureka/packages/go-abigen/attestation"
 	"github.com/cosmos/solidity-ibc-eureka/packages/go-abigen/erc1967proxy"
 	"github.com/cosmos/solidity-ibc-eureka/packages/go-abigen/evmiftsendcall"
+	"github.com/cosmos/solidity-ibc-eureka/packages/go-abigen/ibcerc20"
+	"github.com/cosmos/solidity-ibc-eureka/packages/go-abigen/ics20transfer"
 	"github.com/cosmos/solidity-ibc-eureka/packages/go-abigen/ics26router"
 	"github.com/cosmos/solidity-ibc-eureka/packages/go-abigen/ics27account"
 	"github.com/cosmos/solidity-ibc-eureka/packages/go-abigen/ics27gmp"
@@ -24,47 +24,93 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
 
 	"github.com/cosmos/ibc/gen/go/solidity-abi/accessmanager"
+	"github.com/cosmos/ibc/gen/go/solidity-abi/escrow"
 	"github.com/cosmos/ibc/link/internal/deploy"
+	"github.com/cosmos/ibc/link/internal/service/signer"
 )
 
+// TargetName is the target name EVM manifests record.
+const TargetName = "evm"
+
 // backend is the subset of ethclient the driver needs; narrowed for tests.
 type backend interface {
 	bind.ContractBackend
 	bind.DeployBackend
 	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+	StorageAt(ctx context.Context, account common.Address, key common.Hash, blockNumber *big.Int) ([]byte, error)
 }
 
-var _ deploy.Target = (*Driver)(nil)
+var (
+	_ deploy.Target         = (*Driver)(nil)
+	_ deploy.Predictor      = (*Driver)(nil)
+	_ deploy.ClientMigrator = (*Driver)(nil)
+	_ deploy.AppDeployer    = (*Driver)(nil)
+	_ deploy.IFTDeployer    = (*Driver)(nil)
+	_ deploy.Discoverer     = (*Driver)(nil)
+	_ deploy.RoleManager    = (*Driver)(nil)
+	_ deploy.Upgrader       = (*Driver)(nil)
+)
 
 // Driver implements deploy.Target for EVM chains.
 type Driver struct {
-	chainID *big.Int
-	key     *ecdsa.PrivateKey
-	backend backend
+	chainID  *big.Int
+	deployer signer.Signer
+	backend  backend
+	// recorder and from are set in export mode, where transactions from
+	// from are recorded instead of signed and submitted.
+	recorder *deploy.Recorder
+	from     common.Address
+	// create2 is set in deterministic mode.
+	create2 *create2
 }
 
 // Options configures an EVM driver.
 type Options struct {
-	ChainID        string
-	RPCURL         string
-	DeployerKeyHex string
+	ChainID string
+	RPCURL  string
+	// Deployer signs deployment and wiring transactions. Any ecdsa signer
+	// works, local, remote or pkcs11.
+	Deployer signer.Signer
+	// Export, instead of a Deployer, records the transactions From would
+	// send, for a multisig or offline signer to execute later.
+	Export *deploy.Recorder
+	From   string
+	// Salt, when set, deploys contracts through the CREATE2 Factory under it,
+	// at the same addresses on every chain. See ParseSalt. Factory defaults
+	// to DefaultCreate2Factory.
+	Salt    string
+	Factory string
 }
 
-// New connects to the chain and validates its ID. Empty DeployerKeyHex
+// New connects to the chain and validates its ID. A nil Deployer and Export
 // builds a read-only driver: queries only, no provisioning or wiring.
 func New(ctx context.Context, opts Options) (*Driver, error) {
-	var key *ecdsa.PrivateKey
-	if opts.DeployerKeyHex != "" {
-		var err error
-		key, err = crypto.HexToECDSA(strings.TrimPrefix(opts.DeployerKeyHex, "0x"))
-		if err != nil {
-			return nil, fmt.Errorf("deployer key: %w", err)
+	if opts.Deployer != nil && opts.Deployer.Type() != signer.ECDSA {
+		return nil, fmt.Errorf("deployer signer must be %s, got %s", signer.ECDSA, opts.Deployer.Type())
+	}
+	if opts.Export != nil {
+		if opts.Deployer != nil {
+			return nil, fmt.Errorf("export mode records transactions instead of signing them: no deployer signer")
+		}
+		if !common.IsHexAddress(opts.From) {
+			return nil, fmt.Errorf("invalid export sender address %q", opts.From)
 		}
 	}
+	var deterministic *create2
+	if opts.Salt != "" {
+		factory := opts.Factory
+		if factory == "" {
+			factory = DefaultCreate2Factory
+		}
+		if !common.IsHexAddress(factory) {
+			return nil, fmt.Errorf("invalid CREATE2 factory address %q", factory)
+		}
+		deterministic = &create2{factory: common.HexToAddress(factory), salt: ParseSalt(opts.Salt)}
+	}
 	client, err := ethclient.DialContext(ctx, opts.RPCURL)
 	if err != nil {
 		return nil, fmt.Errorf("dial %s: %w", opts.RPCURL, err)
@@ -76,9 +122,20 @@ func New(ctx context.Context, opts Options) (*Driver, error) {
 	if chainID.String() != opts.ChainID {
 		return nil, fmt.Errorf("rpc %s reports chain id %s, config says %s", opts.RPCURL, chainID, opts.ChainID)
 	}
-	return &Driver{chainID: chainID, key: key, backend: client}, nil
+	if opts.Export != nil {
+		return &Driver{
+			chainID:  chainID,
+			backend:  &exportBackend{backend: client, recorder: opts.Export, from: common.HexToAddress(opts.From)},
+			recorder: opts.Export,
+			from:     common.HexToAddress(opts.From),
+			create2:  deterministic,
+		}, nil
+	}
+	return &Driver{chainID: chainID, deployer: opts.Deployer, backend: client, create2: deterministic}, nil
 }
 
+func (d *Driver) Name() string { return TargetName }
+
 func (d *Driver) SupportedClientTypes() []string {
 	return []string{deploy.ClientTypeAttestation}
 }
@@ -86,7 +143,7 @@ func (d *Driver) SupportedClientTypes() []string {
 // requireSigner errors if called on a driver built without a deployer
 // signer; every mutating operation must call it first.
 func (d *Driver) requireSigner() error {
-	if d.key == nil {
+	if d.deployer == nil && d.recorder == nil {
 		return fmt.Errorf("no deployer signer configured for this chain: set chains[].deployer or pass --deployer")
 	}
 	return nil
@@ -98,52 +155,61 @@ func (d *Driver) deployProxy(
 	ctx context.Context, opts *bind.TransactOpts, label string,
 	meta *bind.MetaData, impl common.Address, initArgs ...any,
 ) (common.Address, error) {
-	parsed, err := meta.GetAbi()
+	init, err := proxyInit(meta, initArgs...)
 	if err != nil {
 		return common.Address{}, err
 	}
-	init, err := parsed.Pack("initialize", initArgs...)
-	if err != nil {
-		return common.Address{}, err
-	}
-	addr, tx, _, err := erc1967proxy.DeployContract(opts, d.backend, impl, init)
+	return d.deployContract(ctx, opts, label+" proxy", erc1967proxy.ContractMetaData, impl, init)
+}
+
+// proxyInit packs initialize(initArgs...) from meta's ABI.
+func proxyInit(meta *bind.MetaData, initArgs ...any) ([]byte, error) {
+	parsed, err := meta.GetAbi()
 	if err != nil {
-		return common.Address{}, fmt.Errorf("deploy %s proxy: %w", label, err)
+		return nil, err
 	}
-	return addr, d.awaitMined(ctx, "deploy "+label+" proxy", tx)
+	return parsed.Pack("initialize", initArgs...)
 }
 
 // ProvisionCore deploys AccessManager + ICS26Router (implementation behind
 // an initialized ERC1967 proxy), then binds the relaying selectors to
-// PUBLIC_ROLE so any relayer EOA can submit packets. The deployer key stays
-// the AccessManager admin; role hardening is a follow-up.
+// PUBLIC_ROLE so any relayer EOA can submit packets. The deployer stays the
+// AccessManager admin until RestrictRelaying and HandOffAdmin harden it. A
+// deterministic deployment reusing a stack leaves open selectors as they are
+// and refuses restricted ones.
 func (d *Driver) ProvisionCore(ctx context.Context, _ deploy.CoreParams) (deploy.CoreRef, error) {
 	opts, err := d.transactOpts(ctx)
 	if err != nil {
 		return deploy.CoreRef{}, err
 	}
-	selectors, err := publicRelayingSelectors()
+	selectors, err := relayingSelectors()
 	if err != nil {
 		return deploy.CoreRef{}, err
 	}
-	amAddr, amTx, am, err := accessmanager.DeployAccessManager(
-		opts,
-		d.backend,
-		crypto.PubkeyToAddress(d.key.PublicKey),
-	)
+	// a deterministic rerun reuses an existing AccessManager, whose
+	// relaying roles may have been hardened since
+	var reused bool
+	if d.create2 != nil {
+		predicted, predictErr := d.predict(accessmanager.AccessManagerMetaData, opts.From)
+		if predictErr != nil {
+			return deploy.CoreRef{}, predictErr
+		}
+		if reused, err = d.HasCode(ctx, predicted.Hex()); err != nil {
+			return deploy.CoreRef{}, err
+		}
+	}
+	amAddr, err := d.deployContract(ctx, opts, "AccessManager", accessmanager.AccessManagerMetaData, opts.From)
 	if err != nil {
-		return deploy.CoreRef{}, fmt.Errorf("deploy AccessManager: %w", err)
+		return deploy.CoreRef{}, err
 	}
-	if mineErr := d.awaitMined(ctx, "deploy AccessManager", amTx); mineErr != nil {
-		return deploy.CoreRef{}, mineErr
+	am, err := accessmanager.NewAccessManager(amAddr, d.backend)
+	if err != nil {
+		return deploy.CoreRef{}, err
 	}
 
-	implAddr, implTx, _, err := ics26router.DeployContract(opts, d.backend)
+	implAddr, err := d.deployContract(ctx, opts, "ICS26Router implementation", ics26router.ContractMetaData)
 	if err != nil {
-		return deploy.CoreRef{}, fmt.Errorf("deploy ICS26Router implementation: %w", err)
-	}
-	if mineErr := d.awaitMined(ctx, "deploy ICS26Router implementation", implTx); mineErr != nil {
-		return deploy.CoreRef{}, mineErr
+		return deploy.CoreRef{}, err
 	}
 
 	routerAddr, err := d.deployProxy(ctx, opts, "ICS26Router", ics26router.ContractMetaData, implAddr, amAddr)
@@ -151,6 +217,15 @@ func (d *Driver) ProvisionCore(ctx context.Context, _ deploy.CoreParams) (deploy
 		return deploy.CoreRef{}, err
 	}
 
+	if reused {
+		open, openErr := d.relayingOpen(ctx, routerAddr, amAddr)
+		if openErr != nil {
+			return deploy.CoreRef{}, openErr
+		}
+		if open {
+			return d.coreRef(routerAddr, amAddr, implAddr), nil
+		}
+	}
 	// OZ AccessManager PUBLIC_ROLE is type(uint64).max
 	roleTx, err := am.SetTargetFunctionRole(opts, routerAddr, selectors, uint64(math.MaxUint64))
 	if err != nil {
@@ -160,13 +235,46 @@ func (d *Driver) ProvisionCore(ctx context.Context, _ deploy.CoreParams) (deploy
 		return deploy.CoreRef{}, mineErr
 	}
 
-	return deploy.CoreRef{
-		Router: routerAddr.Hex(),
+	return d.coreRef(routerAddr, amAddr, implAddr), nil
+}
+
+// relayingOpen reports whether a reused core stack's relaying selectors are
+// already bound to PUBLIC_ROLE. Selectors still at the ADMIN_ROLE default
+// were never opened; any other role is a restriction a core rerun must not
+// undo.
+func (d *Driver) relayingOpen(ctx context.Context, router, accessManager common.Address) (bool, error) {
+	roles, err := d.RelayingRoles(ctx, router.Hex(), accessManager.Hex())
+	if err != nil {
+		return false, err
+	}
+	if allRelayingRoles(roles, deploy.PublicRole) {
+		return true, nil
+	}
+	for _, name := range relayingMethods {
+		if role := roles[name]; role != deploy.AdminRole && role != deploy.PublicRole {
+			return false, fmt.Errorf("relaying selectors of router %s are restricted to role %s: "+
+				"the existing core stack is hardened, record it with `ibc deploy import` instead",
+				router.Hex(), roleName(role))
+		}
+	}
+	return false, nil
+}
+
+// coreRef records a core stack. Deterministic ones also record the factory
+// and salt that reproduce their addresses.
+func (d *Driver) coreRef(router, accessManager, impl common.Address) deploy.CoreRef {
+	ref := deploy.CoreRef{
+		Router: router.Hex(),
 		TargetData: map[string]string{
-			"accessManager":             amAddr.Hex(),
-			"ics26RouterImplementation": implAddr.Hex(),
+			"accessManager":             accessManager.Hex(),
+			"ics26RouterImplementation": impl.Hex(),
 		},
-	}, nil
+	}
+	if d.create2 != nil {
+		ref.TargetData["create2Factory"] = d.create2.factory.Hex()
+		ref.TargetData["create2Salt"] = d.create2.salt.Hex()
+	}
+	return ref
 }
 
 // ProvisionClient deploys a light client contract.
@@ -241,19 +349,13 @@ func (d *Driver) ProvisionGMP(ctx context.Context, router, accessManager string)
 	if err != nil {
 		return deploy.GMPRef{}, err
 	}
-	logicAddr, logicTx, _, err := ics27account.DeployContract(opts, d.backend)
+	logicAddr, err := d.deployContract(ctx, opts, "ICS27Account logic", ics27account.ContractMetaData)
 	if err != nil {
-		return deploy.GMPRef{}, fmt.Errorf("deploy ICS27Account logic: %w", err)
-	}
-	if mineErr := d.awaitMined(ctx, "deploy ICS27Account logic", logicTx); mineErr != nil {
-		return deploy.GMPRef{}, mineErr
+		return deploy.GMPRef{}, err
 	}
-	implAddr, implTx, _, err := ics27gmp.DeployContract(opts, d.backend)
+	implAddr, err := d.deployContract(ctx, opts, "ICS27GMP implementation", ics27gmp.ContractMetaData)
 	if err != nil {
-		return deploy.GMPRef{}, fmt.Errorf("deploy ICS27GMP implementation: %w", err)
-	}
-	if mineErr := d.awaitMined(ctx, "deploy ICS27GMP implementation", implTx); mineErr != nil {
-		return deploy.GMPRef{}, mineErr
+		return deploy.GMPRef{}, err
 	}
 	proxyAddr, err := d.deployProxy(ctx, opts, "ICS27GMP", ics27gmp.ContractMetaData, implAddr,
 		common.HexToAddress(router), logicAddr, common.HexToAddress(accessManager))
@@ -263,6 +365,38 @@ func (d *Driver) ProvisionGMP(ctx context.Context, router, accessManager string)
 	return deploy.GMPRef{Address: proxyAddr.Hex(), AccountLogic: logicAddr.Hex()}, nil
 }
 
+// ProvisionTransfer deploys the Escrow and IBCERC20 logic impls, the
+// ICS20Transfer impl, and an ERC1967 proxy initialized with the router, both
+// logic impls, no Permit2, and AccessManager authority.
+func (d *Driver) ProvisionTransfer(ctx context.Context, router, accessManager string) (deploy.TransferRef, error) {
+	opts, err := d.transactOpts(ctx)
+	if err != nil {
+		return deploy.TransferRef{}, err
+	}
+	escrowAddr, err := d.deployContract(ctx, opts, "Escrow logic", escrow.EscrowMetaData)
+	if err != nil {
+		return deploy.TransferRef{}, err
+	}
+	erc20Addr, err := d.deployContract(ctx, opts, "IBCERC20 logic", ibcerc20.ContractMetaData)
+	if err != nil {
+		return deploy.TransferRef{}, err
+	}
+	implAddr, err := d.deployContract(ctx, opts, "ICS20Transfer implementation", ics20transfer.ContractMetaData)
+	if err != nil {
+		return deploy.TransferRef{}, err
+	}
+	proxyAddr, err := d.deployProxy(ctx, opts, "ICS20Transfer", ics20transfer.ContractMetaData, implAddr,
+		common.HexToAddress(router), escrowAddr, erc20Addr, common.Address{}, common.HexToAddress(accessManager))
+	if err != nil {
+		return deploy.TransferRef{}, err
+	}
+	return deploy.TransferRef{
+		Address:       proxyAddr.Hex(),
+		EscrowLogic:   escrowAddr.Hex(),
+		IBCERC20Logic: erc20Addr.Hex(),
+	}, nil
+}
+
 // ProvisionIFT deploys an IFT impl and an ERC1967 proxy initialized with the
 // owner, name, symbol, and GMP address.
 func (d *Driver) ProvisionIFT(ctx context.Context, gmp string, spec deploy.IFTSpec) (deploy.IFTRef, error) {
@@ -276,12 +410,9 @@ func (d *Driver) ProvisionIFT(ctx context.Context, gmp string, spec deploy.IFTSp
 	if err != nil {
 		return deploy.IFTRef{}, err
 	}
-	implAddr, implTx, _, err := ift.DeployContract(opts, d.backend)
+	implAddr, err := d.deployContract(ctx, opts, "IFT implementation", ift.ContractMetaData)
 	if err != nil {
-		return deploy.IFTRef{}, fmt.Errorf("deploy IFT implementation: %w", err)
-	}
-	if mineErr := d.awaitMined(ctx, "deploy IFT implementation", implTx); mineErr != nil {
-		return deploy.IFTRef{}, mineErr
+		return deploy.IFTRef{}, err
 	}
 	proxyAddr, err := d.deployProxy(ctx, opts, "IFT", ift.ContractMetaData, implAddr,
 		common.HexToAddress(spec.Owner), spec.Name, spec.Symbol, common.HexToAddress(gmp))
@@ -298,12 +429,9 @@ func (d *Driver) ProvisionSendCallConstructor(ctx context.Context) (string, erro
 	if err != nil {
 		return "", err
 	}
-	addr, tx, _, err := evmiftsendcall.DeployContract(opts, d.backend)
+	addr, err := d.deployContract(ctx, opts, "EVMIFTSendCallConstructor", evmiftsendcall.ContractMetaData)
 	if err != nil {
-		return "", fmt.Errorf("deploy EVMIFTSendCallConstructor: %w", err)
-	}
-	if mineErr := d.awaitMined(ctx, "deploy EVMIFTSendCallConstructor", tx); mineErr != nil {
-		return "", mineErr
+		return "", err
 	}
 	return addr.Hex(), nil
 }
@@ -314,17 +442,19 @@ func (d *Driver) transactOpts(ctx context.Context) (*bind.TransactOpts, error) {
 	if err := d.requireSigner(); err != nil {
 		return nil, err
 	}
-	opts, err := bind.NewKeyedTransactorWithChainID(d.key, d.chainID)
-	if err != nil {
-		return nil, err
+	if d.recorder != nil {
+		return d.exportOpts(ctx), nil
 	}
-	opts.Context = ctx
-	return opts, nil
+	return signer.NewTransactor(ctx, d.deployer, d.chainID)
 }
 
 // awaitMined waits for tx and errors on revert. Mined transactions are
 // logged with their hash; manifests record only addresses.
 func (d *Driver) awaitMined(ctx context.Context, label string, tx *types.Transaction) error {
+	if d.recorder != nil {
+		slog.Info("transaction recorded", "label", label, "nonce", tx.Nonce(), "chain", d.chainID)
+		return nil
+	}
 	receipt, err := bind.WaitMined(ctx, d.backend, tx)
 	if err != nil {
 		return fmt.Errorf("%s: wait mined: %w", label, err)
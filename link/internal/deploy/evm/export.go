@@ -0,0 +1,186 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package evm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/cosmos/ibc/link/internal/deploy"
+)
+
+// exportGasLimit is set on export-mode transactions so bind skips gas
+// estimation, which would run against state the earlier recorded calls have
+// not changed yet. Recorded calls carry no gas: the signer estimates it.
+const exportGasLimit = 1
+
+// exportBackend records the transactions the driver sends instead of
+// submitting them. It numbers them on from the sender's pending nonce, so the
+// contracts an exported bundle creates land at the addresses bind reports
+// and later calls reference.
+type exportBackend struct {
+	backend
+	recorder *deploy.Recorder
+	from     common.Address
+
+	mu      sync.Mutex
+	nonce   uint64
+	loaded  bool
+	created map[common.Address]struct{}
+}
+
+func (b *exportBackend) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.loaded {
+		nonce, err := b.backend.PendingNonceAt(ctx, account)
+		if err != nil {
+			return 0, err
+		}
+		b.nonce, b.loaded = nonce, true
+	}
+	return b.nonce, nil
+}
+
+func (b *exportBackend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	call := deploy.Call{
+		Value: tx.Value().String(),
+		Data:  hexutil.Encode(tx.Data()),
+		Nonce: tx.Nonce(),
+	}
+	if tx.To() != nil {
+		call.To = tx.To().Hex()
+	}
+	if err := b.recorder.Record(ctx, call); err != nil {
+		return err
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if tx.To() == nil {
+		if b.created == nil {
+			b.created = make(map[common.Address]struct{})
+		}
+		b.created[crypto.CreateAddress(b.from, tx.Nonce())] = struct{}{}
+	}
+	b.nonce = tx.Nonce() + 1
+	return nil
+}
+
+// markCreated records that the export creates a contract at address through
+// a factory call.
+func (b *exportBackend) markCreated(address common.Address) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.created == nil {
+		b.created = make(map[common.Address]struct{})
+	}
+	b.created[address] = struct{}{}
+}
+
+// exportCreated reports whether address is a contract the export creates.
+// It has no code yet: the steps reading it plan on the fresh contract the
+// recorded creation leaves, registered nothing.
+func (d *Driver) exportCreated(address string) bool {
+	b, ok := d.backend.(*exportBackend)
+	if !ok {
+		return false
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	_, created := b.created[common.HexToAddress(address)]
+	return created
+}
+
+// exportOpts builds transact options that hand transactions from d.from to
+// the export backend unsigned.
+func (d *Driver) exportOpts(ctx context.Context) *bind.TransactOpts {
+	return &bind.TransactOpts{
+		From: d.from,
+		Signer: func(_ common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			return tx, nil
+		},
+		GasLimit: exportGasLimit,
+		Context:  ctx,
+	}
+}
+
+// SafeBatch is a Safe Transaction Builder batch file.
+type SafeBatch struct {
+	Version      string            `json:"version"`
+	ChainID      string            `json:"chainId"`
+	CreatedAt    int64             `json:"createdAt"`
+	Meta         SafeBatchMeta     `json:"meta"`
+	Transactions []SafeTransaction `json:"transactions"`
+}
+
+// SafeBatchMeta describes a Safe Transaction Builder batch.
+type SafeBatchMeta struct {
+	Name                   string `json:"name"`
+	Description            string `json:"description"`
+	CreatedFromSafeAddress string `json:"createdFromSafeAddress"`
+}
+
+// SafeTransaction is one raw call in a Safe Transaction Builder batch. The
+// method fields stay null: the builder decodes nothing and sends data as is.
+type SafeTransaction struct {
+	To                   string `json:"to"`
+	Value                string `json:"value"`
+	Data                 string `json:"data"`
+	ContractMethod       any    `json:"contractMethod"`
+	ContractInputsValues any    `json:"contractInputsValues"`
+}
+
+// NewSafeBatch builds the Safe Transaction Builder batch executing calls from
+// safe on chainID.
+func NewSafeBatch(chainID, safe string, calls []deploy.Call, createdAt time.Time) (SafeBatch, error) {
+	batch := SafeBatch{
+		Version:   "1.0",
+		ChainID:   chainID,
+		CreatedAt: createdAt.UnixMilli(),
+		Meta: SafeBatchMeta{
+			Name:                   "ibc deploy on chain " + chainID,
+			Description:            strings.Join(stepNames(calls), "; "),
+			CreatedFromSafeAddress: safe,
+		},
+		Transactions: make([]SafeTransaction, 0, len(calls)),
+	}
+	for _, call := range calls {
+		if call.To == "" {
+			return SafeBatch{}, fmt.Errorf("step %q deploys a contract, which a Safe batch cannot", call.Step)
+		}
+		batch.Transactions = append(batch.Transactions, SafeTransaction{
+			To:    call.To,
+			Value: call.Value,
+			Data:  call.Data,
+		})
+	}
+	return batch, nil
+}
+
+// Bundle is a bundle of unsigned transactions for From to sign and submit in
+// nonce order.
+type Bundle struct {
+	ChainID      string        `json:"chainId"`
+	From         string        `json:"from"`
+	Transactions []deploy.Call `json:"transactions"`
+}
+
+// stepNames lists the steps calls belong to, in order, once each.
+func stepNames(calls []deploy.Call) []string {
+	var names []string
+	for _, call := range calls {
+		if len(names) == 0 || names[len(names)-1] != call.Step {
+			names = append(names, call.Step)
+		}
+	}
+	return names
+}
@@ -0,0 +1,264 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package evm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/ibc/gen/go/solidity-abi/accessmanager"
+	"github.com/cosmos/ibc/link/internal/deploy"
+	"github.com/cosmos/ibc/link/internal/deploy/manifest"
+)
+
+// RelayingRoles reads the AccessManager role of each relaying method of the
+// router.
+func (d *Driver) RelayingRoles(ctx context.Context, router, accessManager string) (map[string]uint64, error) {
+	am, err := accessmanager.NewAccessManager(common.HexToAddress(accessManager), d.backend)
+	if err != nil {
+		return nil, err
+	}
+	selectors, err := relayingSelectors()
+	if err != nil {
+		return nil, err
+	}
+	roles := make(map[string]uint64, len(relayingMethods))
+	for i, name := range relayingMethods {
+		role, err := am.GetTargetFunctionRole(&bind.CallOpts{Context: ctx}, common.HexToAddress(router), selectors[i])
+		if err != nil {
+			return nil, fmt.Errorf("getTargetFunctionRole %s: %w", name, err)
+		}
+		roles[name] = role
+	}
+	return roles, nil
+}
+
+// Role queries AccessManager.hasRole.
+func (d *Driver) Role(
+	ctx context.Context,
+	accessManager string,
+	roleID uint64,
+	account string,
+) (deploy.RoleGrant, error) {
+	if !common.IsHexAddress(account) {
+		return deploy.RoleGrant{}, fmt.Errorf("invalid account address %q", account)
+	}
+	am, err := accessmanager.NewAccessManager(common.HexToAddress(accessManager), d.backend)
+	if err != nil {
+		return deploy.RoleGrant{}, err
+	}
+	grant, err := am.HasRole(&bind.CallOpts{Context: ctx}, roleID, common.HexToAddress(account))
+	if err != nil {
+		return deploy.RoleGrant{}, fmt.Errorf("hasRole %d %s: %w", roleID, account, err)
+	}
+	return deploy.RoleGrant{Member: grant.IsMember, ExecutionDelay: grant.ExecutionDelay}, nil
+}
+
+// RestrictRelaying grants the relayer role before binding the relaying
+// methods to it, so relaying never stops, and skips grants, revocations and
+// bindings already in place.
+func (d *Driver) RestrictRelaying(
+	ctx context.Context,
+	router, accessManager string,
+	roleID uint64,
+	grant, revoke []string,
+) error {
+	for _, account := range append(append([]string{}, grant...), revoke...) {
+		if !common.IsHexAddress(account) {
+			return fmt.Errorf("invalid relayer address %q", account)
+		}
+	}
+	am, err := accessmanager.NewAccessManager(common.HexToAddress(accessManager), d.backend)
+	if err != nil {
+		return err
+	}
+	opts, err := d.transactOpts(ctx)
+	if err != nil {
+		return err
+	}
+	for _, account := range grant {
+		current, err := d.Role(ctx, accessManager, roleID, account)
+		if err != nil {
+			return err
+		}
+		if current.Member {
+			continue
+		}
+		tx, err := am.GrantRole(opts, roleID, common.HexToAddress(account), 0)
+		if err != nil {
+			return fmt.Errorf("grantRole %d to %s: %w", roleID, account, err)
+		}
+		if err := d.awaitMined(ctx, "grantRole relayer "+account, tx); err != nil {
+			return err
+		}
+	}
+	for _, account := range revoke {
+		current, err := d.Role(ctx, accessManager, roleID, account)
+		if err != nil {
+			return err
+		}
+		if !current.Member {
+			continue
+		}
+		tx, err := am.RevokeRole(opts, roleID, common.HexToAddress(account))
+		if err != nil {
+			return fmt.Errorf("revokeRole %d from %s: %w", roleID, account, err)
+		}
+		if err := d.awaitMined(ctx, "revokeRole relayer "+account, tx); err != nil {
+			return err
+		}
+	}
+	roles, err := d.RelayingRoles(ctx, router, accessManager)
+	if err != nil {
+		return err
+	}
+	if allRelayingRoles(roles, roleID) {
+		return nil
+	}
+	selectors, err := relayingSelectors()
+	if err != nil {
+		return err
+	}
+	tx, err := am.SetTargetFunctionRole(opts, common.HexToAddress(router), selectors, roleID)
+	if err != nil {
+		return fmt.Errorf("setTargetFunctionRole: %w", err)
+	}
+	return d.awaitMined(ctx, "setTargetFunctionRole relayer", tx)
+}
+
+// HandOffAdmin grants ADMIN_ROLE to admin, unless it already holds it with
+// executionDelay, then renounces the deployer's ADMIN_ROLE if it still holds
+// it.
+func (d *Driver) HandOffAdmin(
+	ctx context.Context,
+	accessManager, admin string,
+	executionDelay uint32,
+) (string, error) {
+	if !common.IsHexAddress(admin) {
+		return "", fmt.Errorf("invalid admin address %q", admin)
+	}
+	am, err := accessmanager.NewAccessManager(common.HexToAddress(accessManager), d.backend)
+	if err != nil {
+		return "", err
+	}
+	opts, err := d.transactOpts(ctx)
+	if err != nil {
+		return "", err
+	}
+	deployer := opts.From
+	if deployer == common.HexToAddress(admin) {
+		return "", fmt.Errorf("admin %s is the deployer itself", admin)
+	}
+	current, err := d.Role(ctx, accessManager, deploy.AdminRole, admin)
+	if err != nil {
+		return "", err
+	}
+	if !current.Member || current.ExecutionDelay != executionDelay {
+		tx, err := am.GrantRole(opts, deploy.AdminRole, common.HexToAddress(admin), executionDelay)
+		if err != nil {
+			return "", fmt.Errorf("grantRole admin to %s: %w", admin, err)
+		}
+		if err := d.awaitMined(ctx, "grantRole admin "+admin, tx); err != nil {
+			return "", err
+		}
+	}
+	self, err := d.Role(ctx, accessManager, deploy.AdminRole, deployer.Hex())
+	if err != nil {
+		return "", err
+	}
+	if self.Member {
+		tx, err := am.RenounceRole(opts, deploy.AdminRole, deployer)
+		if err != nil {
+			return "", fmt.Errorf("renounceRole admin: %w", err)
+		}
+		if err := d.awaitMined(ctx, "renounceRole admin", tx); err != nil {
+			return "", err
+		}
+	}
+	return deployer.Hex(), nil
+}
+
+// verifyRoles checks the live roles against the recorded ones: relaying
+// restricted to the relayer role, or open to PUBLIC_ROLE when none is
+// recorded, and the admin hand-off.
+func (d *Driver) verifyRoles(
+	ctx context.Context,
+	m *manifest.Manifest,
+	check func(name string, ok bool, detail string),
+) error {
+	am := m.TargetData["accessManager"]
+	if am == "" {
+		return nil
+	}
+	recorded := m.Roles
+	if recorded == nil {
+		recorded = &manifest.Roles{}
+	}
+
+	wantRole := deploy.PublicRole
+	if len(recorded.Relayers) > 0 {
+		wantRole = recorded.RelayerRole
+	}
+	roles, err := d.RelayingRoles(ctx, m.Core.Router, am)
+	if err != nil {
+		return err
+	}
+	for _, name := range relayingMethods {
+		check(
+			"relaying method "+name+" bound to role "+roleName(wantRole),
+			roles[name] == wantRole,
+			fmt.Sprintf("bound to role %s", roleName(roles[name])),
+		)
+	}
+	for _, relayer := range recorded.Relayers {
+		grant, err := d.Role(ctx, am, recorded.RelayerRole, relayer)
+		if err != nil {
+			return err
+		}
+		check("relayer "+relayer+" holds role "+roleName(recorded.RelayerRole), grant.Member, "role not granted")
+	}
+
+	if recorded.Admin == "" {
+		return nil
+	}
+	grant, err := d.Role(ctx, am, deploy.AdminRole, recorded.Admin)
+	if err != nil {
+		return err
+	}
+	check(
+		fmt.Sprintf("admin %s holds admin role with %ds delay", recorded.Admin, recorded.AdminDelay),
+		grant.Member && grant.ExecutionDelay == recorded.AdminDelay,
+		fmt.Sprintf("member %t, delay %ds", grant.Member, grant.ExecutionDelay),
+	)
+	if recorded.FormerAdmin != "" {
+		former, err := d.Role(ctx, am, deploy.AdminRole, recorded.FormerAdmin)
+		if err != nil {
+			return err
+		}
+		check("former admin "+recorded.FormerAdmin+" renounced admin role", !former.Member, "still holds admin role")
+	}
+	return nil
+}
+
+func allRelayingRoles(roles map[string]uint64, roleID uint64) bool {
+	for _, name := range relayingMethods {
+		if roles[name] != roleID {
+			return false
+		}
+	}
+	return true
+}
+
+func roleName(roleID uint64) string {
+	switch roleID {
+	case deploy.AdminRole:
+		return "ADMIN_ROLE"
+	case deploy.PublicRole:
+		return "PUBLIC_ROLE"
+	default:
+		return fmt.Sprint(roleID)
+	}
+}
@@ -0,0 +1,212 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package evm
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"runtime/debug"
+
+	"github.com/cosmos/solidity-ibc-eureka/packages/go-abigen/ics20transfer"
+	"github.com/cosmos/solidity-ibc-eureka/packages/go-abigen/ics26router"
+	"github.com/cosmos/solidity-ibc-eureka/packages/go-abigen/ics27gmp"
+	"github.com/cosmos/solidity-ibc-eureka/packages/go-abigen/ift"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/ibc/gen/go/solidity-abi/accessmanager"
+	"github.com/cosmos/ibc/link/internal/deploy"
+)
+
+// bindingsModule is the module the contract bindings, and so the bytecode of
+// every implementation the driver deploys, are pinned to.
+const bindingsModule = "github.com/cosmos/solidity-ibc-eureka/packages/go-abigen"
+
+// erc1967ImplementationSlot is the ERC1967 proxy storage slot holding the
+// implementation address, bytes32(uint256(keccak256("eip1967.proxy.implementation")) - 1).
+var erc1967ImplementationSlot = common.HexToHash("0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc")
+
+// ContractsVersion is the pinned version of the contract bindings.
+func (d *Driver) ContractsVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "unknown"
+	}
+	for _, dep := range info.Deps {
+		if dep.Path != bindingsModule {
+			continue
+		}
+		if dep.Replace != nil && dep.Replace.Version != "" {
+			return dep.Replace.Version
+		}
+		return dep.Version
+	}
+	return "unknown"
+}
+
+// Implementation reads the ERC1967 implementation slot of proxy.
+func (d *Driver) Implementation(ctx context.Context, proxy string) (string, error) {
+	if !common.IsHexAddress(proxy) {
+		return "", fmt.Errorf("invalid proxy address %q", proxy)
+	}
+	slot, err := d.backend.StorageAt(ctx, common.HexToAddress(proxy), erc1967ImplementationSlot, nil)
+	if err != nil {
+		return "", fmt.Errorf("read implementation of %s: %w", proxy, err)
+	}
+	return common.BytesToAddress(slot).Hex(), nil
+}
+
+// ProvisionImplementation deploys a new implementation of component from the
+// pinned bindings.
+func (d *Driver) ProvisionImplementation(ctx context.Context, component string) (string, error) {
+	opts, err := d.transactOpts(ctx)
+	if err != nil {
+		return "", err
+	}
+	meta, err := componentMetaData(component)
+	if err != nil {
+		return "", err
+	}
+	addr, err := d.deployContract(ctx, opts, component+" implementation", meta)
+	if err != nil {
+		return "", err
+	}
+	return addr.Hex(), nil
+}
+
+// UpgradeProxy checks implementation can replace proxy's current one, then
+// calls upgradeToAndCall on proxy: through accessManager for the
+// AccessManaged router, GMP and transfer apps, directly as owner for IFT tokens.
+func (d *Driver) UpgradeProxy(ctx context.Context, component, proxy, accessManager, implementation string) error {
+	meta, err := componentMetaData(component)
+	if err != nil {
+		return err
+	}
+	parsed, err := meta.GetAbi()
+	if err != nil {
+		return err
+	}
+	if err := d.checkUpgrade(ctx, parsed, proxy, implementation); err != nil {
+		return fmt.Errorf("%s %s cannot be upgraded to %s: %w", component, proxy, implementation, err)
+	}
+	data, err := parsed.Pack("upgradeToAndCall", common.HexToAddress(implementation), []byte{})
+	if err != nil {
+		return err
+	}
+	opts, err := d.transactOpts(ctx)
+	if err != nil {
+		return err
+	}
+	label := fmt.Sprintf("upgrade %s to %s", component, implementation)
+
+	if component == deploy.ComponentIFT {
+		contract := bind.NewBoundContract(common.HexToAddress(proxy), *parsed, d.backend, d.backend, d.backend)
+		tx, txErr := contract.RawTransact(opts, data)
+		if txErr != nil {
+			return fmt.Errorf("%s: %w", label, txErr)
+		}
+		return d.awaitMined(ctx, label, tx)
+	}
+
+	if accessManager == "" {
+		return fmt.Errorf("%s: no accessManager recorded", label)
+	}
+	am, err := accessmanager.NewAccessManager(common.HexToAddress(accessManager), d.backend)
+	if err != nil {
+		return err
+	}
+	allowed, err := am.CanCall(&bind.CallOpts{Context: ctx}, opts.From, common.HexToAddress(proxy), [4]byte(data[:4]))
+	if err != nil {
+		return fmt.Errorf("canCall: %w", err)
+	}
+	if !allowed.Immediate {
+		return fmt.Errorf(
+			"%s: deployer %s cannot upgrade %s without a delay: schedule upgradeToAndCall(%s, 0x) "+
+				"on %s through the AccessManager admin",
+			label, opts.From.Hex(), proxy, implementation, proxy,
+		)
+	}
+	tx, err := am.Execute(opts, common.HexToAddress(proxy), data)
+	if err != nil {
+		return fmt.Errorf("%s: %w", label, err)
+	}
+	return d.awaitMined(ctx, label, tx)
+}
+
+// checkUpgrade checks what can be checked on chain: implementation has code,
+// is UUPS, so the proxy stays upgradeable, and speaks the same upgrade
+// interface version as the current implementation. Storage layouts cannot be
+// compared on chain; the contracts keep their state in ERC-7201 namespaces.
+func (d *Driver) checkUpgrade(ctx context.Context, parsed *abi.ABI, proxy, implementation string) error {
+	if !common.IsHexAddress(implementation) {
+		return fmt.Errorf("invalid implementation address %q", implementation)
+	}
+	hasCode, err := d.HasCode(ctx, implementation)
+	if err != nil {
+		return err
+	}
+	if !hasCode {
+		return fmt.Errorf("no contract code at %s", implementation)
+	}
+
+	out, err := d.call(ctx, parsed, implementation, "proxiableUUID")
+	if err != nil {
+		return fmt.Errorf("implementation is not UUPS upgradeable: %w", err)
+	}
+	if uuid, ok := out[0].([32]byte); !ok || !bytes.Equal(uuid[:], erc1967ImplementationSlot[:]) {
+		return fmt.Errorf("implementation proxiableUUID %x is not the ERC1967 implementation slot", out[0])
+	}
+
+	current, err := d.call(ctx, parsed, proxy, "UPGRADE_INTERFACE_VERSION")
+	if err != nil {
+		return fmt.Errorf("read current upgrade interface version: %w", err)
+	}
+	next, err := d.call(ctx, parsed, implementation, "UPGRADE_INTERFACE_VERSION")
+	if err != nil {
+		return fmt.Errorf("read new upgrade interface version: %w", err)
+	}
+	if current[0] != next[0] {
+		return fmt.Errorf("upgrade interface version %v, current implementation has %v", next[0], current[0])
+	}
+	return nil
+}
+
+// call invokes the argumentless view method on address and unpacks its
+// result.
+func (d *Driver) call(ctx context.Context, parsed *abi.ABI, address, method string) ([]any, error) {
+	input, err := parsed.Pack(method)
+	if err != nil {
+		return nil, err
+	}
+	to := common.HexToAddress(address)
+	output, err := d.backend.CallContract(ctx, ethereum.CallMsg{To: &to, Data: input}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", method, err)
+	}
+	out, err := parsed.Unpack(method, output)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", method, err)
+	}
+	if len(out) == 0 {
+		return nil, fmt.Errorf("%s: empty result", method)
+	}
+	return out, nil
+}
+
+func componentMetaData(component string) (*bind.MetaData, error) {
+	switch component {
+	case deploy.ComponentRouter:
+		return ics26router.ContractMetaData, nil
+	case deploy.ComponentGMP:
+		return ics27gmp.ContractMetaData, nil
+	case deploy.ComponentTransfer:
+		return ics20transfer.ContractMetaData, nil
+	case deploy.ComponentIFT:
+		return ift.ContractMetaData, nil
+	default:
+		return nil, fmt.Errorf("unknown upgradeable component %q", component)
+	}
+}
@@ -8,8 +8,10 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/cosmos/solidity-ibc-eureka/packages/go-abigen/attestation"
 	"github.com/cosmos/solidity-ibc-eureka/packages/go-abigen/ics26router"
 	"github.com/cosmos/solidity-ibc-eureka/packages/go-abigen/ift"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 
@@ -17,12 +19,14 @@ import (
 	"github.com/cosmos/ibc/link/internal/deploy/manifest"
 )
 
-// evmMerklePrefix is the empty prefix EVM counterparties use.
+// evmMerklePrefix is the empty prefix EVM counterparties use, and the default
+// of a ClientSpec without one.
 var evmMerklePrefix = [][]byte{{}}
 
-// publicRelayingMethods are opened to PUBLIC_ROLE so any relayer EOA can
-// submit packets and client updates.
-var publicRelayingMethods = []string{
+// relayingMethods are opened to PUBLIC_ROLE by ProvisionCore so any relayer
+// EOA can submit packets and client updates, until RestrictRelaying binds
+// them to a relayer role.
+var relayingMethods = []string{
 	"recvPacket",
 	"ackPacket",
 	"timeoutPacket",
@@ -39,6 +43,9 @@ var (
 	iftBridgeNotFoundSelector = customErrorSelector(ift.ContractMetaData, "IFTBridgeNotFound")
 )
 
+// MerklePrefix is the empty prefix of the router's commitments.
+func (d *Driver) MerklePrefix() [][]byte { return evmMerklePrefix }
+
 // RegisterClient calls ICS26Router.addClient with the custom client ID.
 func (d *Driver) RegisterClient(
 	ctx context.Context,
@@ -54,9 +61,13 @@ func (d *Driver) RegisterClient(
 	if err != nil {
 		return "", err
 	}
+	prefix := spec.CounterpartyMerklePrefix
+	if prefix == nil {
+		prefix = evmMerklePrefix
+	}
 	tx, err := contract.AddClient(opts, spec.ClientID, ics26router.IICS02ClientMsgsCounterpartyInfo{
 		ClientId:     spec.CounterpartyClientID,
-		MerklePrefix: evmMerklePrefix,
+		MerklePrefix: prefix,
 	}, common.HexToAddress(ref.Address))
 	if err != nil {
 		return "", fmt.Errorf("addClient %q: %w", spec.ClientID, err)
@@ -69,6 +80,9 @@ func (d *Driver) RegisterClient(
 
 // ClientRegistered queries the router for clientID.
 func (d *Driver) ClientRegistered(ctx context.Context, router, clientID string) (string, bool, error) {
+	if d.exportCreated(router) {
+		return "", false, nil
+	}
 	contract, err := ics26router.NewContract(common.HexToAddress(router), d.backend)
 	if err != nil {
 		return "", false, err
@@ -98,6 +112,94 @@ func (d *Driver) ClientRegistered(ctx context.Context, router, clientID string)
 	}
 }
 
+// attestationClientState mirrors the ABI-encoded client state returned by
+// AttestationLightClient.getClientState.
+type attestationClientState struct {
+	AttestorAddresses []common.Address
+	MinRequiredSigs   uint8
+	LatestHeight      uint64
+	IsFrozen          bool
+}
+
+// attestationClientStateArgs decodes getClientState's bytes.
+var attestationClientStateArgs = func() abi.Arguments {
+	typ, err := abi.NewType("tuple", "", []abi.ArgumentMarshaling{
+		{Name: "attestorAddresses", Type: "address[]"},
+		{Name: "minRequiredSigs", Type: "uint8"},
+		{Name: "latestHeight", Type: "uint64"},
+		{Name: "isFrozen", Type: "bool"},
+	})
+	if err != nil {
+		panic(err)
+	}
+	return abi.Arguments{{Type: typ}}
+}()
+
+// AttestationState reads the registered attestation client's attestor set
+// and its latest trusted height and timestamp.
+func (d *Driver) AttestationState(ctx context.Context, router, clientID string) (deploy.AttestationState, error) {
+	address, registered, err := d.ClientRegistered(ctx, router, clientID)
+	if err != nil {
+		return deploy.AttestationState{}, err
+	}
+	if !registered {
+		return deploy.AttestationState{}, fmt.Errorf("client %q not registered on router %s", clientID, router)
+	}
+	client, err := attestation.NewContract(common.HexToAddress(address), d.backend)
+	if err != nil {
+		return deploy.AttestationState{}, err
+	}
+	opts := &bind.CallOpts{Context: ctx}
+	set, err := client.GetAttestationSet(opts)
+	if err != nil {
+		return deploy.AttestationState{}, fmt.Errorf("getAttestationSet %q: %w", clientID, err)
+	}
+	encoded, err := client.GetClientState(opts)
+	if err != nil {
+		return deploy.AttestationState{}, fmt.Errorf("getClientState %q: %w", clientID, err)
+	}
+	unpacked, err := attestationClientStateArgs.Unpack(encoded)
+	if err != nil {
+		return deploy.AttestationState{}, fmt.Errorf("decode client state %q: %w", clientID, err)
+	}
+	clientState := *abi.ConvertType(unpacked[0], new(attestationClientState)).(*attestationClientState)
+	if clientState.IsFrozen {
+		return deploy.AttestationState{}, fmt.Errorf("client %q is frozen", clientID)
+	}
+	timestamp, err := client.GetConsensusTimestamp(opts, clientState.LatestHeight)
+	if err != nil {
+		return deploy.AttestationState{}, fmt.Errorf("getConsensusTimestamp %q: %w", clientID, err)
+	}
+	attestors := make([]string, len(set.AttestorAddresses))
+	for i, a := range set.AttestorAddresses {
+		attestors[i] = a.Hex()
+	}
+	return deploy.AttestationState{
+		Attestors:       attestors,
+		Threshold:       set.MinRequiredSigs,
+		LatestHeight:    clientState.LatestHeight,
+		LatestTimestamp: timestamp,
+	}, nil
+}
+
+// MigrateClient calls the restricted ICS26Router.migrateClient, pointing
+// clientID at substituteClientID's client contract.
+func (d *Driver) MigrateClient(ctx context.Context, router, clientID, substituteClientID string) error {
+	contract, err := ics26router.NewContract(common.HexToAddress(router), d.backend)
+	if err != nil {
+		return err
+	}
+	opts, err := d.transactOpts(ctx)
+	if err != nil {
+		return err
+	}
+	tx, err := contract.MigrateClient(opts, clientID, substituteClientID)
+	if err != nil {
+		return fmt.Errorf("migrateClient %q to %q: %w", clientID, substituteClientID, err)
+	}
+	return d.awaitMined(ctx, "migrateClient "+clientID, tx)
+}
+
 // getClientError classifies a getClient failure.
 type getClientError int
 
@@ -138,6 +240,9 @@ func classifyGetClientError(err error) getClientError {
 }
 
 func (d *Driver) HasCode(ctx context.Context, address string) (bool, error) {
+	if d.exportCreated(address) {
+		return true, nil
+	}
 	code, err := d.backend.CodeAt(ctx, common.HexToAddress(address), nil)
 	if err != nil {
 		return false, err
@@ -154,15 +259,14 @@ func (d *Driver) Head(ctx context.Context) (uint64, uint64, error) {
 	return header.Number.Uint64(), header.Time, nil
 }
 
-// publicRelayingSelectors resolves the relaying method selectors that
-// ProvisionCore binds to AccessManager's PUBLIC_ROLE.
-func publicRelayingSelectors() ([][4]byte, error) {
+// relayingSelectors resolves the selectors of relayingMethods.
+func relayingSelectors() ([][4]byte, error) {
 	routerABI, err := ics26router.ContractMetaData.GetAbi()
 	if err != nil {
 		return nil, err
 	}
-	selectors := make([][4]byte, 0, len(publicRelayingMethods))
-	for _, name := range publicRelayingMethods {
+	selectors := make([][4]byte, 0, len(relayingMethods))
+	for _, name := range relayingMethods {
 		method, ok := routerABI.Methods[name]
 		if !ok {
 			return nil, fmt.Errorf("router ABI has no method %q", name)
@@ -252,6 +356,25 @@ func (d *Driver) Verify(ctx context.Context, m *manifest.Manifest) (deploy.Repor
 		}
 	}
 
+	if m.Transfer != nil && m.Transfer.Address != "" {
+		transferCode, codeErr := d.HasCode(ctx, m.Transfer.Address)
+		if codeErr != nil {
+			return report, codeErr
+		}
+		check("transfer app code present", transferCode, "no code at "+m.Transfer.Address)
+		if transferCode {
+			appAddr, registered, appErr := d.AppRegistered(ctx, m.Core.Router, m.Transfer.Port)
+			if appErr != nil {
+				return report, appErr
+			}
+			check(
+				"transfer app registered at port "+m.Transfer.Port,
+				registered && strings.EqualFold(appAddr, m.Transfer.Address),
+				fmt.Sprintf("router has %q at port %s, manifest has %s", appAddr, m.Transfer.Port, m.Transfer.Address),
+			)
+		}
+	}
+
 	for _, tok := range m.Tokens {
 		tokenCode, codeErr := d.HasCode(ctx, tok.Address)
 		if codeErr != nil {
@@ -273,6 +396,10 @@ func (d *Driver) Verify(ctx context.Context, m *manifest.Manifest) (deploy.Repor
 			)
 		}
 	}
+
+	if err := d.verifyRoles(ctx, m, check); err != nil {
+		return report, err
+	}
 	return report, nil
 }
 
@@ -329,6 +456,9 @@ func (d *Driver) RegisterApp(ctx context.Context, router, app, port string) erro
 
 // AppRegistered queries the router for the app at port.
 func (d *Driver) AppRegistered(ctx context.Context, router, port string) (string, bool, error) {
+	if d.exportCreated(router) {
+		return "", false, nil
+	}
 	contract, err := ics26router.NewContract(common.HexToAddress(router), d.backend)
 	if err != nil {
 		return "", false, err
@@ -374,6 +504,9 @@ func (d *Driver) RegisterIFTBridge(ctx context.Context, iftAddr string, spec dep
 // IFTBridge queries the token for a bridge registered under clientID, returning
 // its counterparty address and send-call constructor.
 func (d *Driver) IFTBridge(ctx context.Context, iftAddr, clientID string) (string, string, bool, error) {
+	if d.exportCreated(iftAddr) {
+		return "", "", false, nil
+	}
 	contract, err := ift.NewContract(common.HexToAddress(iftAddr), d.backend)
 	if err != nil {
 		return "", "", false, err
@@ -21,15 +21,25 @@ import (
 
 	"github.com/cosmos/ibc/link/internal/deploy"
 	"github.com/cosmos/ibc/link/internal/deploy/manifest"
+	"github.com/cosmos/ibc/link/internal/service/signer"
+	"github.com/cosmos/ibc/link/keyfile"
 )
 
 const simChainID = 1337 // ethclient/simulated fixed chain id
 
 func newSimDriver(t *testing.T) (*Driver, *simulated.Backend, common.Address) {
 	t.Helper()
-	key, err := crypto.GenerateKey()
+	key, err := signer.GenerateLocalKey(keyfile.ECDSA)
 	require.NoError(t, err)
-	addr := crypto.PubkeyToAddress(key.PublicKey)
+	return newSimDriverWithKey(t, key)
+}
+
+// newSimDriverWithKey is newSimDriver on a fresh chain for an existing key.
+func newSimDriverWithKey(t *testing.T, key signer.Signer) (*Driver, *simulated.Backend, common.Address) {
+	t.Helper()
+	address, err := signer.EVMAddress(key)
+	require.NoError(t, err)
+	addr := common.HexToAddress(address)
 
 	// Geth 1.17.5 activates Bogota on dev chains (simulated.NewBackend uses
 	// params.AllDevChainProtocolChanges); under Bogota the gas estimator
@@ -40,8 +50,10 @@ func newSimDriver(t *testing.T) (*Driver, *simulated.Backend, common.Address) {
 	conf.BogotaTime = nil
 	sim := simulated.NewBackend(types.GenesisAlloc{
 		addr: {Balance: new(big.Int).Lsh(big.NewInt(1), 100)},
-	}, func(_ *node.Config, ec *ethconfig.Config) {
+	}, func(nc *node.Config, ec *ethconfig.Config) {
 		ec.Genesis.Config = &conf
+		// the keyless CREATE2 factory deployment has no replay protection
+		nc.AllowUnprotectedTxs = true
 	})
 	t.Cleanup(func() { _ = sim.Close() })
 
@@ -59,7 +71,7 @@ func newSimDriver(t *testing.T) (*Driver, *simulated.Backend, common.Address) {
 		}
 	}()
 
-	d := &Driver{chainID: big.NewInt(simChainID), backend: sim.Client(), key: key}
+	d := &Driver{chainID: big.NewInt(simChainID), backend: sim.Client(), deployer: key}
 	return d, sim, addr
 }
 
@@ -133,10 +145,10 @@ func TestProvisionRegisterVerify(t *testing.T) {
 
 // The PUBLIC_ROLE grant happens inside ProvisionCore and is asserted
 // end-to-end by the e2e module's deploy test; this pins the selector set.
-func TestPublicRelayingSelectors(t *testing.T) {
-	selectors, err := publicRelayingSelectors()
+func TestRelayingSelectors(t *testing.T) {
+	selectors, err := relayingSelectors()
 	require.NoError(t, err)
-	require.Len(t, selectors, len(publicRelayingMethods))
+	require.Len(t, selectors, len(relayingMethods))
 
 	routerABI, err := ics26router.ContractMetaData.GetAbi()
 	require.NoError(t, err)
@@ -200,6 +212,70 @@ func TestProvisionGMPRegister(t *testing.T) {
 	require.Equal(t, ref.Address, got)
 }
 
+func TestProvisionTransferVerify(t *testing.T) {
+	d, _, _ := newSimDriver(t)
+	ctx := context.Background()
+
+	core, err := d.ProvisionCore(ctx, deploy.CoreParams{})
+	require.NoError(t, err)
+
+	ref, err := d.ProvisionTransfer(ctx, core.Router, core.TargetData["accessManager"])
+	require.NoError(t, err)
+	require.NotEmpty(t, ref.Address)
+	require.NotEmpty(t, ref.EscrowLogic)
+	require.NotEmpty(t, ref.IBCERC20Logic)
+	require.NoError(t, d.RegisterApp(ctx, core.Router, ref.Address, deploy.TransferPortID))
+
+	m := manifest.New("1337", "evm")
+	m.Core.Router = core.Router
+	m.TargetData = core.TargetData
+	m.Transfer = &manifest.Transfer{
+		Address:       ref.Address,
+		EscrowLogic:   ref.EscrowLogic,
+		IBCERC20Logic: ref.IBCERC20Logic,
+		Port:          deploy.TransferPortID,
+	}
+	report, err := d.Verify(ctx, m)
+	require.NoError(t, err)
+	require.Empty(t, report.Failed())
+
+	// drift: the router serves another app at the transfer port
+	broken := *m
+	broken.Transfer = &manifest.Transfer{Address: core.Router, Port: deploy.TransferPortID}
+	report, err = d.Verify(ctx, &broken)
+	require.NoError(t, err)
+	require.NotEmpty(t, report.Failed())
+}
+
+func TestUpgradeRouter(t *testing.T) {
+	d, _, _ := newSimDriver(t)
+	ctx := context.Background()
+
+	core, err := d.ProvisionCore(ctx, deploy.CoreParams{})
+	require.NoError(t, err)
+	am := core.TargetData["accessManager"]
+
+	impl, err := d.Implementation(ctx, core.Router)
+	require.NoError(t, err)
+	require.Equal(t, core.TargetData["ics26RouterImplementation"], impl)
+
+	next, err := d.ProvisionImplementation(ctx, deploy.ComponentRouter)
+	require.NoError(t, err)
+	require.NoError(t, d.UpgradeProxy(ctx, deploy.ComponentRouter, core.Router, am, next))
+
+	impl, err = d.Implementation(ctx, core.Router)
+	require.NoError(t, err)
+	require.Equal(t, next, impl)
+
+	// the AccessManager is no UUPS implementation
+	err = d.UpgradeProxy(ctx, deploy.ComponentRouter, core.Router, am, am)
+	require.ErrorContains(t, err, "not UUPS upgradeable")
+
+	// rolling back is an upgrade to the previous implementation
+	require.NoError(t, d.UpgradeProxy(ctx, deploy.ComponentRouter, core.Router, am,
+		core.TargetData["ics26RouterImplementation"]))
+}
+
 func TestProvisionIFTAndBridge(t *testing.T) {
 	d, _, owner := newSimDriver(t)
 	ctx := context.Background()
@@ -234,6 +310,56 @@ func TestProvisionIFTAndBridge(t *testing.T) {
 	require.Equal(t, ctor, gotCtor)
 }
 
+func TestDiscoverAndIFTToken(t *testing.T) {
+	d, _, owner := newSimDriver(t)
+	ctx := context.Background()
+
+	core, err := d.ProvisionCore(ctx, deploy.CoreParams{})
+	require.NoError(t, err)
+	spec := deploy.ClientSpec{
+		ClientID:             "link-2",
+		Type:                 deploy.ClientTypeAttestation,
+		CounterpartyChainID:  "2",
+		CounterpartyClientID: "link-1",
+		Params: deploy.AttestationParams{
+			Attestors:        []string{"0x00000000000000000000000000000000000000aa"},
+			Threshold:        1,
+			InitialHeight:    5,
+			InitialTimestamp: 500,
+		},
+	}
+	client, err := d.ProvisionClient(ctx, core.Router, spec)
+	require.NoError(t, err)
+	_, err = d.RegisterClient(ctx, core.Router, spec, client)
+	require.NoError(t, err)
+	gmp, err := d.ProvisionGMP(ctx, core.Router, core.TargetData["accessManager"])
+	require.NoError(t, err)
+	require.NoError(t, d.RegisterApp(ctx, core.Router, gmp.Address, deploy.GMPPortID))
+
+	disc, err := d.Discover(ctx, core.Router, 0)
+	require.NoError(t, err)
+	require.Equal(t, core.Router, disc.Core.Router)
+	require.Equal(t, core.TargetData, disc.Core.TargetData)
+	require.Len(t, disc.Clients, 1)
+	c := disc.Clients[0]
+	require.Equal(t, "link-2", c.ClientID)
+	require.Equal(t, client.Address, c.Address)
+	require.Equal(t, "link-1", c.CounterpartyClientID)
+	require.Equal(t, deploy.ClientTypeAttestation, c.Type)
+	attestor := common.HexToAddress("0x00000000000000000000000000000000000000aa").Hex()
+	require.Equal(t, []string{attestor}, c.Params["attestors"])
+	require.Equal(t, map[string]string{deploy.GMPPortID: gmp.Address}, disc.Apps)
+
+	token, err := d.ProvisionIFT(ctx, gmp.Address, deploy.IFTSpec{Owner: owner.Hex(), Name: "Foo", Symbol: "FOO"})
+	require.NoError(t, err)
+	got, err := d.IFTToken(ctx, token.Address)
+	require.NoError(t, err)
+	require.Equal(t, deploy.IFTSpec{Owner: owner.Hex(), Name: "Foo", Symbol: "FOO"}, got)
+
+	_, err = d.Discover(ctx, "0x0000000000000000000000000000000000000123", 0)
+	require.ErrorContains(t, err, "no contract code")
+}
+
 func TestVerifyGMPAndIFT(t *testing.T) {
 	d, _, owner := newSimDriver(t)
 	ctx := context.Background()
@@ -275,3 +401,147 @@ func TestVerifyGMPAndIFT(t *testing.T) {
 	require.NoError(t, err)
 	require.NotEmpty(t, report.Failed())
 }
+
+func TestExportRecordsCalls(t *testing.T) {
+	d, _, addr := newSimDriver(t)
+	ctx := context.Background()
+
+	core, err := d.ProvisionCore(ctx, deploy.CoreParams{})
+	require.NoError(t, err)
+	live, err := d.ProvisionGMP(ctx, core.Router, core.TargetData["accessManager"])
+	require.NoError(t, err)
+	exporting := func(creations bool) (*Driver, *deploy.Recorder) {
+		rec := &deploy.Recorder{Creations: creations}
+		return &Driver{
+			chainID:  d.chainID,
+			backend:  &exportBackend{backend: d.backend, recorder: rec, from: addr},
+			recorder: rec,
+			from:     addr,
+		}, rec
+	}
+
+	// a multisig batch records calls but refuses contract creations
+	batch, rec := exporting(false)
+	require.NoError(t, batch.RegisterApp(ctx, core.Router, live.Address, deploy.GMPPortID))
+	_, err = batch.ProvisionTransfer(ctx, core.Router, core.TargetData["accessManager"])
+	require.ErrorContains(t, err, "multisig batch cannot")
+	calls := rec.Calls()
+	require.Len(t, calls, 1)
+	require.True(t, common.IsHexAddress(calls[0].To))
+	require.Equal(t, common.HexToAddress(core.Router), common.HexToAddress(calls[0].To))
+	require.NotEqual(t, "0x", calls[0].Data)
+	// nothing was submitted
+	_, registered, err := d.AppRegistered(ctx, core.Router, deploy.GMPPortID)
+	require.NoError(t, err)
+	require.False(t, registered)
+
+	// a bundle creates contracts at the addresses its sender's nonces give
+	bundle, rec := exporting(true)
+	nonce, err := d.backend.PendingNonceAt(ctx, addr)
+	require.NoError(t, err)
+	ref, err := bundle.ProvisionTransfer(ctx, core.Router, core.TargetData["accessManager"])
+	require.NoError(t, err)
+	calls = rec.Calls()
+	require.Len(t, calls, 4)
+	for i, call := range calls {
+		require.Empty(t, call.To)
+		require.Equal(t, nonce+uint64(i), call.Nonce)
+	}
+	require.Equal(t, crypto.CreateAddress(addr, nonce+3).Hex(), ref.Address)
+	// the recorded contracts read as fresh ones while the export plans on
+	ok, err := bundle.HasCode(ctx, ref.Address)
+	require.NoError(t, err)
+	require.True(t, ok)
+	ok, err = d.HasCode(ctx, ref.Address)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	safe, err := NewSafeBatch("1337", addr.Hex(), calls, time.Unix(1, 0))
+	require.ErrorContains(t, err, "Safe batch cannot")
+	require.Empty(t, safe.Transactions)
+}
+
+func TestDeterministicDeploy(t *testing.T) {
+	ctx := context.Background()
+	key, err := signer.GenerateLocalKey(keyfile.ECDSA)
+	require.NoError(t, err)
+
+	var stacks [][]string
+	for chain := range 2 {
+		d, _, addr := newSimDriverWithKey(t, key)
+		if chain == 1 {
+			// a different deployer nonce, which plain creation addresses
+			// would depend on
+			_, err := d.ProvisionSendCallConstructor(ctx)
+			require.NoError(t, err)
+		}
+		d.create2 = &create2{factory: common.HexToAddress(DefaultCreate2Factory), salt: ParseSalt("ibc-test")}
+
+		predicted, err := d.PredictCore()
+		require.NoError(t, err)
+		hasCode, err := d.HasCode(ctx, predicted.Router)
+		require.NoError(t, err)
+		require.False(t, hasCode)
+
+		// the factory is missing on a fresh chain and deployed first
+		core, err := d.ProvisionCore(ctx, deploy.CoreParams{})
+		require.NoError(t, err)
+		require.Equal(t, predicted, core)
+		hasCode, err = d.HasCode(ctx, DefaultCreate2Factory)
+		require.NoError(t, err)
+		require.True(t, hasCode)
+
+		// a rerun finds every contract deployed
+		again, err := d.ProvisionCore(ctx, deploy.CoreParams{})
+		require.NoError(t, err)
+		require.Equal(t, core, again)
+
+		am := core.TargetData["accessManager"]
+		predictedGMP, err := d.PredictGMP(core.Router, am)
+		require.NoError(t, err)
+		gmp, err := d.ProvisionGMP(ctx, core.Router, am)
+		require.NoError(t, err)
+		require.Equal(t, predictedGMP, gmp)
+
+		spec := deploy.IFTSpec{Owner: addr.Hex(), Name: "Foo", Symbol: "FOO"}
+		predictedIFT, err := d.PredictIFT(gmp.Address, spec)
+		require.NoError(t, err)
+		token, err := d.ProvisionIFT(ctx, gmp.Address, spec)
+		require.NoError(t, err)
+		require.Equal(t, predictedIFT, token)
+
+		stacks = append(stacks, []string{core.Router, am, gmp.Address, token.Address})
+	}
+	require.Equal(t, stacks[0], stacks[1])
+}
+
+// A deterministic core rerun over a hardened stack keeps its relaying
+// restriction instead of reopening it.
+func TestDeterministicRerunKeepsRestrictedRelaying(t *testing.T) {
+	ctx := context.Background()
+	d, sim, addr := newSimDriver(t)
+	d.create2 = &create2{factory: common.HexToAddress(DefaultCreate2Factory), salt: ParseSalt("ibc-test")}
+
+	core, err := d.ProvisionCore(ctx, deploy.CoreParams{})
+	require.NoError(t, err)
+	am := core.TargetData["accessManager"]
+
+	// an open stack is reused without sending anything
+	nonce, err := sim.Client().PendingNonceAt(ctx, addr)
+	require.NoError(t, err)
+	again, err := d.ProvisionCore(ctx, deploy.CoreParams{})
+	require.NoError(t, err)
+	require.Equal(t, core, again)
+	rerunNonce, err := sim.Client().PendingNonceAt(ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, nonce, rerunNonce)
+
+	const relayerRole = 7
+	require.NoError(t, d.RestrictRelaying(ctx, core.Router, am, relayerRole, []string{addr.Hex()}, nil))
+	_, err = d.ProvisionCore(ctx, deploy.CoreParams{})
+	require.ErrorContains(t, err, "restricted to role 7")
+
+	roles, err := d.RelayingRoles(ctx, core.Router, am)
+	require.NoError(t, err)
+	require.True(t, allRelayingRoles(roles, relayerRole))
+}
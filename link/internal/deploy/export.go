@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Call is one transaction a step would have submitted, recorded instead in
+// export mode. To is empty for a contract creation.
+type Call struct {
+	Step  string `json:"step"`
+	To    string `json:"to,omitempty"`
+	Value string `json:"value"`
+	Data  string `json:"data"`
+	Nonce uint64 `json:"nonce"`
+}
+
+// Recorder collects the calls of one chain's exported plan, for an offline
+// signer or a multisig to execute later.
+type Recorder struct {
+	// Creations allows contract creations. Only a bundle executed by the
+	// account it was built for can create contracts at the addresses later
+	// calls reference; a multisig batch cannot.
+	Creations bool
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+// Record appends call, attributed to the step running in ctx.
+func (r *Recorder) Record(ctx context.Context, call Call) error {
+	if call.To == "" && !r.Creations {
+		return fmt.Errorf("step %q deploys a contract, which a multisig batch cannot: "+
+			"export an unsigned bundle instead, or run the step with a deployer key", StepName(ctx))
+	}
+	call.Step = StepName(ctx)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, call)
+	return nil
+}
+
+// Calls returns the recorded calls in order.
+func (r *Recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Call(nil), r.calls...)
+}
+
+type stepKey struct{}
+
+// StepName is the name of the step RunSteps is running in ctx, empty outside
+// a step.
+func StepName(ctx context.Context) string {
+	name, _ := ctx.Value(stepKey{}).(string)
+	return name
+}
+
+func withStep(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, stepKey{}, name)
+}
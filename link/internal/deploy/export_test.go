@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorder(t *testing.T) {
+	rec := &Recorder{}
+	record := func(name string, call Call) Step {
+		return Step{Name: name, Run: func(ctx context.Context) error { return rec.Record(ctx, call) }}
+	}
+
+	_, err := RunSteps(context.Background(), slog.Default(), false, []Step{
+		record("wire app", Call{To: "0xrouter", Value: "0", Data: "0x01", Nonce: 4}),
+		record("grant role", Call{To: "0xmanager", Value: "0", Data: "0x02", Nonce: 5}),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []Call{
+		{Step: "wire app", To: "0xrouter", Value: "0", Data: "0x01", Nonce: 4},
+		{Step: "grant role", To: "0xmanager", Value: "0", Data: "0x02", Nonce: 5},
+	}, rec.Calls())
+
+	// creations need a bundle its sender executes
+	_, err = RunSteps(context.Background(), slog.Default(), false, []Step{
+		record("deploy app", Call{Value: "0", Data: "0x60"}),
+	})
+	require.ErrorContains(t, err, `step "deploy app" deploys a contract, which a multisig batch cannot`)
+	require.Len(t, rec.Calls(), 2)
+
+	rec.Creations = true
+	require.NoError(t, rec.Record(context.Background(), Call{Value: "0", Data: "0x60"}))
+	require.Empty(t, rec.Calls()[2].Step)
+}
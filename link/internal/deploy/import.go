@@ -0,0 +1,273 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/cosmos/ibc/link/internal/deploy/manifest"
+)
+
+// ImportOptions are the inputs of Import besides chain state.
+type ImportOptions struct {
+	ChainID string
+	// Target names the deployment target the manifest records.
+	Target string
+	Router string
+	// FromBlock bounds the scan of the router's events.
+	FromBlock uint64
+	// Tokens are the addresses of the IFT tokens to import. Tokens are not
+	// registered on the router, so they cannot be discovered.
+	Tokens []string
+	// CounterpartyChains maps client ids to the chain their counterparty is
+	// on, which the chain does not record.
+	CounterpartyChains map[string]string
+	// Previous is the manifest being rebuilt, if any. It contributes what
+	// chain state cannot: logic contracts, launch-time client parameters,
+	// the CREATE2 salt, roles and upgrade history.
+	Previous *manifest.Manifest
+}
+
+// Import rebuilds the manifest of the deployment behind opts.Router from
+// chain state: the core stack, the registered clients, the GMP and transfer
+// apps, and the given IFT tokens with a bridge over any of the clients.
+func Import(ctx context.Context, t Discoverer, opts ImportOptions) (*manifest.Manifest, error) {
+	disc, err := t.Discover(ctx, opts.Router, opts.FromBlock)
+	if err != nil {
+		return nil, fmt.Errorf("discover router %s: %w", opts.Router, err)
+	}
+	prev := opts.Previous
+	if prev == nil {
+		prev = manifest.New(opts.ChainID, opts.Target)
+	}
+
+	m := manifest.New(opts.ChainID, opts.Target)
+	m.Core.Router = disc.Core.Router
+	m.TargetData = maps.Clone(disc.Core.TargetData)
+	if strings.EqualFold(prev.Core.Router, m.Core.Router) {
+		// such as the CREATE2 factory and salt the stack was deployed with
+		for key, value := range prev.TargetData {
+			if _, ok := m.TargetData[key]; !ok {
+				if m.TargetData == nil {
+					m.TargetData = map[string]string{}
+				}
+				m.TargetData[key] = value
+			}
+		}
+	}
+	for _, c := range disc.Clients {
+		if chainID, ok := opts.CounterpartyChains[c.ClientID]; ok {
+			c.CounterpartyChainID = chainID
+		}
+		if old, ok := prev.Client(c.ClientID); ok && strings.EqualFold(old.Address, c.Address) {
+			if c.CounterpartyChainID == "" {
+				c.CounterpartyChainID = old.CounterpartyChainID
+			}
+			for _, key := range []string{"initialHeight", "initialTimestamp"} {
+				if v, ok := old.Params[key]; ok && c.Params != nil {
+					c.Params[key] = v
+				}
+			}
+		}
+		m.UpsertClient(c)
+	}
+
+	if address, ok := disc.Apps[GMPPortID]; ok {
+		m.GMP = &manifest.GMP{Address: address, Port: GMPPortID}
+		if prev.GMP != nil && strings.EqualFold(prev.GMP.Address, address) {
+			m.GMP.AccountLogic = prev.GMP.AccountLogic
+		}
+	}
+	if address, ok := disc.Apps[TransferPortID]; ok {
+		m.Transfer = &manifest.Transfer{Address: address, Port: TransferPortID}
+		if prev.Transfer != nil && strings.EqualFold(prev.Transfer.Address, address) {
+			m.Transfer.EscrowLogic = prev.Transfer.EscrowLogic
+			m.Transfer.IBCERC20Logic = prev.Transfer.IBCERC20Logic
+		}
+	}
+
+	for _, address := range opts.Tokens {
+		if _, dup := m.TokenByAddress(address); dup {
+			continue
+		}
+		tok, err := importToken(ctx, t, address, m.Clients)
+		if err != nil {
+			return nil, err
+		}
+		m.Tokens = append(m.Tokens, tok)
+	}
+
+	// only the previous record knows these; they stay for Verify to check
+	m.Roles = prev.Roles
+	m.Proxies = slices.Clone(prev.Proxies)
+	m.EVMSendCallConstructor = prev.EVMSendCallConstructor
+	return m, nil
+}
+
+// importToken reads the IFT token at address and its bridges over clients.
+func importToken(ctx context.Context, t Discoverer, address string, clients []manifest.Client) (manifest.Token, error) {
+	spec, err := t.IFTToken(ctx, address)
+	if err != nil {
+		return manifest.Token{}, fmt.Errorf("ift token %s: %w", address, err)
+	}
+	tok := manifest.Token{Symbol: spec.Symbol, Name: spec.Name, Address: address, Owner: spec.Owner}
+	for _, c := range clients {
+		counterparty, constructor, registered, err := t.IFTBridge(ctx, address, c.ClientID)
+		if err != nil {
+			return manifest.Token{}, fmt.Errorf("ift token %s bridge %s: %w", address, c.ClientID, err)
+		}
+		if registered {
+			tok.Bridges = append(tok.Bridges, manifest.Bridge{
+				ClientID:            c.ClientID,
+				CounterpartyIFT:     counterparty,
+				SendCallConstructor: constructor,
+			})
+		}
+	}
+	return tok, nil
+}
+
+// Drift is one field on which a recorded deployment disagrees with the chain
+// or the config. An empty side has no value for the field.
+type Drift struct {
+	Field    string `json:"field"`
+	Manifest string `json:"manifest"`
+	Chain    string `json:"chain"`
+	Config   string `json:"config,omitempty"`
+}
+
+// Diff compares recorded with live, the manifest Import rebuilt from chain
+// state, field by field. Fields chain state cannot tell are not compared.
+func Diff(recorded, live *manifest.Manifest) []Drift {
+	var drifts []Drift
+	compare := func(field, recordedValue, liveValue string) {
+		if !strings.EqualFold(recordedValue, liveValue) {
+			drifts = append(drifts, Drift{Field: field, Manifest: recordedValue, Chain: liveValue})
+		}
+	}
+
+	compare("core.router", recorded.Core.Router, live.Core.Router)
+	keys := slices.Sorted(maps.Keys(live.TargetData))
+	for _, key := range keys {
+		compare("targetData."+key, recorded.TargetData[key], live.TargetData[key])
+	}
+
+	ids := clientIDs(recorded, live)
+	for _, id := range ids {
+		field := fmt.Sprintf("clients[%s]", id)
+		rc, inRecorded := recorded.Client(id)
+		lc, inLive := live.Client(id)
+		if !inRecorded || !inLive {
+			compare(field, Presence(inRecorded, "recorded"), Presence(inLive, "registered"))
+			continue
+		}
+		compare(field+".address", rc.Address, lc.Address)
+		compare(field+".counterpartyClientId", rc.CounterpartyClientID, lc.CounterpartyClientID)
+		compare(field+".type", rc.Type, lc.Type)
+		if lc.Type != ClientTypeAttestation || rc.Type != ClientTypeAttestation {
+			continue
+		}
+		recordedAttestors, liveAttestors := ParamStrings(rc.Params["attestors"]), ParamStrings(lc.Params["attestors"])
+		if !SameAddresses(recordedAttestors, liveAttestors) {
+			drifts = append(drifts, Drift{
+				Field:    field + ".params.attestors",
+				Manifest: strings.Join(recordedAttestors, ","),
+				Chain:    strings.Join(liveAttestors, ","),
+			})
+		}
+		compare(field+".params.threshold", fmt.Sprint(rc.Params["threshold"]), fmt.Sprint(lc.Params["threshold"]))
+	}
+
+	compare("gmp.address", gmpAddress(recorded), gmpAddress(live))
+	compare("transfer.address", transferAddress(recorded), transferAddress(live))
+
+	for _, rt := range recorded.Tokens {
+		field := fmt.Sprintf("tokens[%s]", rt.Address)
+		lt, ok := live.TokenByAddress(rt.Address)
+		if !ok {
+			compare(field, "recorded", "")
+			continue
+		}
+		compare(field+".owner", rt.Owner, lt.Owner)
+		for _, id := range bridgeIDs(rt, lt) {
+			bfield := fmt.Sprintf("%s.bridges[%s]", field, id)
+			rb, inRecorded := rt.Bridge(id)
+			lb, inLive := lt.Bridge(id)
+			if !inRecorded || !inLive {
+				compare(bfield, Presence(inRecorded, "recorded"), Presence(inLive, "registered"))
+				continue
+			}
+			compare(bfield+".counterpartyIft", rb.CounterpartyIFT, lb.CounterpartyIFT)
+			compare(bfield+".sendCallConstructor", rb.SendCallConstructor, lb.SendCallConstructor)
+		}
+	}
+	return drifts
+}
+
+// Presence is value if present and empty otherwise, for drift entries
+// reporting which side has a field.
+func Presence(present bool, value string) string {
+	if present {
+		return value
+	}
+	return ""
+}
+
+// clientIDs lists the ids of the clients in either manifest, sorted.
+func clientIDs(a, b *manifest.Manifest) []string {
+	seen := map[string]struct{}{}
+	for _, m := range []*manifest.Manifest{a, b} {
+		for _, c := range m.Clients {
+			seen[c.ClientID] = struct{}{}
+		}
+	}
+	return slices.Sorted(maps.Keys(seen))
+}
+
+// bridgeIDs lists the client ids of the bridges of either token, sorted.
+func bridgeIDs(a, b manifest.Token) []string {
+	seen := map[string]struct{}{}
+	for _, t := range []manifest.Token{a, b} {
+		for _, br := range t.Bridges {
+			seen[br.ClientID] = struct{}{}
+		}
+	}
+	return slices.Sorted(maps.Keys(seen))
+}
+
+// ParamStrings reads a string list param in its native ([]string) or file
+// round-tripped ([]any) form.
+func ParamStrings(v any) []string {
+	switch list := v.(type) {
+	case []string:
+		return list
+	case []any:
+		out := make([]string, 0, len(list))
+		for _, item := range list {
+			if s, ok := item.(string); ok {
+				out = append(out, s)
+			}
+		}
+		return out
+	default:
+		return nil
+	}
+}
+
+func gmpAddress(m *manifest.Manifest) string {
+	if m.GMP == nil {
+		return ""
+	}
+	return m.GMP.Address
+}
+
+func transferAddress(m *manifest.Manifest) string {
+	if m.Transfer == nil {
+		return ""
+	}
+	return m.Transfer.Address
+}
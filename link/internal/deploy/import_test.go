@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc/link/internal/deploy/manifest"
+)
+
+// newImportTarget is a chain with a router, an attestation client, a client
+// of an unknown type, the GMP app and an IFT token bridged over link-2.
+func newImportTarget() *fakeTarget {
+	target := newFakeTarget()
+	target.registered["link-2"] = "0xclient"
+	target.sets["link-2"] = AttestationState{Attestors: []string{"0xa", "0xb"}, Threshold: 2}
+	target.registered["other-9"] = "0xother"
+	target.apps[GMPPortID] = "0xgmp"
+	target.apps["custom"] = "0xcustom"
+	target.tokens["0xift-FOO"] = IFTSpec{Owner: "0xowner", Name: "Foo", Symbol: "FOO"}
+	target.bridges["0xift-FOO|link-2"] = fakeBridge{cp: "0xcp", ctor: "0xctor"}
+	return target
+}
+
+func TestImport(t *testing.T) {
+	target := newImportTarget()
+	opts := ImportOptions{
+		ChainID:            "1",
+		Target:             "evm",
+		Router:             "0xrouter",
+		Tokens:             []string{"0xift-FOO", "0xift-FOO"},
+		CounterpartyChains: map[string]string{"link-2": "2"},
+	}
+
+	m, err := Import(context.Background(), target, opts)
+	require.NoError(t, err)
+	require.Equal(t, "1", m.ChainID)
+	require.Equal(t, "0xrouter", m.Core.Router)
+	require.Equal(t, "0xam", m.TargetData["accessManager"])
+
+	c, ok := m.Client("link-2")
+	require.True(t, ok)
+	require.Equal(t, "0xclient", c.Address)
+	require.Equal(t, "2", c.CounterpartyChainID)
+	require.Equal(t, "cp-link-2", c.CounterpartyClientID)
+	require.Equal(t, ClientTypeAttestation, c.Type)
+	require.Equal(t, []string{"0xa", "0xb"}, c.Params["attestors"])
+	other, ok := m.Client("other-9")
+	require.True(t, ok)
+	require.Empty(t, other.Type)
+	require.Empty(t, other.CounterpartyChainID)
+
+	require.Equal(t, &manifest.GMP{Address: "0xgmp", Port: GMPPortID}, m.GMP)
+	require.Nil(t, m.Transfer)
+	require.Len(t, m.Tokens, 1)
+	tok := m.Tokens[0]
+	require.Equal(t, "FOO", tok.Symbol)
+	require.Equal(t, "0xowner", tok.Owner)
+	require.Equal(t, []manifest.Bridge{{ClientID: "link-2", CounterpartyIFT: "0xcp", SendCallConstructor: "0xctor"}},
+		tok.Bridges)
+
+	// a previous manifest contributes what the chain cannot tell, as long as
+	// it describes the same contracts
+	prev := manifest.New("1", "evm")
+	prev.UpsertClient(manifest.Client{
+		ClientID: "link-2", Address: "0xclient", CounterpartyChainID: "2",
+		Params: map[string]any{"initialHeight": 5.0},
+	})
+	prev.UpsertClient(manifest.Client{
+		ClientID: "other-9", Address: "0xreplaced", CounterpartyChainID: "9",
+	})
+	prev.GMP = &manifest.GMP{Address: "0xgmp", AccountLogic: "0xlogic", Port: GMPPortID}
+	prev.Roles = &manifest.Roles{Admin: "0xsafe"}
+	prev.Core.Router = "0xRouter"
+	prev.TargetData = map[string]string{"accessManager": "0xold", "create2Salt": "0xsalt"}
+	opts.CounterpartyChains = nil
+	opts.Previous = prev
+
+	m, err = Import(context.Background(), target, opts)
+	require.NoError(t, err)
+	c, _ = m.Client("link-2")
+	require.Equal(t, "2", c.CounterpartyChainID)
+	require.Equal(t, 5.0, c.Params["initialHeight"])
+	other, _ = m.Client("other-9")
+	require.Empty(t, other.CounterpartyChainID)
+	require.Equal(t, "0xlogic", m.GMP.AccountLogic)
+	require.Equal(t, "0xsafe", m.Roles.Admin)
+	require.Equal(t, map[string]string{"accessManager": "0xam", "create2Salt": "0xsalt"}, m.TargetData)
+
+	_, err = Import(context.Background(), target, ImportOptions{Router: "0xrouter", Tokens: []string{"0xmissing"}})
+	require.ErrorContains(t, err, "ift token 0xmissing")
+}
+
+func TestDiff(t *testing.T) {
+	live, err := Import(context.Background(), newImportTarget(), ImportOptions{
+		ChainID: "1", Target: "evm", Router: "0xrouter", Tokens: []string{"0xift-FOO"},
+	})
+	require.NoError(t, err)
+
+	// the same deployment, as read back from its file
+	recorded := manifest.New("1", "evm")
+	recorded.Core.Router = "0xRouter"
+	recorded.TargetData = map[string]string{"accessManager": "0xam"}
+	recorded.UpsertClient(manifest.Client{
+		ClientID: "link-2", Type: ClientTypeAttestation, Address: "0xclient", CounterpartyClientID: "cp-link-2",
+		Params: map[string]any{"attestors": []any{"0xB", "0xa"}, "threshold": 2.0, "initialHeight": 5.0},
+	})
+	recorded.UpsertClient(manifest.Client{ClientID: "other-9", Address: "0xother", CounterpartyClientID: "cp-other-9"})
+	recorded.GMP = &manifest.GMP{Address: "0xgmp", Port: GMPPortID}
+	recorded.Tokens = []manifest.Token{{
+		Symbol: "FOO", Name: "Foo", Address: "0xift-FOO", Owner: "0xowner",
+		Bridges: []manifest.Bridge{{ClientID: "link-2", CounterpartyIFT: "0xcp", SendCallConstructor: "0xctor"}},
+	}}
+	require.Empty(t, Diff(recorded, live))
+
+	// a client registered by hand, a changed attestor set, a lost app and a
+	// bridge pointing elsewhere
+	live.UpsertClient(manifest.Client{ClientID: "manual-1", Address: "0xmanual"})
+	c, _ := live.Client("link-2")
+	c.Params["attestors"] = []string{"0xa", "0xc"}
+	live.UpsertClient(c)
+	live.GMP = nil
+	live.Tokens[0].Bridges[0].CounterpartyIFT = "0xelsewhere"
+
+	require.Equal(t, []Drift{
+		{Field: "clients[link-2].params.attestors", Manifest: "0xB,0xa", Chain: "0xa,0xc"},
+		{Field: "clients[manual-1]", Chain: "registered"},
+		{Field: "gmp.address", Manifest: "0xgmp"},
+		{Field: "tokens[0xift-FOO].bridges[link-2].counterpartyIft", Manifest: "0xcp", Chain: "0xelsewhere"},
+	}, Diff(recorded, live))
+}
@@ -6,22 +6,24 @@ package manifest
 
 import (
 	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
 )
 
-const schemaVersion = 1
-
 // Manifest is the deployment record for one chain.
 type Manifest struct {
-	SchemaVersion int      `json:"schemaVersion"`
-	ChainID       string   `json:"chainId"`
-	Target        string   `json:"target"`
-	Core          Core     `json:"core"`
-	Clients       []Client `json:"clients"`
-	GMP           *GMP     `json:"gmp,omitempty"`
-	Tokens        []Token  `json:"tokens,omitempty"`
+	SchemaVersion int       `json:"schemaVersion"`
+	ChainID       string    `json:"chainId"`
+	Target        string    `json:"target"`
+	Core          Core      `json:"core"`
+	Clients       []Client  `json:"clients"`
+	GMP           *GMP      `json:"gmp,omitempty"`
+	Transfer      *Transfer `json:"transfer,omitempty"`
+	Tokens        []Token   `json:"tokens,omitempty"`
+	Roles         *Roles    `json:"roles,omitempty"`
+	Proxies       []Proxy   `json:"proxies,omitempty"`
 	// EVMSendCallConstructor is the chain's reusable stateless EVM send-call
 	// constructor. A per-counterparty constructor is recorded for each Bridge.
 	EVMSendCallConstructor string            `json:"evmSendCallConstructor,omitempty"`
@@ -52,23 +54,31 @@ func Path(dir, chainID string) string {
 }
 
 // Load reads the manifest for chainID, or returns (nil, nil) if none exists.
+// A manifest of an older schema is migrated in memory; Save writes it back
+// in the current one. A newer schema than this binary supports is refused.
 func Load(dir, chainID string) (*Manifest, error) {
-	bz, err := os.ReadFile(Path(dir, chainID))
+	path := Path(dir, chainID)
+	bz, err := os.ReadFile(path)
 	if os.IsNotExist(err) {
 		return nil, nil
 	}
 	if err != nil {
 		return nil, err
 	}
-	var m Manifest
-	if err := json.Unmarshal(bz, &m); err != nil {
-		return nil, err
+	m, _, err := decode(bz, schemaVersion, migrations)
+	if err != nil {
+		return nil, fmt.Errorf("manifest %s: %w", path, err)
 	}
-	return &m, nil
+	return m, nil
 }
 
-// Save writes the manifest atomically.
+// Save writes the manifest atomically, in the current schema.
 func (m *Manifest) Save(dir string) error {
+	m.SchemaVersion = schemaVersion
+	return m.save(dir)
+}
+
+func (m *Manifest) save(dir string) error {
 	if err := os.MkdirAll(dir, 0o755); err != nil {
 		return err
 	}
@@ -100,6 +110,17 @@ func (m *Manifest) Client(clientID string) (Client, bool) {
 	return Client{}, false
 }
 
+// ClientTracking returns the client tracking client counterpartyClientID of
+// chain counterpartyChainID.
+func (m *Manifest) ClientTracking(counterpartyChainID, counterpartyClientID string) (Client, bool) {
+	for _, c := range m.Clients {
+		if c.CounterpartyChainID == counterpartyChainID && c.CounterpartyClientID == counterpartyClientID {
+			return c, true
+		}
+	}
+	return Client{}, false
+}
+
 // UpsertClient replaces the client with the same ID or appends it.
 func (m *Manifest) UpsertClient(c Client) {
 	for i := range m.Clients {
@@ -111,6 +132,62 @@ func (m *Manifest) UpsertClient(c Client) {
 	m.Clients = append(m.Clients, c)
 }
 
+// Roles is the hardened access control of the core stack. Nil while relaying
+// is open to anyone and the deployer is admin.
+type Roles struct {
+	// RelayerRole the role relaying is restricted to, granted to Relayers.
+	RelayerRole uint64   `json:"relayerRole,omitempty"`
+	Relayers    []string `json:"relayers,omitempty"`
+	// Admin the account holding the admin role, with AdminDelay seconds of
+	// execution delay, after FormerAdmin (the deployer) renounced it.
+	Admin       string `json:"admin,omitempty"`
+	AdminDelay  uint32 `json:"adminDelay,omitempty"`
+	FormerAdmin string `json:"formerAdmin,omitempty"`
+}
+
+// Proxy is an upgradeable contract whose implementation `ibc deploy upgrade`
+// manages, recorded from its first upgrade on.
+type Proxy struct {
+	Component      string         `json:"component"`
+	Address        string         `json:"address"`
+	Implementation Implementation `json:"implementation"`
+	// Previous the implementation replaced by the last upgrade, which a
+	// rollback restores.
+	Previous *Implementation `json:"previous,omitempty"`
+	// Pending an implementation deployed for an upgrade not yet completed;
+	// a rerun completes it.
+	Pending *Implementation `json:"pending,omitempty"`
+}
+
+// Implementation is a contract a Proxy delegates to. Version is the contracts
+// version it was deployed from, empty for the implementation deployed with
+// the proxy.
+type Implementation struct {
+	Address string `json:"address"`
+	Version string `json:"version,omitempty"`
+}
+
+// Proxy returns the recorded proxy at address (case-insensitive).
+func (m *Manifest) Proxy(address string) (Proxy, bool) {
+	for _, p := range m.Proxies {
+		if strings.EqualFold(p.Address, address) {
+			return p, true
+		}
+	}
+	return Proxy{}, false
+}
+
+// UpsertProxy replaces the proxy with the same address or appends it.
+func (m *Manifest) UpsertProxy(p Proxy) {
+	for i := range m.Proxies {
+		if strings.EqualFold(m.Proxies[i].Address, p.Address) {
+			m.Proxies[i] = p
+			return
+		}
+	}
+	m.Proxies = append(m.Proxies, p)
+}
+
 // GMP is the deployed ICS27-GMP app for a chain. Address is the proxy;
 // AccountLogic is the beacon logic impl; Port is the router port it registered
 // under (always ICS27's "gmpport").
@@ -120,6 +197,17 @@ type GMP struct {
 	Port         string `json:"port"`
 }
 
+// Transfer is the deployed ICS20 transfer app for a chain. Address is the
+// proxy; EscrowLogic and IBCERC20Logic are the beacon logic impls of the
+// escrows and IBC-minted tokens it creates; Port is the router port it
+// registered under (always ICS20's "transfer").
+type Transfer struct {
+	Address       string `json:"address"`
+	EscrowLogic   string `json:"escrowLogic"`
+	IBCERC20Logic string `json:"ibcErc20Logic"`
+	Port          string `json:"port"`
+}
+
 // Token is one deployed IFT token keyed by symbol+name+owner
 type Token struct {
 	Symbol  string   `json:"symbol"`
@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// schemaVersion is the manifest schema this binary reads and writes.
+// Changing the schema bumps it and registers the migration from the previous
+// version, so every manifest written since schema 1 still loads.
+const schemaVersion = 1
+
+// migration rewrites a manifest document from one schema version to the
+// next. Documents are decoded with json.Number, so integers keep their
+// precision.
+type migration func(doc map[string]any) error
+
+// migrations are keyed by the schema version they migrate from.
+var migrations = map[int]migration{}
+
+// decode reads a manifest document of schema current or older, applying
+// migrations to older ones. It returns the version the document had.
+func decode(bz []byte, current int, migrations map[int]migration) (*Manifest, int, error) {
+	var doc map[string]any
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.UseNumber()
+	if err := dec.Decode(&doc); err != nil {
+		return nil, 0, err
+	}
+	version, err := docVersion(doc)
+	if err != nil {
+		return nil, 0, err
+	}
+	if version > current {
+		return nil, version, fmt.Errorf("schema version %d is newer than the %d this binary supports: upgrade ibc",
+			version, current)
+	}
+	if version < current {
+		for v := version; v < current; v++ {
+			migrate, ok := migrations[v]
+			if !ok {
+				return nil, version, fmt.Errorf("no migration from schema version %d", v)
+			}
+			if err := migrate(doc); err != nil {
+				return nil, version, fmt.Errorf("migrate schema version %d to %d: %w", v, v+1, err)
+			}
+		}
+		doc["schemaVersion"] = current
+		if bz, err = json.Marshal(doc); err != nil {
+			return nil, version, err
+		}
+	}
+	var m Manifest
+	if err := json.Unmarshal(bz, &m); err != nil {
+		return nil, version, err
+	}
+	return &m, version, nil
+}
+
+// docVersion reads a manifest document's schema version.
+func docVersion(doc map[string]any) (int, error) {
+	n, ok := doc["schemaVersion"].(json.Number)
+	if !ok {
+		return 0, fmt.Errorf("no schemaVersion")
+	}
+	version, err := n.Int64()
+	if err != nil || version < 1 {
+		return 0, fmt.Errorf("invalid schemaVersion %s", n)
+	}
+	return int(version), nil
+}
+
+// Migration is the outcome of migrating one manifest file.
+type Migration struct {
+	ChainID string `json:"chainId"`
+	From    int    `json:"from"`
+	To      int    `json:"to"`
+	// Backup is the copy of the file as it was, empty when the manifest was
+	// already current or the migration only planned.
+	Backup string `json:"backup,omitempty"`
+}
+
+// Migrate rewrites the manifest for chainID in the current schema, keeping
+// the original as <path>.v<version>.bak. A current manifest is left as is.
+// With dryRun it only reports the versions.
+func Migrate(dir, chainID string, dryRun bool) (Migration, error) {
+	return migrateFile(dir, chainID, dryRun, schemaVersion, migrations)
+}
+
+func migrateFile(dir, chainID string, dryRun bool, current int, migrations map[int]migration) (Migration, error) {
+	path := Path(dir, chainID)
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return Migration{}, err
+	}
+	m, version, err := decode(bz, current, migrations)
+	if err != nil {
+		return Migration{}, fmt.Errorf("manifest %s: %w", path, err)
+	}
+	if m.ChainID != chainID {
+		// saving would write another chain's manifest and leave this one as is
+		return Migration{}, fmt.Errorf("manifest %s records chain %q", path, m.ChainID)
+	}
+	out := Migration{ChainID: chainID, From: version, To: current}
+	if version == current || dryRun {
+		return out, nil
+	}
+	out.Backup = fmt.Sprintf("%s.v%d.bak", path, version)
+	if err := writeBackup(out.Backup, bz); err != nil {
+		return Migration{}, fmt.Errorf("back up manifest: %w", err)
+	}
+	if err := m.save(dir); err != nil {
+		return Migration{}, err
+	}
+	return out, nil
+}
+
+// writeBackup writes bz to a new file at path. An existing backup may be
+// the only copy of an original, so it is never overwritten.
+func writeBackup(path string, bz []byte) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if errors.Is(err, os.ErrExist) {
+		return fmt.Errorf("%s already exists: move it aside to migrate again", path)
+	}
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(bz); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
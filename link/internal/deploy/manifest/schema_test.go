@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"math"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testMigrations stand in for a schema history: version 1 kept the router at
+// the top level, version 2 had no target.
+var testMigrations = map[int]migration{
+	1: func(doc map[string]any) error {
+		doc["core"] = map[string]any{"router": doc["router"]}
+		delete(doc, "router")
+		return nil
+	},
+	2: func(doc map[string]any) error {
+		doc["target"] = "evm"
+		return nil
+	},
+}
+
+const v1Manifest = `{
+  "schemaVersion": 1,
+  "chainId": "1",
+  "router": "0xabc",
+  "roles": {"relayerRole": 18446744073709551615}
+}`
+
+func TestLoadSchemaVersions(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(Path(dir, "1"), []byte(`{"schemaVersion": 2, "chainId": "1"}`), 0o644))
+	_, err := Load(dir, "1")
+	require.ErrorContains(t, err, "schema version 2 is newer than the 1 this binary supports")
+
+	require.NoError(t, os.WriteFile(Path(dir, "1"), []byte(`{"chainId": "1"}`), 0o644))
+	_, err = Load(dir, "1")
+	require.ErrorContains(t, err, "no schemaVersion")
+
+	m, version, err := decode([]byte(v1Manifest), 3, testMigrations)
+	require.NoError(t, err)
+	require.Equal(t, 1, version)
+	require.Equal(t, 3, m.SchemaVersion)
+	require.Equal(t, "0xabc", m.Core.Router)
+	require.Equal(t, "evm", m.Target)
+	require.Equal(t, uint64(math.MaxUint64), m.Roles.RelayerRole)
+
+	_, _, err = decode([]byte(v1Manifest), 3, map[int]migration{1: testMigrations[1]})
+	require.ErrorContains(t, err, "no migration from schema version 2")
+}
+
+func TestMigrateFile(t *testing.T) {
+	dir := t.TempDir()
+	path := Path(dir, "1")
+	require.NoError(t, os.WriteFile(path, []byte(v1Manifest), 0o644))
+
+	// a dry run reports without writing
+	got, err := migrateFile(dir, "1", true, 3, testMigrations)
+	require.NoError(t, err)
+	require.Equal(t, Migration{ChainID: "1", From: 1, To: 3}, got)
+	_, err = os.Stat(path + ".v1.bak")
+	require.True(t, os.IsNotExist(err))
+
+	got, err = migrateFile(dir, "1", false, 3, testMigrations)
+	require.NoError(t, err)
+	require.Equal(t, Migration{ChainID: "1", From: 1, To: 3, Backup: path + ".v1.bak"}, got)
+	backup, err := os.ReadFile(path + ".v1.bak")
+	require.NoError(t, err)
+	require.Equal(t, v1Manifest, string(backup))
+
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	m, version, err := decode(bz, 3, nil)
+	require.NoError(t, err)
+	require.Equal(t, 3, version)
+	require.Equal(t, "0xabc", m.Core.Router)
+
+	// a current manifest is left as is
+	got, err = migrateFile(dir, "1", false, 3, testMigrations)
+	require.NoError(t, err)
+	require.Equal(t, Migration{ChainID: "1", From: 3, To: 3}, got)
+
+	// a second migration from the same version keeps the first backup
+	require.NoError(t, os.WriteFile(path, []byte(v1Manifest), 0o644))
+	_, err = migrateFile(dir, "1", false, 3, testMigrations)
+	require.ErrorContains(t, err, "v1.bak already exists")
+	backup, err = os.ReadFile(path + ".v1.bak")
+	require.NoError(t, err)
+	require.Equal(t, v1Manifest, string(backup))
+
+	// a manifest recording another chain is not migrated into that chain's file
+	require.NoError(t, os.WriteFile(Path(dir, "2"), []byte(v1Manifest), 0o644))
+	_, err = migrateFile(dir, "2", false, 3, testMigrations)
+	require.ErrorContains(t, err, `records chain "1"`)
+	_, err = os.Stat(Path(dir, "2") + ".v1.bak")
+	require.True(t, os.IsNotExist(err))
+	bz, err = os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, v1Manifest, string(bz))
+}
@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/ibc/link/internal/deploy/manifest"
+)
+
+// predictFunc is a Step's Predict.
+type predictFunc = func(ctx context.Context) (map[string]string, error)
+
+// predictor returns t as a Predictor when it deploys deterministically.
+func predictor(t Target) (Predictor, bool) {
+	p, ok := t.(Predictor)
+	return p, ok && p.Deterministic()
+}
+
+// predictCore predicts CoreSteps' deployment, or is nil when t cannot.
+func predictCore(t Target) predictFunc {
+	p, ok := predictor(t)
+	if !ok {
+		return nil
+	}
+	return func(context.Context) (map[string]string, error) {
+		ref, err := p.PredictCore()
+		if err != nil {
+			return nil, err
+		}
+		out := map[string]string{"core.router": ref.Router}
+		for key, value := range ref.TargetData {
+			out["targetData."+key] = value
+		}
+		return out, nil
+	}
+}
+
+// predictGMP predicts GMPSteps' deployment, or is nil when t cannot.
+func predictGMP(t Target, dir, chainID string) predictFunc {
+	p, ok := predictor(t)
+	if !ok {
+		return nil
+	}
+	return func(context.Context) (map[string]string, error) {
+		router, am, err := predictedCore(p, dir, chainID)
+		if err != nil {
+			return nil, err
+		}
+		ref, err := p.PredictGMP(router, am)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]string{"gmp.address": ref.Address, "gmp.accountLogic": ref.AccountLogic}, nil
+	}
+}
+
+// predictTransfer predicts TransferSteps' deployment, or is nil when t
+// cannot.
+func predictTransfer(t Target, dir, chainID string) predictFunc {
+	p, ok := predictor(t)
+	if !ok {
+		return nil
+	}
+	return func(context.Context) (map[string]string, error) {
+		router, am, err := predictedCore(p, dir, chainID)
+		if err != nil {
+			return nil, err
+		}
+		ref, err := p.PredictTransfer(router, am)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]string{
+			"transfer.address":       ref.Address,
+			"transfer.escrowLogic":   ref.EscrowLogic,
+			"transfer.ibcErc20Logic": ref.IBCERC20Logic,
+		}, nil
+	}
+}
+
+// predictIFT predicts IFTSteps' deployment, or is nil when t cannot.
+func predictIFT(t Target, dir, chainID string, spec IFTSpec) predictFunc {
+	p, ok := predictor(t)
+	if !ok {
+		return nil
+	}
+	return func(context.Context) (map[string]string, error) {
+		m, err := manifest.Load(dir, chainID)
+		if err != nil {
+			return nil, err
+		}
+		gmp := ""
+		if m != nil && m.GMP != nil {
+			gmp = m.GMP.Address
+		}
+		if gmp == "" {
+			router, am, err := predictedCore(p, dir, chainID)
+			if err != nil {
+				return nil, err
+			}
+			ref, err := p.PredictGMP(router, am)
+			if err != nil {
+				return nil, err
+			}
+			gmp = ref.Address
+		}
+		ref, err := p.PredictIFT(gmp, spec)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]string{fmt.Sprintf("tokens[%s].address", spec.Symbol): ref.Address}, nil
+	}
+}
+
+// predictedCore is chainID's recorded router and AccessManager or, while
+// none is recorded, those p predicts the core step deploys: a dry run of a
+// whole plan predicts every step.
+func predictedCore(p Predictor, dir, chainID string) (router, accessManager string, err error) {
+	m, err := manifest.Load(dir, chainID)
+	if err != nil {
+		return "", "", err
+	}
+	if m != nil && m.Core.Router != "" {
+		return m.Core.Router, m.TargetData["accessManager"], nil
+	}
+	ref, err := p.PredictCore()
+	if err != nil {
+		return "", "", err
+	}
+	return ref.Router, ref.TargetData["accessManager"], nil
+}
@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// predictingTarget predicts addresses derived from its inputs, so tests can
+// tell which router and GMP app a prediction was based on.
+type predictingTarget struct {
+	*fakeTarget
+	deterministic bool
+}
+
+func (p *predictingTarget) Deterministic() bool { return p.deterministic }
+
+func (p *predictingTarget) PredictCore() (CoreRef, error) {
+	return CoreRef{Router: "0xp-router", TargetData: map[string]string{"accessManager": "0xp-am"}}, nil
+}
+
+func (p *predictingTarget) PredictGMP(router, accessManager string) (GMPRef, error) {
+	return GMPRef{Address: "0xp-gmp@" + router + "/" + accessManager, AccountLogic: "0xp-logic"}, nil
+}
+
+func (p *predictingTarget) PredictTransfer(router, _ string) (TransferRef, error) {
+	return TransferRef{Address: "0xp-transfer@" + router, EscrowLogic: "0xp-escrow", IBCERC20Logic: "0xp-erc20"}, nil
+}
+
+func (p *predictingTarget) PredictIFT(gmp string, spec IFTSpec) (IFTRef, error) {
+	return IFTRef{Address: "0xp-ift-" + spec.Symbol + "@" + gmp}, nil
+}
+
+func TestDryRunPredictsAddresses(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	target := &predictingTarget{fakeTarget: newFakeTarget(), deterministic: true}
+	spec := IFTSpec{Owner: "0xowner", Name: "Foo", Symbol: "FOO"}
+	plan := func() []Step {
+		return slices.Concat(
+			CoreSteps(target, dir, "1"),
+			GMPSteps(target, dir, "1"),
+			TransferSteps(target, dir, "1"),
+			IFTSteps(target, dir, "1", spec),
+		)
+	}
+
+	// nothing recorded: later steps build on the predicted core stack
+	res, err := RunSteps(ctx, slog.Default(), true, plan())
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"core.router": "0xp-router", "targetData.accessManager": "0xp-am"},
+		res[0].Addresses)
+	require.Equal(t, map[string]string{"gmp.address": "0xp-gmp@0xp-router/0xp-am", "gmp.accountLogic": "0xp-logic"},
+		res[1].Addresses)
+	require.Equal(t, "0xp-transfer@0xp-router", res[2].Addresses["transfer.address"])
+	require.Equal(t, map[string]string{"tokens[FOO].address": "0xp-ift-FOO@0xp-gmp@0xp-router/0xp-am"},
+		res[3].Addresses)
+
+	// a recorded core stack is built on as it is
+	_, err = RunSteps(ctx, slog.Default(), false, CoreSteps(target, dir, "1"))
+	require.NoError(t, err)
+	target.hasCode["0xrouter"] = true
+	res, err = RunSteps(ctx, slog.Default(), true, plan())
+	require.NoError(t, err)
+	require.Equal(t, ActionSkipped, res[0].Action)
+	require.Empty(t, res[0].Addresses)
+	require.Equal(t, "0xp-gmp@0xrouter/0xam", res[1].Addresses["gmp.address"])
+	require.Equal(t, "0xp-ift-FOO@0xp-gmp@0xrouter/0xam", res[3].Addresses["tokens[FOO].address"])
+
+	// a target outside its deterministic mode predicts nothing
+	target.deterministic = false
+	res, err = RunSteps(ctx, slog.Default(), true, plan())
+	require.NoError(t, err)
+	for _, r := range res {
+		require.Nil(t, r.Addresses, r.Name)
+	}
+}
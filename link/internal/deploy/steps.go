@@ -4,7 +4,10 @@ package deploy
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"slices"
@@ -14,11 +17,13 @@ import (
 )
 
 // Step is one idempotent deployment step. A nil Done means never
-// pre-satisfied.
+// pre-satisfied. Predict, when set, reports the addresses Run will deploy,
+// keyed by manifest field, for dry runs to show.
 type Step struct {
-	Name string
-	Done func(ctx context.Context) (bool, error)
-	Run  func(ctx context.Context) error
+	Name    string
+	Done    func(ctx context.Context) (bool, error)
+	Run     func(ctx context.Context) error
+	Predict func(ctx context.Context) (map[string]string, error)
 }
 
 // StepResult actions.
@@ -30,8 +35,9 @@ const (
 
 // StepResult records what happened to one step.
 type StepResult struct {
-	Name   string `json:"name"`
-	Action string `json:"action"`
+	Name      string            `json:"name"`
+	Action    string            `json:"action"`
+	Addresses map[string]string `json:"addresses,omitempty"`
 }
 
 // RunSteps executes steps in order, skipping satisfied ones. With dryRun it
@@ -52,11 +58,19 @@ func RunSteps(ctx context.Context, log *slog.Logger, dryRun bool, steps []Step)
 		}
 		if dryRun {
 			log.Info("would execute", "step", step.Name)
-			results = append(results, StepResult{Name: step.Name, Action: ActionPlanned})
+			result := StepResult{Name: step.Name, Action: ActionPlanned}
+			if step.Predict != nil {
+				addresses, err := step.Predict(ctx)
+				if err != nil {
+					return results, fmt.Errorf("step %q predict addresses: %w", step.Name, err)
+				}
+				result.Addresses = addresses
+			}
+			results = append(results, result)
 			continue
 		}
 		log.Info("executing", "step", step.Name)
-		if err := step.Run(ctx); err != nil {
+		if err := step.Run(withStep(ctx, step.Name)); err != nil {
 			return results, fmt.Errorf("step %q: %w", step.Name, err)
 		}
 		results = append(results, StepResult{Name: step.Name, Action: ActionExecuted})
@@ -86,32 +100,51 @@ func CoreSteps(t Target, dir, chainID string) []Step {
 				return err
 			}
 			if m == nil {
-				// single-target simplification: thread the target name
-				// through CoreSteps when a second target type exists
-				m = manifest.New(chainID, "evm")
+				m = manifest.New(chainID, t.Name())
 			}
 			m.Core.Router = ref.Router
 			m.TargetData = ref.TargetData
 			return m.Save(dir)
 		},
+		Predict: predictCore(t),
 	}}
 }
 
 // ClientSteps provisions and registers one light client on chainID's router
-// and records it in the manifest. Requires the core step to have run.
+// and records it in the manifest. Requires the core step to have run. On a
+// ClientIDAssigner target the client is recorded under the ID its chain
+// assigns, spec.ClientID unused.
 func ClientSteps(t Target, dir, chainID string, spec ClientSpec) []Step {
+	assigned := AssignsClientIDs(t)
+	name := fmt.Sprintf("client %s on chain %s tracking chain %s", spec.ClientID, chainID, spec.CounterpartyChainID)
+	// a new pair is deployed under a new client id, of this chain's client
+	// or, where the chain assigns that one, of the counterparty's
+	newPairFlag := "--client-id"
+	if assigned {
+		name = fmt.Sprintf("client on chain %s tracking client %s of chain %s",
+			chainID, spec.CounterpartyClientID, spec.CounterpartyChainID)
+		newPairFlag = "--counterparty-client-id"
+	}
 	return []Step{{
-		Name: fmt.Sprintf("client %s on chain %s tracking chain %s", spec.ClientID, chainID, spec.CounterpartyChainID),
+		Name: name,
 		Done: func(ctx context.Context) (bool, error) {
 			m, err := manifest.Load(dir, chainID)
 			if err != nil || m == nil || m.Core.Router == "" {
 				return false, err
 			}
-			address, registered, err := t.ClientRegistered(ctx, m.Core.Router, spec.ClientID)
+			clientID := spec.ClientID
+			if assigned {
+				recorded, ok := m.ClientTracking(spec.CounterpartyChainID, spec.CounterpartyClientID)
+				if !ok {
+					return false, nil
+				}
+				clientID = recorded.ClientID
+			}
+			address, registered, err := t.ClientRegistered(ctx, m.Core.Router, clientID)
 			if err != nil || !registered {
 				return false, err
 			}
-			existing, ok := m.Client(spec.ClientID)
+			existing, ok := m.Client(clientID)
 			if !ok {
 				// a deployment that died between RegisterClient and Save: the
 				// client exists on-chain but its constructor parameters were
@@ -132,12 +165,12 @@ func ClientSteps(t Target, dir, chainID string, spec ClientSpec) []Step {
 			if diffs := clientConflicts(existing, spec); len(diffs) > 0 {
 				return false, fmt.Errorf(
 					"client %q on chain %s is already deployed with different values (%s); "+
-						"pass a new --client-id to deploy another client pair",
-					spec.ClientID, chainID, strings.Join(diffs, "; "),
+						"pass a new %s to deploy another client pair",
+					clientID, chainID, strings.Join(diffs, "; "), newPairFlag,
 				)
 			}
 			slog.Info("client already registered, continuing",
-				"client", spec.ClientID, "chain", chainID, "address", address)
+				"client", clientID, "chain", chainID, "address", address)
 			if existing.Address != address {
 				existing.Address = address
 				m.UpsertClient(existing)
@@ -162,26 +195,651 @@ func ClientSteps(t Target, dir, chainID string, spec ClientSpec) []Step {
 			if m == nil || m.Core.Router == "" {
 				return fmt.Errorf("no core deployment recorded for chain %s: run `ibc deploy core` first", chainID)
 			}
-			ref, err := t.ProvisionClient(ctx, m.Core.Router, spec)
+			var ref ClientRef
+			if assigned {
+				ref, err = assignedClient(ctx, t, dir, chainID, m, spec)
+			} else {
+				ref, err = t.ProvisionClient(ctx, m.Core.Router, spec)
+			}
 			if err != nil {
 				return err
 			}
-			if _, regErr := t.RegisterClient(ctx, m.Core.Router, spec, ref); regErr != nil {
-				return regErr
+			clientID, err := t.RegisterClient(ctx, m.Core.Router, spec, ref)
+			if err != nil {
+				return err
 			}
 			client, err := specToClient(spec, ref.Address)
 			if err != nil {
 				return err
 			}
+			client.ClientID = clientID
 			m.UpsertClient(client)
 			return m.Save(dir)
 		},
 	}}
 }
 
+// AttestationSetSteps replaces the attestor set and threshold of the
+// attestation client clientID on chainID and records them in the manifest.
+// Attestation clients fix their set at construction, so the change goes
+// through the router's admin path: a substitute client with the new set is
+// provisioned from the live client's latest trusted state and clientID is
+// migrated onto it. The substitute ID derives from the requested set, so a
+// rerun after an interrupted migration reuses the substitute it registered.
+func AttestationSetSteps(t Target, dir, chainID, clientID string, attestors []string, threshold uint8) []Step {
+	return []Step{{
+		Name: fmt.Sprintf("attestation set of client %s on chain %s", clientID, chainID),
+		Done: func(ctx context.Context) (bool, error) {
+			m, client, err := attestationClient(dir, chainID, clientID)
+			if err != nil {
+				return false, err
+			}
+			state, err := t.AttestationState(ctx, m.Core.Router, clientID)
+			if err != nil {
+				return false, err
+			}
+			if state.Threshold != threshold || !SameAddresses(state.Attestors, attestors) {
+				return false, nil
+			}
+			// an earlier run migrated the client but died before saving
+			address, _, err := t.ClientRegistered(ctx, m.Core.Router, clientID)
+			if err != nil {
+				return false, err
+			}
+			if strings.EqualFold(client.Address, address) && !clientConflictsSet(client, attestors, threshold) {
+				return true, nil
+			}
+			client.Address = address
+			client.Params["attestors"] = attestors
+			client.Params["threshold"] = threshold
+			m.UpsertClient(client)
+			return true, m.Save(dir)
+		},
+		Run: func(ctx context.Context) error {
+			if threshold == 0 || int(threshold) > len(attestors) {
+				return fmt.Errorf("threshold %d invalid for %d attestors", threshold, len(attestors))
+			}
+			if AssignsClientIDs(t) {
+				// the substitute must be found again under its derived ID
+				return fmt.Errorf("%w: chain %s assigns client ids, so client %s cannot be migrated "+
+					"to a substitute", errors.ErrUnsupported, chainID, clientID)
+			}
+			migrator, err := Require[ClientMigrator](t, "client migration")
+			if err != nil {
+				return err
+			}
+			m, client, err := attestationClient(dir, chainID, clientID)
+			if err != nil {
+				return err
+			}
+			substituteID := substituteClientID(clientID, attestors, threshold)
+			if !ValidClientID(substituteID) {
+				return fmt.Errorf("substitute client id %q for client %q is invalid", substituteID, clientID)
+			}
+			state, err := t.AttestationState(ctx, m.Core.Router, clientID)
+			if err != nil {
+				return err
+			}
+			spec := ClientSpec{
+				ClientID:             substituteID,
+				Type:                 ClientTypeAttestation,
+				CounterpartyChainID:  client.CounterpartyChainID,
+				CounterpartyClientID: client.CounterpartyClientID,
+				Params: AttestationParams{
+					Attestors:        attestors,
+					Threshold:        threshold,
+					InitialHeight:    state.LatestHeight,
+					InitialTimestamp: state.LatestTimestamp,
+				},
+			}
+			_, registered, err := t.ClientRegistered(ctx, m.Core.Router, substituteID)
+			if err != nil {
+				return err
+			}
+			if !registered {
+				ref, provErr := t.ProvisionClient(ctx, m.Core.Router, spec)
+				if provErr != nil {
+					return provErr
+				}
+				if _, regErr := t.RegisterClient(ctx, m.Core.Router, spec, ref); regErr != nil {
+					return regErr
+				}
+			}
+			if err := migrator.MigrateClient(ctx, m.Core.Router, clientID, substituteID); err != nil {
+				return err
+			}
+			address, _, err := t.ClientRegistered(ctx, m.Core.Router, clientID)
+			if err != nil {
+				return err
+			}
+			spec.ClientID = clientID
+			migrated, err := specToClient(spec, address)
+			if err != nil {
+				return err
+			}
+			slog.Info("client migrated to new attestation set",
+				"client", clientID, "chain", chainID, "substitute", substituteID, "address", address)
+			m.UpsertClient(migrated)
+			return m.Save(dir)
+		},
+	}}
+}
+
+// attestationClient loads chainID's manifest and its record of the
+// attestation client clientID.
+func attestationClient(dir, chainID, clientID string) (*manifest.Manifest, manifest.Client, error) {
+	m, err := manifest.Load(dir, chainID)
+	if err != nil {
+		return nil, manifest.Client{}, err
+	}
+	if m == nil || m.Core.Router == "" {
+		return nil, manifest.Client{}, fmt.Errorf(
+			"no core deployment recorded for chain %s: run `ibc deploy core` first", chainID,
+		)
+	}
+	client, ok := m.Client(clientID)
+	if !ok {
+		return nil, manifest.Client{}, fmt.Errorf(
+			"client %q not recorded in the manifest for chain %s", clientID, chainID,
+		)
+	}
+	if client.Type != ClientTypeAttestation {
+		return nil, manifest.Client{}, fmt.Errorf(
+			"client %q on chain %s is a %q client, not %q", clientID, chainID, client.Type, ClientTypeAttestation,
+		)
+	}
+	if client.Params == nil {
+		client.Params = map[string]any{}
+	}
+	return m, client, nil
+}
+
+// SameAddresses reports whether a and b hold the same addresses, ignoring
+// order and case.
+func SameAddresses(a, b []string) bool {
+	return slices.Equal(NormalizedAddresses(a), NormalizedAddresses(b))
+}
+
+// NormalizedAddresses sorts lowercased addresses, for comparing sets.
+func NormalizedAddresses(addresses []string) []string {
+	out := make([]string, len(addresses))
+	for i, a := range addresses {
+		out[i] = strings.ToLower(a)
+	}
+	slices.Sort(out)
+	return out
+}
+
+// assignedClient provisions the client a ClientIDAssigner target tracks
+// spec's counterparty with and records it under its assigned ID before it is
+// registered, so a run dying in between resumes with it. A recorded client
+// that still exists unregistered, as such a run left it, is reused instead.
+func assignedClient(
+	ctx context.Context,
+	t Target,
+	dir, chainID string,
+	m *manifest.Manifest,
+	spec ClientSpec,
+) (ClientRef, error) {
+	if recorded, ok := m.ClientTracking(spec.CounterpartyChainID, spec.CounterpartyClientID); ok {
+		exists, err := t.HasCode(ctx, recorded.Address)
+		if err != nil {
+			return ClientRef{}, err
+		}
+		if exists && len(clientConflicts(recorded, spec)) == 0 {
+			slog.Info("registering client provisioned by an earlier run",
+				"client", recorded.ClientID, "chain", chainID)
+			return ClientRef{Address: recorded.Address}, nil
+		}
+		slog.Warn("abandoning unregistered client recorded by an earlier run",
+			"client", recorded.ClientID, "chain", chainID, "exists", exists)
+	}
+	ref, err := t.ProvisionClient(ctx, m.Core.Router, spec)
+	if err != nil {
+		return ClientRef{}, err
+	}
+	client, err := specToClient(spec, ref.Address)
+	if err != nil {
+		return ClientRef{}, err
+	}
+	client.ClientID = ref.Address
+	m.Clients = slices.DeleteFunc(m.Clients, func(c manifest.Client) bool {
+		return c.CounterpartyChainID == spec.CounterpartyChainID &&
+			c.CounterpartyClientID == spec.CounterpartyClientID
+	})
+	m.UpsertClient(client)
+	slog.Info("chain assigned client id", "client", client.ClientID, "chain", chainID)
+	return ref, m.Save(dir)
+}
+
+// clientConflictsSet reports whether the recorded client disagrees with the
+// given attestor set or threshold.
+func clientConflictsSet(client manifest.Client, attestors []string, threshold uint8) bool {
+	return len(clientConflicts(client, ClientSpec{
+		ClientID:             client.ClientID,
+		Type:                 client.Type,
+		CounterpartyChainID:  client.CounterpartyChainID,
+		CounterpartyClientID: client.CounterpartyClientID,
+		Params:               AttestationParams{Attestors: attestors, Threshold: threshold},
+	})) > 0
+}
+
+// substituteClientID derives the ID a substitute client for clientID with
+// the given set registers under.
+func substituteClientID(clientID string, attestors []string, threshold uint8) string {
+	h := sha256.New()
+	for _, a := range NormalizedAddresses(attestors) {
+		h.Write([]byte(a))
+	}
+	h.Write([]byte{threshold})
+	return clientID + "-" + hex.EncodeToString(h.Sum(nil))[:8]
+}
+
+// RolesSteps hardens the access control of chainID's core stack and records
+// it in the manifest: relaying is restricted to spec.RelayerRole, granted to
+// spec.Relayers, then the admin role is handed to spec.Admin. Either part is
+// skipped when its spec fields are empty. The hand-off runs last: once the
+// deployer renounced admin, only spec.Admin can change roles.
+func RolesSteps(t RoleManager, dir, chainID string, spec RolesSpec) []Step {
+	var steps []Step
+	if len(spec.Relayers) > 0 {
+		steps = append(steps, relayerRoleStep(t, dir, chainID, spec))
+	}
+	if spec.Admin != "" {
+		steps = append(steps, adminHandOffStep(t, dir, chainID, spec))
+	}
+	return steps
+}
+
+func relayerRoleStep(t RoleManager, dir, chainID string, spec RolesSpec) Step {
+	return Step{
+		Name: fmt.Sprintf("relaying restricted to role %d on chain %s", spec.RelayerRole, chainID),
+		Done: func(ctx context.Context) (bool, error) {
+			m, am, err := rolesManifest(dir, chainID)
+			if err != nil || m == nil {
+				return false, err
+			}
+			roles, err := t.RelayingRoles(ctx, m.Core.Router, am)
+			if err != nil {
+				return false, err
+			}
+			for _, role := range roles {
+				if role != spec.RelayerRole {
+					return false, nil
+				}
+			}
+			for _, relayer := range spec.Relayers {
+				grant, roleErr := t.Role(ctx, am, spec.RelayerRole, relayer)
+				if roleErr != nil || !grant.Member {
+					return false, roleErr
+				}
+			}
+			for _, relayer := range revokedRelayers(m.Roles, spec) {
+				grant, roleErr := t.Role(ctx, am, spec.RelayerRole, relayer)
+				if roleErr != nil || grant.Member {
+					return false, roleErr
+				}
+			}
+			// an earlier run restricted relaying but died before saving
+			if r := m.Roles; r != nil && r.RelayerRole == spec.RelayerRole && SameAddresses(r.Relayers, spec.Relayers) {
+				return true, nil
+			}
+			return true, saveRelayers(dir, m, spec)
+		},
+		Run: func(ctx context.Context) error {
+			m, am, err := rolesManifest(dir, chainID)
+			if err != nil {
+				return err
+			}
+			if m == nil {
+				return fmt.Errorf("no core deployment recorded for chain %s: run `ibc deploy core` first", chainID)
+			}
+			if spec.RelayerRole == AdminRole || spec.RelayerRole == PublicRole {
+				return fmt.Errorf("relayer role %d is reserved", spec.RelayerRole)
+			}
+			if m.Roles != nil && m.Roles.FormerAdmin != "" {
+				return fmt.Errorf(
+					"admin of chain %s was handed to %s: relayer changes must be scheduled through it",
+					chainID, m.Roles.Admin,
+				)
+			}
+			if m.Roles != nil && len(m.Roles.Relayers) > 0 && m.Roles.RelayerRole != spec.RelayerRole {
+				return fmt.Errorf(
+					"relaying on chain %s is already restricted to role %d, requested %d",
+					chainID, m.Roles.RelayerRole, spec.RelayerRole,
+				)
+			}
+			revoke := revokedRelayers(m.Roles, spec)
+			if err := t.RestrictRelaying(ctx, m.Core.Router, am, spec.RelayerRole, spec.Relayers, revoke); err != nil {
+				return err
+			}
+			slog.Info("relaying restricted", "chain", chainID, "role", spec.RelayerRole,
+				"relayers", spec.Relayers, "revoked", revoke)
+			return saveRelayers(dir, m, spec)
+		},
+	}
+}
+
+func adminHandOffStep(t RoleManager, dir, chainID string, spec RolesSpec) Step {
+	return Step{
+		Name: fmt.Sprintf("admin handed to %s on chain %s", spec.Admin, chainID),
+		Done: func(ctx context.Context) (bool, error) {
+			m, am, err := rolesManifest(dir, chainID)
+			if err != nil || m == nil {
+				return false, err
+			}
+			handedOff := m.Roles != nil && m.Roles.FormerAdmin != ""
+			if handedOff && !strings.EqualFold(m.Roles.Admin, spec.Admin) {
+				return false, fmt.Errorf(
+					"admin of chain %s was already handed to %s: further changes must be scheduled through it",
+					chainID, m.Roles.Admin,
+				)
+			}
+			grant, err := t.Role(ctx, am, AdminRole, spec.Admin)
+			if err != nil || !grant.Member {
+				return false, err
+			}
+			if grant.ExecutionDelay != spec.AdminDelay {
+				if handedOff {
+					return false, fmt.Errorf(
+						"admin %s on chain %s has a %ds execution delay, requested %ds: only the admin can change it",
+						spec.Admin, chainID, grant.ExecutionDelay, spec.AdminDelay,
+					)
+				}
+				return false, nil
+			}
+			if !handedOff {
+				// the deployer may not have renounced yet; Run finishes it
+				return false, nil
+			}
+			former, err := t.Role(ctx, am, AdminRole, m.Roles.FormerAdmin)
+			if err != nil || former.Member {
+				return false, err
+			}
+			return true, nil
+		},
+		Run: func(ctx context.Context) error {
+			m, am, err := rolesManifest(dir, chainID)
+			if err != nil {
+				return err
+			}
+			if m == nil {
+				return fmt.Errorf("no core deployment recorded for chain %s: run `ibc deploy core` first", chainID)
+			}
+			// an admin without code is a typo or an EOA, and handing it the
+			// only admin role is irreversible
+			hasCode, err := t.HasCode(ctx, spec.Admin)
+			if err != nil {
+				return err
+			}
+			if !hasCode {
+				return fmt.Errorf("admin %s has no code on chain %s: hand admin to a deployed multisig or timelock",
+					spec.Admin, chainID)
+			}
+			former, err := t.HandOffAdmin(ctx, am, spec.Admin, spec.AdminDelay)
+			if err != nil {
+				return err
+			}
+			slog.Info("admin handed off", "chain", chainID, "admin", spec.Admin,
+				"delay", spec.AdminDelay, "former", former)
+			if m.Roles == nil {
+				m.Roles = &manifest.Roles{}
+			}
+			m.Roles.Admin = spec.Admin
+			m.Roles.AdminDelay = spec.AdminDelay
+			m.Roles.FormerAdmin = former
+			return m.Save(dir)
+		},
+	}
+}
+
+// rolesManifest loads chainID's manifest and its AccessManager address; the
+// manifest is nil while no core deployment is recorded.
+func rolesManifest(dir, chainID string) (*manifest.Manifest, string, error) {
+	m, err := manifest.Load(dir, chainID)
+	if err != nil || m == nil || m.Core.Router == "" {
+		return nil, "", err
+	}
+	am := m.TargetData["accessManager"]
+	if am == "" {
+		return nil, "", fmt.Errorf("core manifest for chain %s has no accessManager recorded", chainID)
+	}
+	return m, am, nil
+}
+
+// revokedRelayers lists the relayers recorded under spec's role that spec no
+// longer grants it.
+func revokedRelayers(recorded *manifest.Roles, spec RolesSpec) []string {
+	if recorded == nil || recorded.RelayerRole != spec.RelayerRole {
+		return nil
+	}
+	var revoked []string
+	for _, relayer := range recorded.Relayers {
+		if !slices.ContainsFunc(spec.Relayers, func(r string) bool { return strings.EqualFold(r, relayer) }) {
+			revoked = append(revoked, relayer)
+		}
+	}
+	return revoked
+}
+
+func saveRelayers(dir string, m *manifest.Manifest, spec RolesSpec) error {
+	if m.Roles == nil {
+		m.Roles = &manifest.Roles{}
+	}
+	m.Roles.RelayerRole = spec.RelayerRole
+	m.Roles.Relayers = spec.Relayers
+	return m.Save(dir)
+}
+
+// ComponentProxies lists the proxies of component recorded in chainID's
+// manifest: the router, the GMP app, the transfer app, or the IFT token at
+// ift, every recorded token when ift is empty.
+func ComponentProxies(dir, chainID, component, ift string) ([]string, error) {
+	m, err := manifest.Load(dir, chainID)
+	if err != nil {
+		return nil, err
+	}
+	if m == nil || m.Core.Router == "" {
+		return nil, fmt.Errorf("no core deployment recorded for chain %s: run `ibc deploy core` first", chainID)
+	}
+	switch component {
+	case ComponentRouter:
+		return []string{m.Core.Router}, nil
+	case ComponentGMP:
+		if m.GMP == nil || m.GMP.Address == "" {
+			return nil, fmt.Errorf("no gmp deployment recorded for chain %s", chainID)
+		}
+		return []string{m.GMP.Address}, nil
+	case ComponentTransfer:
+		if m.Transfer == nil || m.Transfer.Address == "" {
+			return nil, fmt.Errorf("no transfer deployment recorded for chain %s", chainID)
+		}
+		return []string{m.Transfer.Address}, nil
+	case ComponentIFT:
+		if ift != "" {
+			if _, ok := m.TokenByAddress(ift); !ok {
+				return nil, fmt.Errorf("ift token %s not recorded for chain %s", ift, chainID)
+			}
+			return []string{ift}, nil
+		}
+		if len(m.Tokens) == 0 {
+			return nil, fmt.Errorf("no ift tokens recorded for chain %s", chainID)
+		}
+		proxies := make([]string, len(m.Tokens))
+		for i, tok := range m.Tokens {
+			proxies[i] = tok.Address
+		}
+		return proxies, nil
+	default:
+		return nil, fmt.Errorf("unknown component %q (want %s, %s, %s or %s)",
+			component, ComponentRouter, ComponentGMP, ComponentTransfer, ComponentIFT)
+	}
+}
+
+// UpgradeSteps points component's proxy at a new implementation deployed from
+// the target's contracts version and records it, keeping the replaced one for
+// RollbackSteps. The step is satisfied once the recorded implementation is of
+// that version and live.
+func UpgradeSteps(t Upgrader, dir, chainID, component, proxy string) []Step {
+	version := t.ContractsVersion()
+	return []Step{{
+		Name: fmt.Sprintf("%s %s upgraded to contracts %s on chain %s", component, proxy, version, chainID),
+		Done: func(ctx context.Context) (bool, error) {
+			m, err := manifest.Load(dir, chainID)
+			if err != nil || m == nil {
+				return false, err
+			}
+			rec, ok := m.Proxy(proxy)
+			if !ok || rec.Pending != nil || rec.Implementation.Version != version {
+				return false, nil
+			}
+			live, err := t.Implementation(ctx, proxy)
+			if err != nil {
+				return false, err
+			}
+			return strings.EqualFold(live, rec.Implementation.Address), nil
+		},
+		Run: func(ctx context.Context) error {
+			m, rec, live, err := upgradeState(ctx, t, dir, chainID, component, proxy)
+			if err != nil {
+				return err
+			}
+			// an earlier run may have deployed the implementation, or even
+			// upgraded to it, but died before recording the upgrade
+			reuse := rec.Pending != nil && rec.Pending.Version == version
+			if reuse {
+				if reuse, err = t.HasCode(ctx, rec.Pending.Address); err != nil {
+					return err
+				}
+			}
+			if !reuse {
+				impl, provErr := t.ProvisionImplementation(ctx, component)
+				if provErr != nil {
+					return provErr
+				}
+				rec.Pending = &manifest.Implementation{Address: impl, Version: version}
+				m.UpsertProxy(rec)
+				if saveErr := m.Save(dir); saveErr != nil {
+					return saveErr
+				}
+			}
+			if !strings.EqualFold(live, rec.Pending.Address) {
+				am := m.TargetData["accessManager"]
+				if err := t.UpgradeProxy(ctx, component, proxy, am, rec.Pending.Address); err != nil {
+					return err
+				}
+			}
+			slog.Info("proxy upgraded", "chain", chainID, "component", component, "proxy", proxy,
+				"implementation", rec.Pending.Address, "previous", rec.Implementation.Address, "version", version)
+			previous := rec.Implementation
+			rec.Previous, rec.Implementation, rec.Pending = &previous, *rec.Pending, nil
+			return saveProxy(dir, m, rec)
+		},
+	}}
+}
+
+// RollbackSteps points component's proxy back at the implementation its last
+// upgrade replaced. A rollback is undone by upgrading again. The step is
+// satisfied once the recorded implementation is live with nothing left to
+// roll back to.
+func RollbackSteps(t Upgrader, dir, chainID, component, proxy string) []Step {
+	return []Step{{
+		Name: fmt.Sprintf("%s %s rolled back on chain %s", component, proxy, chainID),
+		Done: func(ctx context.Context) (bool, error) {
+			m, err := manifest.Load(dir, chainID)
+			if err != nil || m == nil {
+				return false, err
+			}
+			rec, ok := m.Proxy(proxy)
+			if !ok || rec.Previous != nil || rec.Pending != nil {
+				return false, nil
+			}
+			live, err := t.Implementation(ctx, proxy)
+			if err != nil {
+				return false, err
+			}
+			return strings.EqualFold(live, rec.Implementation.Address), nil
+		},
+		Run: func(ctx context.Context) error {
+			m, rec, live, err := upgradeState(ctx, t, dir, chainID, component, proxy)
+			if err != nil {
+				return err
+			}
+			if rec.Previous == nil {
+				return fmt.Errorf("no previous implementation recorded for %s %s on chain %s: nothing to roll back",
+					component, proxy, chainID)
+			}
+			if !strings.EqualFold(live, rec.Previous.Address) {
+				am := m.TargetData["accessManager"]
+				if err := t.UpgradeProxy(ctx, component, proxy, am, rec.Previous.Address); err != nil {
+					return err
+				}
+			}
+			slog.Info("proxy rolled back", "chain", chainID, "component", component, "proxy", proxy,
+				"implementation", rec.Previous.Address, "abandoned", rec.Implementation.Address)
+			rec.Implementation, rec.Previous, rec.Pending = *rec.Previous, nil, nil
+			return saveProxy(dir, m, rec)
+		},
+	}}
+}
+
+// upgradeState loads chainID's manifest, proxy's record and its live
+// implementation. The record's implementation is resynced to the live one,
+// for a proxy upgraded for the first time, one rolled back before the
+// rollback was recorded, and one changed outside `ibc deploy`.
+func upgradeState(
+	ctx context.Context,
+	t Upgrader,
+	dir, chainID, component, proxy string,
+) (*manifest.Manifest, manifest.Proxy, string, error) {
+	proxies, err := ComponentProxies(dir, chainID, component, proxy)
+	if component != ComponentIFT {
+		if err == nil && !slices.ContainsFunc(proxies, func(p string) bool { return strings.EqualFold(p, proxy) }) {
+			err = fmt.Errorf("%s %s is not recorded for chain %s", component, proxy, chainID)
+		}
+	}
+	if err != nil {
+		return nil, manifest.Proxy{}, "", err
+	}
+	m, err := manifest.Load(dir, chainID)
+	if err != nil {
+		return nil, manifest.Proxy{}, "", err
+	}
+	live, err := t.Implementation(ctx, proxy)
+	if err != nil {
+		return nil, manifest.Proxy{}, "", err
+	}
+	rec, ok := m.Proxy(proxy)
+	if !ok {
+		rec = manifest.Proxy{Component: component, Address: proxy}
+	}
+	switch {
+	case strings.EqualFold(live, rec.Implementation.Address):
+	case rec.Pending != nil && strings.EqualFold(live, rec.Pending.Address):
+	case rec.Previous != nil && strings.EqualFold(live, rec.Previous.Address):
+		// an interrupted rollback
+		rec.Implementation = *rec.Previous
+	default:
+		if ok {
+			slog.Warn("proxy implementation changed outside ibc deploy", "chain", chainID, "proxy", proxy,
+				"recorded", rec.Implementation.Address, "live", live)
+		}
+		rec.Implementation = manifest.Implementation{Address: live}
+	}
+	return m, rec, live, nil
+}
+
+func saveProxy(dir string, m *manifest.Manifest, rec manifest.Proxy) error {
+	m.UpsertProxy(rec)
+	if rec.Component == ComponentRouter && m.TargetData != nil {
+		m.TargetData["ics26RouterImplementation"] = rec.Implementation.Address
+	}
+	return m.Save(dir)
+}
+
 // GMPSteps provisions the ICS27-GMP app on chainID's router and records it in
 // the manifest. Requires the core step to have run.
-func GMPSteps(t Target, dir, chainID string) []Step {
+func GMPSteps(t AppDeployer, dir, chainID string) []Step {
 	return []Step{{
 		Name: fmt.Sprintf("gmp app on chain %s", chainID),
 		Done: func(ctx context.Context) (bool, error) {
@@ -244,12 +902,87 @@ func GMPSteps(t Target, dir, chainID string) []Step {
 			m.GMP = &manifest.GMP{Address: ref.Address, AccountLogic: ref.AccountLogic, Port: GMPPortID}
 			return m.Save(dir)
 		},
+		Predict: predictGMP(t, dir, chainID),
+	}}
+}
+
+// TransferSteps provisions the ICS20 transfer app on chainID's router and
+// records it in the manifest. Requires the core step to have run.
+func TransferSteps(t AppDeployer, dir, chainID string) []Step {
+	return []Step{{
+		Name: fmt.Sprintf("transfer app on chain %s", chainID),
+		Done: func(ctx context.Context) (bool, error) {
+			m, err := manifest.Load(dir, chainID)
+			if err != nil {
+				return false, err
+			}
+			if m == nil || m.Core.Router == "" {
+				return false, nil // Run reports the missing-core error
+			}
+			if m.Transfer == nil || m.Transfer.Address == "" {
+				_, registered, regErr := t.AppRegistered(ctx, m.Core.Router, TransferPortID)
+				if regErr != nil {
+					return false, regErr
+				}
+				if registered {
+					return false, fmt.Errorf(
+						"transfer app is registered at port %q on chain %s but missing from the manifest "+
+							"(likely an interrupted deployment); reconcile the manifest before rerunning",
+						TransferPortID, chainID,
+					)
+				}
+				return false, nil
+			}
+			hasCode, err := t.HasCode(ctx, m.Transfer.Address)
+			if err != nil || !hasCode {
+				return false, err
+			}
+			addr, registered, err := t.AppRegistered(ctx, m.Core.Router, m.Transfer.Port)
+			if err != nil || !registered {
+				return false, err
+			}
+			if !strings.EqualFold(addr, m.Transfer.Address) {
+				return false, fmt.Errorf(
+					"transfer port %q on chain %s is registered to %s but the manifest has %s",
+					m.Transfer.Port, chainID, addr, m.Transfer.Address,
+				)
+			}
+			return true, nil
+		},
+		Run: func(ctx context.Context) error {
+			m, err := manifest.Load(dir, chainID)
+			if err != nil {
+				return err
+			}
+			if m == nil || m.Core.Router == "" {
+				return fmt.Errorf("no core deployment recorded for chain %s: run `ibc deploy core` first", chainID)
+			}
+			am := m.TargetData["accessManager"]
+			if am == "" {
+				return fmt.Errorf("core manifest for chain %s has no accessManager recorded", chainID)
+			}
+			ref, err := t.ProvisionTransfer(ctx, m.Core.Router, am)
+			if err != nil {
+				return err
+			}
+			if regErr := t.RegisterApp(ctx, m.Core.Router, ref.Address, TransferPortID); regErr != nil {
+				return regErr
+			}
+			m.Transfer = &manifest.Transfer{
+				Address:       ref.Address,
+				EscrowLogic:   ref.EscrowLogic,
+				IBCERC20Logic: ref.IBCERC20Logic,
+				Port:          TransferPortID,
+			}
+			return m.Save(dir)
+		},
+		Predict: predictTransfer(t, dir, chainID),
 	}}
 }
 
 // IFTSteps provisions one IFT token on chainID and records it in the manifest.
 // Requires the gmp step to have run.
-func IFTSteps(t Target, dir, chainID string, spec IFTSpec) []Step {
+func IFTSteps(t IFTDeployer, dir, chainID string, spec IFTSpec) []Step {
 	return []Step{{
 		Name: fmt.Sprintf("ift token %s on chain %s", spec.Symbol, chainID),
 		Done: func(ctx context.Context) (bool, error) {
@@ -284,6 +1017,7 @@ func IFTSteps(t Target, dir, chainID string, spec IFTSpec) []Step {
 			})
 			return m.Save(dir)
 		},
+		Predict: predictIFT(t, dir, chainID, spec),
 	}}
 }
 
@@ -292,7 +1026,7 @@ func IFTSteps(t Target, dir, chainID string, spec IFTSpec) []Step {
 // pointing at spec.CounterpartyIFT over spec.ClientID.
 // Requires core and a registered client on chainID. The bridge is recorded on
 // the manifest token matching iftAddr when one exists.
-func IFTBridgeSteps(t Target, dir, chainID, iftAddr, ctorOverride string, spec BridgeSpec) []Step {
+func IFTBridgeSteps(t IFTDeployer, dir, chainID, iftAddr, ctorOverride string, spec BridgeSpec) []Step {
 	return []Step{
 		{
 			Name: fmt.Sprintf("send call constructor on chain %s", chainID),
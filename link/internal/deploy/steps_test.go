@@ -4,7 +4,12 @@ package deploy
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log/slog"
+	"maps"
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -18,14 +23,28 @@ type fakeTarget struct {
 	registered map[string]string     // clientID -> address
 	apps       map[string]string     // port -> app address
 	bridges    map[string]fakeBridge // ift|clientID -> bridge
+	sets       map[string]AttestationState
+	relaying   uint64               // role the relaying entry points are bound to
+	roles      map[string]RoleGrant // roleID|account -> grant
+	impls      map[string]string    // proxy -> implementation
+	tokens     map[string]IFTSpec   // ift -> spec
+	version    string
 	provisions int
 	registers  int
-
-	gmpProvisions  int
-	iftProvisions  int
-	ctorProvisions int
+	migrations int
+
+	gmpProvisions      int
+	transferProvisions int
+	iftProvisions      int
+	ctorProvisions     int
+	roleChanges        int
+	implProvisions     int
+	upgrades           int
 }
 
+func (f *fakeTarget) Name() string           { return "fake" }
+func (f *fakeTarget) MerklePrefix() [][]byte { return [][]byte{{}} }
+
 func (f *fakeTarget) ProvisionCore(context.Context, CoreParams) (CoreRef, error) {
 	f.provisions++
 	return CoreRef{Router: "0xrouter", TargetData: map[string]string{"accessManager": "0xam"}}, nil
@@ -39,9 +58,28 @@ func (f *fakeTarget) ProvisionClient(context.Context, string, ClientSpec) (Clien
 func (f *fakeTarget) RegisterClient(_ context.Context, _ string, spec ClientSpec, ref ClientRef) (string, error) {
 	f.registers++
 	f.registered[spec.ClientID] = ref.Address
+	if p, ok := spec.Params.(AttestationParams); ok {
+		f.sets[spec.ClientID] = AttestationState{
+			Attestors:       p.Attestors,
+			Threshold:       p.Threshold,
+			LatestHeight:    p.InitialHeight,
+			LatestTimestamp: p.InitialTimestamp,
+		}
+	}
 	return spec.ClientID, nil
 }
 
+func (f *fakeTarget) AttestationState(_ context.Context, _, clientID string) (AttestationState, error) {
+	return f.sets[clientID], nil
+}
+
+func (f *fakeTarget) MigrateClient(_ context.Context, _, clientID, substituteClientID string) error {
+	f.migrations++
+	f.registered[clientID] = f.registered[substituteClientID]
+	f.sets[clientID] = f.sets[substituteClientID]
+	return nil
+}
+
 func (f *fakeTarget) ClientRegistered(_ context.Context, _, clientID string) (string, bool, error) {
 	addr, ok := f.registered[clientID]
 	return addr, ok, nil
@@ -65,7 +103,63 @@ func newFakeTarget() *fakeTarget {
 		registered: map[string]string{},
 		apps:       map[string]string{},
 		bridges:    map[string]fakeBridge{},
+		sets:       map[string]AttestationState{},
+		relaying:   PublicRole,
+		impls:      map[string]string{},
+		tokens:     map[string]IFTSpec{},
+		version:    "v1",
+		roles:      map[string]RoleGrant{roleKey(AdminRole, "0xdeployer"): {Member: true}},
+	}
+}
+
+func roleKey(roleID uint64, account string) string {
+	return fmt.Sprintf("%d|%s", roleID, strings.ToLower(account))
+}
+
+func (f *fakeTarget) RelayingRoles(context.Context, string, string) (map[string]uint64, error) {
+	return map[string]uint64{"recvPacket": f.relaying, "ackPacket": f.relaying, "timeoutPacket": f.relaying}, nil
+}
+
+func (f *fakeTarget) Role(_ context.Context, _ string, roleID uint64, account string) (RoleGrant, error) {
+	return f.roles[roleKey(roleID, account)], nil
+}
+
+func (f *fakeTarget) RestrictRelaying(_ context.Context, _, _ string, roleID uint64, grant, revoke []string) error {
+	f.roleChanges++
+	for _, account := range grant {
+		f.roles[roleKey(roleID, account)] = RoleGrant{Member: true}
 	}
+	for _, account := range revoke {
+		delete(f.roles, roleKey(roleID, account))
+	}
+	f.relaying = roleID
+	return nil
+}
+
+func (f *fakeTarget) ContractsVersion() string { return f.version }
+
+func (f *fakeTarget) Implementation(_ context.Context, proxy string) (string, error) {
+	return f.impls[proxy], nil
+}
+
+func (f *fakeTarget) ProvisionImplementation(_ context.Context, component string) (string, error) {
+	f.implProvisions++
+	impl := fmt.Sprintf("0x%s-impl-%d", component, f.implProvisions)
+	f.hasCode[impl] = true
+	return impl, nil
+}
+
+func (f *fakeTarget) UpgradeProxy(_ context.Context, _, proxy, _, implementation string) error {
+	f.upgrades++
+	f.impls[proxy] = implementation
+	return nil
+}
+
+func (f *fakeTarget) HandOffAdmin(_ context.Context, _, admin string, executionDelay uint32) (string, error) {
+	f.roleChanges++
+	f.roles[roleKey(AdminRole, admin)] = RoleGrant{Member: true, ExecutionDelay: executionDelay}
+	delete(f.roles, roleKey(AdminRole, "0xdeployer"))
+	return "0xdeployer", nil
 }
 
 func (f *fakeTarget) ProvisionGMP(context.Context, string, string) (GMPRef, error) {
@@ -73,6 +167,11 @@ func (f *fakeTarget) ProvisionGMP(context.Context, string, string) (GMPRef, erro
 	return GMPRef{Address: "0xgmp", AccountLogic: "0xlogic"}, nil
 }
 
+func (f *fakeTarget) ProvisionTransfer(context.Context, string, string) (TransferRef, error) {
+	f.transferProvisions++
+	return TransferRef{Address: "0xtransfer", EscrowLogic: "0xescrow", IBCERC20Logic: "0xerc20"}, nil
+}
+
 func (f *fakeTarget) RegisterApp(_ context.Context, _, app, port string) error {
 	f.apps[port] = app
 	return nil
@@ -85,9 +184,34 @@ func (f *fakeTarget) AppRegistered(_ context.Context, _, port string) (string, b
 
 func (f *fakeTarget) ProvisionIFT(_ context.Context, _ string, spec IFTSpec) (IFTRef, error) {
 	f.iftProvisions++
+	f.tokens["0xift-"+spec.Symbol] = spec
 	return IFTRef{Address: "0xift-" + spec.Symbol}, nil
 }
 
+func (f *fakeTarget) IFTToken(_ context.Context, address string) (IFTSpec, error) {
+	spec, ok := f.tokens[address]
+	if !ok {
+		return IFTSpec{}, fmt.Errorf("no token at %s", address)
+	}
+	return spec, nil
+}
+
+func (f *fakeTarget) Discover(context.Context, string, uint64) (Discovery, error) {
+	disc := Discovery{
+		Core: CoreRef{Router: "0xrouter", TargetData: map[string]string{"accessManager": "0xam"}},
+		Apps: maps.Clone(f.apps),
+	}
+	for _, id := range slices.Sorted(maps.Keys(f.registered)) {
+		c := manifest.Client{ClientID: id, Address: f.registered[id], CounterpartyClientID: "cp-" + id}
+		if set, ok := f.sets[id]; ok {
+			c.Type = ClientTypeAttestation
+			c.Params = map[string]any{"attestors": set.Attestors, "threshold": set.Threshold}
+		}
+		disc.Clients = append(disc.Clients, c)
+	}
+	return disc, nil
+}
+
 func (f *fakeTarget) ProvisionSendCallConstructor(context.Context) (string, error) {
 	f.ctorProvisions++
 	return "0xctor", nil
@@ -124,6 +248,7 @@ func TestCoreStepsIdempotent(t *testing.T) {
 
 	m, err := manifest.Load(dir, "1")
 	require.NoError(t, err)
+	require.Equal(t, "fake", m.Target)
 	require.Equal(t, "0xrouter", m.Core.Router)
 	require.Equal(t, "0xam", m.TargetData["accessManager"])
 
@@ -176,6 +301,148 @@ func TestClientStepsIdempotent(t *testing.T) {
 	require.Equal(t, 1, target.registers)
 }
 
+// assigningTarget is a fakeTarget whose chain assigns client IDs, like
+// ibc-go's.
+type assigningTarget struct {
+	*fakeTarget
+	seq int
+	// failRegister fails registration, as a run dying after creating
+	// the client does
+	failRegister bool
+}
+
+func (a *assigningTarget) AssignsClientIDs() bool { return true }
+
+func (a *assigningTarget) ProvisionClient(context.Context, string, ClientSpec) (ClientRef, error) {
+	a.provisions++
+	id := fmt.Sprintf("attestations-%d", a.seq)
+	a.seq++
+	a.hasCode[id] = true
+	return ClientRef{Address: id}, nil
+}
+
+func (a *assigningTarget) RegisterClient(
+	ctx context.Context,
+	router string,
+	spec ClientSpec,
+	ref ClientRef,
+) (string, error) {
+	if a.failRegister {
+		return "", errors.New("connection reset")
+	}
+	spec.ClientID = ref.Address
+	return a.fakeTarget.RegisterClient(ctx, router, spec, ref)
+}
+
+func TestClientStepsAssignedClientID(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	target := &assigningTarget{fakeTarget: newFakeTarget()}
+	target.hasCode["ibc"] = true
+
+	m := manifest.New("1", "test")
+	m.Core.Router = "ibc"
+	require.NoError(t, m.Save(dir))
+
+	spec := ClientSpec{
+		ClientID:             "link-1-2",
+		Type:                 ClientTypeAttestation,
+		CounterpartyChainID:  "2",
+		CounterpartyClientID: "link-1-2",
+		Params:               AttestationParams{Attestors: []string{"0xa"}, Threshold: 1, InitialHeight: 5},
+	}
+	res, err := RunSteps(ctx, slog.Default(), false, ClientSteps(target, dir, "1", spec))
+	require.NoError(t, err)
+	require.Equal(t, ActionExecuted, res[0].Action)
+
+	m, err = manifest.Load(dir, "1")
+	require.NoError(t, err)
+	require.Len(t, m.Clients, 1)
+	require.Equal(t, "attestations-0", m.Clients[0].ClientID)
+	require.Equal(t, "link-1-2", m.Clients[0].CounterpartyClientID)
+
+	// a rerun finds the client by the counterparty client it tracks
+	res, err = RunSteps(ctx, slog.Default(), false, ClientSteps(target, dir, "1", spec))
+	require.NoError(t, err)
+	require.Equal(t, ActionSkipped, res[0].Action)
+	require.Equal(t, 1, target.provisions)
+
+	changed := spec
+	changed.Params = AttestationParams{Attestors: []string{"0xa"}, Threshold: 2, InitialHeight: 5}
+	_, err = RunSteps(ctx, slog.Default(), false, ClientSteps(target, dir, "1", changed))
+	require.ErrorContains(t, err, `client "attestations-0" on chain 1 is already deployed with different values`)
+	require.ErrorContains(t, err, "--counterparty-client-id")
+
+	// a client the chain no longer has is replaced, record and all
+	delete(target.registered, "attestations-0")
+	delete(target.hasCode, "attestations-0")
+	_, err = RunSteps(ctx, slog.Default(), false, ClientSteps(target, dir, "1", spec))
+	require.NoError(t, err)
+	m, err = manifest.Load(dir, "1")
+	require.NoError(t, err)
+	require.Len(t, m.Clients, 1)
+	require.Equal(t, "attestations-1", m.Clients[0].ClientID)
+}
+
+// A run that dies between creating a client and registering it leaves the
+// assigned ID recorded, so the rerun registers that client rather than
+// creating another.
+func TestClientStepsAssignedClientResumesRegistration(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	target := &assigningTarget{fakeTarget: newFakeTarget(), failRegister: true}
+	target.hasCode["ibc"] = true
+
+	m := manifest.New("1", "test")
+	m.Core.Router = "ibc"
+	require.NoError(t, m.Save(dir))
+
+	spec := ClientSpec{
+		ClientID:             "link-1-2",
+		Type:                 ClientTypeAttestation,
+		CounterpartyChainID:  "2",
+		CounterpartyClientID: "link-1-2",
+		Params:               AttestationParams{Attestors: []string{"0xa"}, Threshold: 1, InitialHeight: 5},
+	}
+	_, err := RunSteps(ctx, slog.Default(), false, ClientSteps(target, dir, "1", spec))
+	require.ErrorContains(t, err, "connection reset")
+	m, err = manifest.Load(dir, "1")
+	require.NoError(t, err)
+	recorded, ok := m.ClientTracking("2", "link-1-2")
+	require.True(t, ok)
+	require.Equal(t, "attestations-0", recorded.ClientID)
+
+	target.failRegister = false
+	res, err := RunSteps(ctx, slog.Default(), false, ClientSteps(target, dir, "1", spec))
+	require.NoError(t, err)
+	require.Equal(t, ActionExecuted, res[0].Action)
+	require.Equal(t, 1, target.provisions)
+	require.Equal(t, "attestations-0", target.registered["attestations-0"])
+
+	m, err = manifest.Load(dir, "1")
+	require.NoError(t, err)
+	require.Len(t, m.Clients, 1)
+	require.Equal(t, "attestations-0", m.Clients[0].ClientID)
+
+	// an unregistered client created for another attestor set is abandoned
+	target.failRegister = true
+	other := spec
+	other.CounterpartyClientID = "link-1-3"
+	_, err = RunSteps(ctx, slog.Default(), false, ClientSteps(target, dir, "1", other))
+	require.Error(t, err)
+	target.failRegister = false
+	other.Params = AttestationParams{Attestors: []string{"0xb"}, Threshold: 1, InitialHeight: 5}
+	_, err = RunSteps(ctx, slog.Default(), false, ClientSteps(target, dir, "1", other))
+	require.NoError(t, err)
+	require.Equal(t, 3, target.provisions)
+	m, err = manifest.Load(dir, "1")
+	require.NoError(t, err)
+	recorded, ok = m.ClientTracking("2", "link-1-3")
+	require.True(t, ok)
+	require.Equal(t, "attestations-2", recorded.ClientID)
+	require.Len(t, m.Clients, 2)
+}
+
 // A client registered on-chain with no manifest entry (a deployment that
 // died between RegisterClient and Save) cannot have its deployed parameters
 // recovered reliably, so the precheck fails rather than trusting the
@@ -288,6 +555,147 @@ func TestClientStepsDivergentSpecError(t *testing.T) {
 // change every invocation; they are launch-time trusted state, not client
 // identity, so a rerun differing only in them skips cleanly and leaves the
 // deploy-time values in the manifest.
+func TestAttestationSetSteps(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func(t *testing.T) (string, *fakeTarget) {
+		dir := t.TempDir()
+		target := newFakeTarget()
+		target.registered["link-2"] = "0xold"
+		target.sets["link-2"] = AttestationState{
+			Attestors:       []string{"0xa", "0xb"},
+			Threshold:       2,
+			LatestHeight:    90,
+			LatestTimestamp: 900,
+		}
+
+		m := manifest.New("1", "test")
+		m.Core.Router = "0xrouter"
+		m.UpsertClient(manifest.Client{
+			ClientID:             "link-2",
+			Type:                 ClientTypeAttestation,
+			Address:              "0xold",
+			CounterpartyChainID:  "2",
+			CounterpartyClientID: "link-1",
+			Params:               map[string]any{"attestors": []string{"0xa", "0xb"}, "threshold": 2},
+		})
+		require.NoError(t, m.Save(dir))
+
+		return dir, target
+	}
+
+	t.Run("migratesToSubstitute", func(t *testing.T) {
+		// ARRANGE
+		dir, target := setup(t)
+		steps := AttestationSetSteps(target, dir, "1", "link-2", []string{"0xa", "0xc"}, 2)
+
+		// ACT
+		res, err := RunSteps(ctx, slog.Default(), false, steps)
+
+		// ASSERT
+		require.NoError(t, err)
+		require.Equal(t, ActionExecuted, res[0].Action)
+		require.Equal(t, 1, target.migrations)
+
+		substitute := substituteClientID("link-2", []string{"0xa", "0xc"}, 2)
+		// the substitute starts from the live client's latest trusted state
+		require.Equal(t, uint64(90), target.sets[substitute].LatestHeight)
+
+		m, err := manifest.Load(dir, "1")
+		require.NoError(t, err)
+		c, ok := m.Client("link-2")
+		require.True(t, ok)
+		require.Equal(t, "0xclient", c.Address)
+		require.Equal(t, []any{"0xa", "0xc"}, c.Params["attestors"])
+		require.Equal(t, "link-1", c.CounterpartyClientID)
+
+		// rerun is satisfied by the on-chain set
+		res, err = RunSteps(ctx, slog.Default(), false, steps)
+		require.NoError(t, err)
+		require.Equal(t, ActionSkipped, res[0].Action)
+		require.Equal(t, 1, target.migrations)
+	})
+
+	t.Run("requiresClientMigrator", func(t *testing.T) {
+		// ARRANGE
+		dir, target := setup(t)
+		// only the required Target methods: no MigrateClient
+		bare := struct{ Target }{target}
+
+		// ACT
+		_, err := RunSteps(ctx, slog.Default(), false,
+			AttestationSetSteps(bare, dir, "1", "link-2", []string{"0xa", "0xc"}, 2))
+
+		// ASSERT
+		require.ErrorIs(t, err, errors.ErrUnsupported)
+		require.ErrorContains(t, err, "client migration")
+		require.Zero(t, target.provisions)
+	})
+
+	t.Run("reusesRegisteredSubstitute", func(t *testing.T) {
+		// ARRANGE
+		dir, target := setup(t)
+		substitute := substituteClientID("link-2", []string{"0xa", "0xc"}, 1)
+		target.registered[substitute] = "0xsubstitute"
+		target.sets[substitute] = AttestationState{Attestors: []string{"0xa", "0xc"}, Threshold: 1}
+
+		// ACT
+		_, err := RunSteps(ctx, slog.Default(), false,
+			AttestationSetSteps(target, dir, "1", "link-2", []string{"0xC", "0xA"}, 1))
+
+		// ASSERT
+		require.NoError(t, err)
+		require.Zero(t, target.provisions)
+		require.Equal(t, "0xsubstitute", target.registered["link-2"])
+	})
+
+	t.Run("recordsMigrationMissingFromManifest", func(t *testing.T) {
+		// ARRANGE
+		dir, target := setup(t)
+		target.registered["link-2"] = "0xmigrated"
+		target.sets["link-2"] = AttestationState{Attestors: []string{"0xc"}, Threshold: 1}
+
+		// ACT
+		res, err := RunSteps(ctx, slog.Default(), false,
+			AttestationSetSteps(target, dir, "1", "link-2", []string{"0xc"}, 1))
+
+		// ASSERT
+		require.NoError(t, err)
+		require.Equal(t, ActionSkipped, res[0].Action)
+
+		m, err := manifest.Load(dir, "1")
+		require.NoError(t, err)
+		c, _ := m.Client("link-2")
+		require.Equal(t, "0xmigrated", c.Address)
+		require.Equal(t, []any{"0xc"}, c.Params["attestors"])
+	})
+
+	t.Run("rejectsInvalidThreshold", func(t *testing.T) {
+		// ARRANGE
+		dir, target := setup(t)
+
+		// ACT
+		_, err := RunSteps(ctx, slog.Default(), false,
+			AttestationSetSteps(target, dir, "1", "link-2", []string{"0xc"}, 2))
+
+		// ASSERT
+		require.ErrorContains(t, err, "threshold 2 invalid for 1 attestors")
+		require.Zero(t, target.migrations)
+	})
+
+	t.Run("unknownClient", func(t *testing.T) {
+		// ARRANGE
+		dir, target := setup(t)
+
+		// ACT
+		_, err := RunSteps(ctx, slog.Default(), false,
+			AttestationSetSteps(target, dir, "1", "link-9", []string{"0xc"}, 1))
+
+		// ASSERT
+		require.ErrorContains(t, err, `client "link-9" not recorded`)
+	})
+}
+
 func TestGMPStepsIdempotent(t *testing.T) {
 	dir := t.TempDir()
 	target := newFakeTarget()
@@ -342,6 +750,63 @@ func TestGMPStepsInterruptedDeploy(t *testing.T) {
 	require.Equal(t, 0, target.gmpProvisions)
 }
 
+func TestTransferStepsIdempotent(t *testing.T) {
+	dir := t.TempDir()
+	target := newFakeTarget()
+	target.hasCode["0xrouter"] = true
+
+	m := manifest.New("1", "test")
+	m.Core.Router = "0xrouter"
+	m.TargetData = map[string]string{"accessManager": "0xam"}
+	require.NoError(t, m.Save(dir))
+
+	res, err := RunSteps(context.Background(), slog.Default(), false, TransferSteps(target, dir, "1"))
+	require.NoError(t, err)
+	require.Equal(t, "executed", res[0].Action)
+	require.Equal(t, 1, target.transferProvisions)
+	require.Equal(t, "0xtransfer", target.apps[TransferPortID])
+
+	m, err = manifest.Load(dir, "1")
+	require.NoError(t, err)
+	require.NotNil(t, m.Transfer)
+	require.Equal(t, "0xtransfer", m.Transfer.Address)
+	require.Equal(t, "0xescrow", m.Transfer.EscrowLogic)
+	require.Equal(t, "0xerc20", m.Transfer.IBCERC20Logic)
+	require.Equal(t, TransferPortID, m.Transfer.Port)
+
+	// second run skips: transfer app recorded, has code, app registered
+	target.hasCode["0xtransfer"] = true
+	res, err = RunSteps(context.Background(), slog.Default(), false, TransferSteps(target, dir, "1"))
+	require.NoError(t, err)
+	require.Equal(t, "skipped", res[0].Action)
+	require.Equal(t, 1, target.transferProvisions)
+}
+
+func TestTransferStepsRequiresCore(t *testing.T) {
+	dir := t.TempDir()
+	target := newFakeTarget()
+	_, err := RunSteps(context.Background(), slog.Default(), false, TransferSteps(target, dir, "1"))
+	require.ErrorContains(t, err, "run `ibc deploy core` first")
+}
+
+func TestTransferStepsInterruptedDeploy(t *testing.T) {
+	dir := t.TempDir()
+	target := newFakeTarget()
+	target.hasCode["0xrouter"] = true
+
+	m := manifest.New("1", "test")
+	m.Core.Router = "0xrouter"
+	m.TargetData = map[string]string{"accessManager": "0xam"}
+	require.NoError(t, m.Save(dir))
+
+	// registered on-chain but absent from the manifest
+	target.apps[TransferPortID] = "0xlive"
+
+	_, err := RunSteps(context.Background(), slog.Default(), false, TransferSteps(target, dir, "1"))
+	require.ErrorContains(t, err, "missing from the manifest")
+	require.Equal(t, 0, target.transferProvisions)
+}
+
 func TestClientStepsRerunIgnoresTrustedStateDrift(t *testing.T) {
 	dir := t.TempDir()
 	target := newFakeTarget()
@@ -618,3 +1083,236 @@ func TestIFTBridgeStepsUnrecordedToken(t *testing.T) {
 	require.NoError(t, err)
 	require.Empty(t, m.Tokens)
 }
+
+func rolesManifestFixture(t *testing.T) (string, *fakeTarget) {
+	t.Helper()
+	dir := t.TempDir()
+	target := newFakeTarget()
+	m := manifest.New("1", "test")
+	m.Core.Router = "0xrouter"
+	m.TargetData = map[string]string{"accessManager": "0xam"}
+	require.NoError(t, m.Save(dir))
+	return dir, target
+}
+
+func TestRolesStepsIdempotent(t *testing.T) {
+	dir, target := rolesManifestFixture(t)
+	target.hasCode["0xsafe"] = true
+	spec := RolesSpec{
+		RelayerRole: DefaultRelayerRole,
+		Relayers:    []string{"0xr1", "0xr2"},
+		Admin:       "0xsafe",
+		AdminDelay:  86400,
+	}
+
+	res, err := RunSteps(context.Background(), slog.Default(), false, RolesSteps(target, dir, "1", spec))
+
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	require.Equal(t, "executed", res[0].Action)
+	require.Equal(t, "executed", res[1].Action)
+	require.Equal(t, DefaultRelayerRole, target.relaying)
+	require.True(t, target.roles[roleKey(DefaultRelayerRole, "0xr2")].Member)
+	require.Equal(t, RoleGrant{Member: true, ExecutionDelay: 86400}, target.roles[roleKey(AdminRole, "0xsafe")])
+	require.False(t, target.roles[roleKey(AdminRole, "0xdeployer")].Member)
+
+	m, err := manifest.Load(dir, "1")
+	require.NoError(t, err)
+	require.Equal(t, &manifest.Roles{
+		RelayerRole: DefaultRelayerRole,
+		Relayers:    []string{"0xr1", "0xr2"},
+		Admin:       "0xsafe",
+		AdminDelay:  86400,
+		FormerAdmin: "0xdeployer",
+	}, m.Roles)
+
+	// second run skips both steps
+	res, err = RunSteps(context.Background(), slog.Default(), false, RolesSteps(target, dir, "1", spec))
+	require.NoError(t, err)
+	require.Equal(t, "skipped", res[0].Action)
+	require.Equal(t, "skipped", res[1].Action)
+	require.Equal(t, 2, target.roleChanges)
+}
+
+func TestRolesStepsRevokesDroppedRelayers(t *testing.T) {
+	dir, target := rolesManifestFixture(t)
+	spec := RolesSpec{RelayerRole: DefaultRelayerRole, Relayers: []string{"0xr1", "0xr2"}}
+	_, err := RunSteps(context.Background(), slog.Default(), false, RolesSteps(target, dir, "1", spec))
+	require.NoError(t, err)
+
+	spec.Relayers = []string{"0xR1"}
+	res, err := RunSteps(context.Background(), slog.Default(), false, RolesSteps(target, dir, "1", spec))
+
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	require.Equal(t, "executed", res[0].Action)
+	require.True(t, target.roles[roleKey(DefaultRelayerRole, "0xr1")].Member)
+	require.False(t, target.roles[roleKey(DefaultRelayerRole, "0xr2")].Member)
+
+	m, err := manifest.Load(dir, "1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"0xR1"}, m.Roles.Relayers)
+}
+
+func TestRolesStepsRejectsReservedRole(t *testing.T) {
+	dir, target := rolesManifestFixture(t)
+	spec := RolesSpec{RelayerRole: PublicRole, Relayers: []string{"0xr1"}}
+
+	_, err := RunSteps(context.Background(), slog.Default(), false, RolesSteps(target, dir, "1", spec))
+
+	require.ErrorContains(t, err, "is reserved")
+	require.Equal(t, 0, target.roleChanges)
+}
+
+func TestRolesStepsAdminWithoutCode(t *testing.T) {
+	dir, target := rolesManifestFixture(t)
+	spec := RolesSpec{Admin: "0xeoa"}
+
+	_, err := RunSteps(context.Background(), slog.Default(), false, RolesSteps(target, dir, "1", spec))
+
+	require.ErrorContains(t, err, "has no code")
+	require.Equal(t, 0, target.roleChanges)
+	require.True(t, target.roles[roleKey(AdminRole, "0xdeployer")].Member)
+}
+
+func TestRolesStepsAfterHandOff(t *testing.T) {
+	dir, target := rolesManifestFixture(t)
+	target.hasCode["0xsafe"] = true
+	target.hasCode["0xother"] = true
+	_, err := RunSteps(context.Background(), slog.Default(), false,
+		RolesSteps(target, dir, "1", RolesSpec{Admin: "0xsafe"}))
+	require.NoError(t, err)
+
+	t.Run("different admin", func(t *testing.T) {
+		_, err := RunSteps(context.Background(), slog.Default(), false,
+			RolesSteps(target, dir, "1", RolesSpec{Admin: "0xother"}))
+		require.ErrorContains(t, err, "already handed to 0xsafe")
+	})
+
+	t.Run("relayer change", func(t *testing.T) {
+		spec := RolesSpec{RelayerRole: DefaultRelayerRole, Relayers: []string{"0xr1"}}
+		_, err := RunSteps(context.Background(), slog.Default(), false, RolesSteps(target, dir, "1", spec))
+		require.ErrorContains(t, err, "must be scheduled through it")
+	})
+
+	require.Equal(t, 1, target.roleChanges)
+}
+
+func upgradeFixture(t *testing.T) (string, *fakeTarget) {
+	t.Helper()
+	dir := t.TempDir()
+	target := newFakeTarget()
+	target.impls["0xrouter"] = "0xrouter-impl-0"
+	m := manifest.New("1", "test")
+	m.Core.Router = "0xrouter"
+	m.TargetData = map[string]string{"accessManager": "0xam", "ics26RouterImplementation": "0xrouter-impl-0"}
+	require.NoError(t, m.Save(dir))
+	return dir, target
+}
+
+func TestUpgradeStepsIdempotentAndRollback(t *testing.T) {
+	dir, target := upgradeFixture(t)
+	upgrade := func() []StepResult {
+		res, err := RunSteps(context.Background(), slog.Default(), false,
+			UpgradeSteps(target, dir, "1", ComponentRouter, "0xrouter"))
+		require.NoError(t, err)
+		return res
+	}
+
+	// never upgraded: nothing to restore
+	_, err := RunSteps(context.Background(), slog.Default(), false,
+		RollbackSteps(target, dir, "1", ComponentRouter, "0xrouter"))
+	require.ErrorContains(t, err, "nothing to roll back")
+
+	res := upgrade()
+	require.Equal(t, "executed", res[0].Action)
+	require.Equal(t, "0xrouter-impl-1", target.impls["0xrouter"])
+
+	m, err := manifest.Load(dir, "1")
+	require.NoError(t, err)
+	require.Equal(t, []manifest.Proxy{{
+		Component:      ComponentRouter,
+		Address:        "0xrouter",
+		Implementation: manifest.Implementation{Address: "0xrouter-impl-1", Version: "v1"},
+		Previous:       &manifest.Implementation{Address: "0xrouter-impl-0"},
+	}}, m.Proxies)
+	require.Equal(t, "0xrouter-impl-1", m.TargetData["ics26RouterImplementation"])
+
+	// same contracts version: nothing to do
+	res = upgrade()
+	require.Equal(t, "skipped", res[0].Action)
+	require.Equal(t, 1, target.implProvisions)
+
+	// roll back to the deploy-time implementation
+	_, err = RunSteps(context.Background(), slog.Default(), false,
+		RollbackSteps(target, dir, "1", ComponentRouter, "0xrouter"))
+	require.NoError(t, err)
+	require.Equal(t, "0xrouter-impl-0", target.impls["0xrouter"])
+
+	m, err = manifest.Load(dir, "1")
+	require.NoError(t, err)
+	require.Equal(t, manifest.Implementation{Address: "0xrouter-impl-0"}, m.Proxies[0].Implementation)
+	require.Nil(t, m.Proxies[0].Previous)
+
+	// rerunning the finished rollback is a no-op
+	res, err = RunSteps(context.Background(), slog.Default(), false,
+		RollbackSteps(target, dir, "1", ComponentRouter, "0xrouter"))
+	require.NoError(t, err)
+	require.Equal(t, "skipped", res[0].Action)
+	require.Equal(t, 2, target.upgrades)
+}
+
+// An upgrade that died after deploying its implementation reuses it.
+func TestUpgradeStepsResumesPending(t *testing.T) {
+	dir, target := upgradeFixture(t)
+	target.hasCode["0xpending"] = true
+	m, err := manifest.Load(dir, "1")
+	require.NoError(t, err)
+	m.UpsertProxy(manifest.Proxy{
+		Component:      ComponentRouter,
+		Address:        "0xrouter",
+		Implementation: manifest.Implementation{Address: "0xrouter-impl-0"},
+		Pending:        &manifest.Implementation{Address: "0xpending", Version: "v1"},
+	})
+	require.NoError(t, m.Save(dir))
+
+	_, err = RunSteps(context.Background(), slog.Default(), false,
+		UpgradeSteps(target, dir, "1", ComponentRouter, "0xrouter"))
+
+	require.NoError(t, err)
+	require.Equal(t, 0, target.implProvisions)
+	require.Equal(t, "0xpending", target.impls["0xrouter"])
+	m, err = manifest.Load(dir, "1")
+	require.NoError(t, err)
+	require.Nil(t, m.Proxies[0].Pending)
+	require.Equal(t, "0xrouter-impl-0", m.Proxies[0].Previous.Address)
+}
+
+func TestComponentProxies(t *testing.T) {
+	dir, _ := upgradeFixture(t)
+	m, err := manifest.Load(dir, "1")
+	require.NoError(t, err)
+	m.UpsertToken(manifest.Token{Symbol: "A", Address: "0xa"})
+	m.UpsertToken(manifest.Token{Symbol: "B", Address: "0xb"})
+	require.NoError(t, m.Save(dir))
+
+	proxies, err := ComponentProxies(dir, "1", ComponentIFT, "")
+	require.NoError(t, err)
+	require.Equal(t, []string{"0xa", "0xb"}, proxies)
+
+	proxies, err = ComponentProxies(dir, "1", ComponentIFT, "0xB")
+	require.NoError(t, err)
+	require.Equal(t, []string{"0xB"}, proxies)
+
+	_, err = ComponentProxies(dir, "1", ComponentIFT, "0xc")
+	require.ErrorContains(t, err, "not recorded")
+
+	_, err = ComponentProxies(dir, "1", ComponentGMP, "")
+	require.ErrorContains(t, err, "no gmp deployment recorded")
+
+	_, err = ComponentProxies(dir, "1", ComponentTransfer, "")
+	require.ErrorContains(t, err, "no transfer deployment recorded")
+
+	_, err = ComponentProxies(dir, "1", "escrow", "")
+	require.ErrorContains(t, err, "unknown component")
+}
@@ -0,0 +1,179 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Package topology parses topology files: declarative descriptions of a mesh
+// of chains, the connections between them, the attestor sets their clients
+// trust and the apps each chain runs, which `ibc deploy apply` provisions.
+package topology
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/goccy/go-yaml"
+
+	"github.com/cosmos/ibc/link/internal/deploy"
+)
+
+// Topology is a declarative multi-chain deployment.
+type Topology struct {
+	Chains       []Chain       `yaml:"chains"`
+	AttestorSets []AttestorSet `yaml:"attestorSets,omitempty"`
+	Connections  []Connection  `yaml:"connections,omitempty"`
+}
+
+// Chain is one chain to deploy the core stack on. The chain itself, its RPC
+// and deployer, is declared in the config file.
+type Chain struct {
+	ChainID string `yaml:"chainId"`
+	Apps    Apps   `yaml:"apps,omitempty"`
+}
+
+// Apps are the apps to deploy on a chain.
+type Apps struct {
+	GMP      bool    `yaml:"gmp,omitempty"`
+	Transfer bool    `yaml:"transfer,omitempty"`
+	IFT      []Token `yaml:"ift,omitempty"`
+}
+
+// Token is one IFT token to deploy. Owner defaults to the deployer.
+type Token struct {
+	Name   string `yaml:"name"`
+	Symbol string `yaml:"symbol"`
+	Owner  string `yaml:"owner,omitempty"`
+}
+
+// AttestorSet is a named attestor set and threshold that clients tracking a
+// chain trust. Attestors are addresses, attestor names or signer aliases.
+type AttestorSet struct {
+	Name      string   `yaml:"name"`
+	Attestors []string `yaml:"attestors"`
+	Threshold uint8    `yaml:"threshold"`
+}
+
+// Connection is a client pair between two chains. Alias names its
+// relayer.connections[] entry, "<a>-<b>" by default.
+type Connection struct {
+	Alias string `yaml:"alias,omitempty"`
+	A     End    `yaml:"a"`
+	B     End    `yaml:"b"`
+}
+
+// End is one side of a connection: the client on ChainID tracking the other
+// side, and the signer relaying to ChainID.
+type End struct {
+	ChainID string `yaml:"chainId"`
+	// ClientID defaults to the connection's shared "link-<a>-<b>" name.
+	ClientID string `yaml:"clientId,omitempty"`
+	// AttestorSet names the set attesting to ChainID, which the
+	// counterparty's client trusts. Empty means every attestor configured
+	// for ChainID, with threshold 1.
+	AttestorSet string `yaml:"attestorSet,omitempty"`
+	// Signer is the signers[] alias submitting relay transactions on ChainID.
+	Signer string `yaml:"signer"`
+}
+
+// Load reads and validates the topology file at path. Environment variables
+// in the file are expanded, as in the config file.
+func Load(path string) (Topology, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return Topology{}, err
+	}
+	var t Topology
+	if err := yaml.UnmarshalWithOptions([]byte(os.ExpandEnv(string(bz))), &t, yaml.DisallowUnknownField()); err != nil {
+		return Topology{}, fmt.Errorf("parse topology %s: %w", path, err)
+	}
+	if err := t.Validate(); err != nil {
+		return Topology{}, fmt.Errorf("topology %s: %w", path, err)
+	}
+	return t, nil
+}
+
+// Validate checks the topology is self-consistent. Whether its chains,
+// signers and attestors are declared in the config is checked on apply.
+func (t Topology) Validate() error {
+	if len(t.Chains) == 0 {
+		return fmt.Errorf(".chains: no chains declared")
+	}
+	chains := make(map[string]Chain, len(t.Chains))
+	for i, c := range t.Chains {
+		if c.ChainID == "" {
+			return fmt.Errorf(".chains[%d]: chainId required", i)
+		}
+		if _, dup := chains[c.ChainID]; dup {
+			return fmt.Errorf(".chains[%s]: declared twice", c.ChainID)
+		}
+		chains[c.ChainID] = c
+		if len(c.Apps.IFT) > 0 && !c.Apps.GMP {
+			return fmt.Errorf(".chains[%s].apps: ift tokens require gmp", c.ChainID)
+		}
+		for j, tok := range c.Apps.IFT {
+			if tok.Name == "" || tok.Symbol == "" {
+				return fmt.Errorf(".chains[%s].apps.ift[%d]: name and symbol required", c.ChainID, j)
+			}
+		}
+	}
+
+	sets := make(map[string]struct{}, len(t.AttestorSets))
+	for i, s := range t.AttestorSets {
+		if s.Name == "" {
+			return fmt.Errorf(".attestorSets[%d]: name required", i)
+		}
+		if _, dup := sets[s.Name]; dup {
+			return fmt.Errorf(".attestorSets[%s]: declared twice", s.Name)
+		}
+		sets[s.Name] = struct{}{}
+		if len(s.Attestors) == 0 {
+			return fmt.Errorf(".attestorSets[%s]: no attestors", s.Name)
+		}
+		if s.Threshold == 0 || int(s.Threshold) > len(s.Attestors) {
+			return fmt.Errorf(".attestorSets[%s]: threshold %d out of range [1, %d]",
+				s.Name, s.Threshold, len(s.Attestors))
+		}
+	}
+
+	aliases := make(map[string]struct{}, len(t.Connections))
+	for i, conn := range t.Connections {
+		for _, end := range []End{conn.A, conn.B} {
+			if _, ok := chains[end.ChainID]; !ok {
+				return fmt.Errorf(".connections[%d]: chain %q not declared in .chains", i, end.ChainID)
+			}
+			if end.ClientID != "" && !deploy.ValidClientID(end.ClientID) {
+				return fmt.Errorf(".connections[%d]: invalid client id %q", i, end.ClientID)
+			}
+			if end.Signer == "" {
+				return fmt.Errorf(".connections[%d]: signer for chain %s required", i, end.ChainID)
+			}
+			if _, ok := sets[end.AttestorSet]; end.AttestorSet != "" && !ok {
+				return fmt.Errorf(".connections[%d]: attestor set %q not declared in .attestorSets", i, end.AttestorSet)
+			}
+		}
+		if conn.A.ChainID == conn.B.ChainID {
+			return fmt.Errorf(".connections[%d]: both ends on chain %s", i, conn.A.ChainID)
+		}
+		alias := conn.Name()
+		if _, dup := aliases[alias]; dup {
+			return fmt.Errorf(".connections[%d]: alias %q used twice; set a distinct alias", i, alias)
+		}
+		aliases[alias] = struct{}{}
+	}
+	return nil
+}
+
+// Name is the connection's alias, "<a>-<b>" when unset.
+func (c Connection) Name() string {
+	if c.Alias != "" {
+		return c.Alias
+	}
+	return c.A.ChainID + "-" + c.B.ChainID
+}
+
+// AttestorSet looks up a set by name.
+func (t Topology) AttestorSet(name string) (AttestorSet, bool) {
+	for _, s := range t.AttestorSets {
+		if s.Name == name {
+			return s, true
+		}
+	}
+	return AttestorSet{}, false
+}
@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package topology
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const mesh = `
+chains:
+  - chainId: "1"
+    apps:
+      gmp: true
+      transfer: true
+      ift:
+        - name: Foo
+          symbol: FOO
+  - chainId: "2"
+attestorSets:
+  - name: watching-1
+    attestors: [att-1a, att-1b, "${THIRD_ATTESTOR}"]
+    threshold: 2
+connections:
+  - a:
+      chainId: "1"
+      signer: relayer-1
+      attestorSet: watching-1
+    b:
+      chainId: "2"
+      clientId: custom-b
+      signer: relayer-2
+`
+
+func TestLoad(t *testing.T) {
+	t.Setenv("THIRD_ATTESTOR", "0xc")
+	path := filepath.Join(t.TempDir(), "topology.yml")
+	require.NoError(t, os.WriteFile(path, []byte(mesh), 0o600))
+
+	topo, err := Load(path)
+	require.NoError(t, err)
+	require.Len(t, topo.Chains, 2)
+	require.True(t, topo.Chains[0].Apps.GMP)
+	require.True(t, topo.Chains[0].Apps.Transfer)
+	require.Equal(t, []Token{{Name: "Foo", Symbol: "FOO"}}, topo.Chains[0].Apps.IFT)
+
+	set, ok := topo.AttestorSet("watching-1")
+	require.True(t, ok)
+	require.Equal(t, []string{"att-1a", "att-1b", "0xc"}, set.Attestors)
+	require.EqualValues(t, 2, set.Threshold)
+
+	require.Len(t, topo.Connections, 1)
+	require.Equal(t, "1-2", topo.Connections[0].Name())
+	require.Equal(t, "custom-b", topo.Connections[0].B.ClientID)
+
+	// unknown fields are rejected, not ignored
+	require.NoError(t, os.WriteFile(path, []byte("chains:\n  - chainID: \"1\"\n"), 0o600))
+	_, err = Load(path)
+	require.Error(t, err)
+}
+
+func TestValidate(t *testing.T) {
+	valid := func() Topology {
+		return Topology{
+			Chains:       []Chain{{ChainID: "1"}, {ChainID: "2"}},
+			AttestorSets: []AttestorSet{{Name: "s", Attestors: []string{"0xa", "0xb"}, Threshold: 2}},
+			Connections: []Connection{{
+				A: End{ChainID: "1", Signer: "r1", AttestorSet: "s"},
+				B: End{ChainID: "2", Signer: "r2"},
+			}},
+		}
+	}
+	require.NoError(t, valid().Validate())
+
+	for name, tc := range map[string]struct {
+		mutate func(*Topology)
+		err    string
+	}{
+		"no chains": {
+			func(t *Topology) { t.Chains = nil }, "no chains declared",
+		},
+		"duplicate chain": {
+			func(t *Topology) { t.Chains = append(t.Chains, Chain{ChainID: "1"}) }, "declared twice",
+		},
+		"ift without gmp": {
+			func(t *Topology) { t.Chains[0].Apps.IFT = []Token{{Name: "Foo", Symbol: "FOO"}} }, "require gmp",
+		},
+		"threshold above set size": {
+			func(t *Topology) { t.AttestorSets[0].Threshold = 3 }, "out of range",
+		},
+		"undeclared chain": {
+			func(t *Topology) { t.Connections[0].B.ChainID = "9" }, `chain "9" not declared`,
+		},
+		"same chain": {
+			func(t *Topology) { t.Connections[0].B.ChainID = "1" }, "both ends on chain 1",
+		},
+		"missing signer": {
+			func(t *Topology) { t.Connections[0].B.Signer = "" }, "signer for chain 2 required",
+		},
+		"invalid client id": {
+			func(t *Topology) { t.Connections[0].A.ClientID = "client-0" }, "invalid client id",
+		},
+		"undeclared attestor set": {
+			func(t *Topology) { t.Connections[0].B.AttestorSet = "other" }, `attestor set "other" not declared`,
+		},
+		"duplicate alias": {
+			func(t *Topology) { t.Connections = append(t.Connections, t.Connections[0]) }, `alias "1-2" used twice`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			topo := valid()
+			tc.mutate(&topo)
+			require.ErrorContains(t, topo.Validate(), tc.err)
+		})
+	}
+}
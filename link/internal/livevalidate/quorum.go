@@ -14,11 +14,11 @@ import (
 	"github.com/cosmos/ibc/link/internal/service/signer"
 )
 
-// checkAttestorQuorum resolves every configured attestor (local and remote)
+// CheckAttestorQuorum resolves every configured attestor (local and remote)
 // and confirms every attestation-type client end of every configured
 // connection can currently satisfy its attestor quorum against on-chain
 // state.
-func checkAttestorQuorum(ctx context.Context, cfg config.Config, clientSet *chains.ClientSet) error {
+func CheckAttestorQuorum(ctx context.Context, cfg config.Config, clientSet *chains.ClientSet) error {
 	signers, err := signer.NewSetFromConfig(ctx, cfg.Signers)
 	if err != nil {
 		return errors.Wrap(err, "signers")
@@ -33,7 +33,7 @@ func checkAttestorQuorum(ctx context.Context, cfg config.Config, clientSet *chai
 	attestors = append(attestors, local...)
 	attestors = append(attestors, remote...)
 
-	if _, err := proofgen.NewSetFromConfig(ctx, cfg, clientSet, attestors); err != nil {
+	if _, err := proofgen.NewSetFromConfig(ctx, cfg, clientSet, attestors, nil); err != nil {
 		return errors.Wrap(err, "attestor quorum")
 	}
 
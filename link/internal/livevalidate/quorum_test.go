@@ -94,7 +94,7 @@ func TestCheckAttestorQuorum(t *testing.T) {
 			},
 		}
 
-		require.NoError(t, checkAttestorQuorum(ctx, cfg, clientSet))
+		require.NoError(t, CheckAttestorQuorum(ctx, cfg, clientSet))
 	})
 
 	t.Run("insufficientMatchingAttestorsErrors", func(t *testing.T) {
@@ -125,7 +125,7 @@ func TestCheckAttestorQuorum(t *testing.T) {
 			},
 		}
 
-		err := checkAttestorQuorum(ctx, cfg, clientSet)
+		err := CheckAttestorQuorum(ctx, cfg, clientSet)
 
 		require.ErrorContains(t, err, `only 0 reachable/matching attestors for chain "8453"`)
 		require.ErrorContains(t, err, "on-chain quorum requires 2")
@@ -138,7 +138,7 @@ func TestCheckAttestorQuorum(t *testing.T) {
 
 		cfg := config.Config{Relayer: config.RelayerConfig{Connections: []config.ConnectionConfig{conn}}}
 
-		err := checkAttestorQuorum(ctx, cfg, chains.NewClientSet(nil))
+		err := CheckAttestorQuorum(ctx, cfg, chains.NewClientSet(nil))
 		require.ErrorContains(t, err, `unsupported client type "tendermint"`)
 	})
 }
@@ -0,0 +1,247 @@
+// SPDX-License-Identifier: Apache-2.0
+
+// Package hd derives signing keys from a BIP-39 mnemonic: secp256k1 keys
+// along BIP-32/BIP-44 paths and ed25519 keys along SLIP-10 paths.
+package hd
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/go-bip39"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/pkg/errors"
+
+	"github.com/cosmos/ibc/link/keyfile"
+)
+
+const (
+	// DefaultECDSAPath is the BIP-44 Ethereum account path; the address
+	// index is appended.
+	DefaultECDSAPath = "m/44'/60'/0'/0"
+	// DefaultEDDSAPath is DefaultECDSAPath with every level hardened, as
+	// SLIP-10 ed25519 only supports hardened derivation.
+	DefaultEDDSAPath = "m/44'/60'/0'/0'"
+
+	// HardenedOffset marks a hardened path segment.
+	HardenedOffset uint32 = 1 << 31
+
+	// mnemonicEntropyBits yields a 24-word mnemonic.
+	mnemonicEntropyBits = 256
+)
+
+var (
+	curveSecp256k1 = []byte("Bitcoin seed")
+	curveEd25519   = []byte("ed25519 seed")
+)
+
+// NewMnemonic generates a random 24-word BIP-39 mnemonic.
+func NewMnemonic() (string, error) {
+	entropy, err := bip39.NewEntropy(mnemonicEntropyBits)
+	if err != nil {
+		return "", errors.Wrap(err, "generate entropy")
+	}
+
+	return bip39.NewMnemonic(entropy)
+}
+
+// DefaultPath returns the account path used for keyType when none is
+// configured.
+func DefaultPath(keyType keyfile.Type) string {
+	if keyType == keyfile.EDDSA {
+		return DefaultEDDSAPath
+	}
+
+	return DefaultECDSAPath
+}
+
+// ParsePath parses a derivation path such as m/44'/60'/0'/0. Hardened
+// segments end in ' or h.
+func ParsePath(path string) ([]uint32, error) {
+	segments := strings.Split(strings.TrimSpace(path), "/")
+	if segments[0] != "m" {
+		return nil, errors.Errorf("derivation path must start with m/: %q", path)
+	}
+
+	indexes := make([]uint32, 0, len(segments)-1)
+
+	for _, segment := range segments[1:] {
+		hardened := strings.HasSuffix(segment, "'") || strings.HasSuffix(segment, "h")
+		if hardened {
+			segment = segment[:len(segment)-1]
+		}
+
+		index, err := strconv.ParseUint(segment, 10, 32)
+		if err != nil || uint32(index) >= HardenedOffset {
+			return nil, errors.Errorf("invalid derivation path segment %q in %q", segment, path)
+		}
+
+		if hardened {
+			index += uint64(HardenedOffset)
+		}
+
+		indexes = append(indexes, uint32(index))
+	}
+
+	return indexes, nil
+}
+
+// NotHardened reports whether a path segment is not hardened.
+func NotHardened(index uint32) bool {
+	return index < HardenedOffset
+}
+
+// FormatPath renders indexes as a derivation path.
+func FormatPath(indexes []uint32) string {
+	var b strings.Builder
+	b.WriteString("m")
+
+	for _, index := range indexes {
+		if index >= HardenedOffset {
+			fmt.Fprintf(&b, "/%d'", index-HardenedOffset)
+			continue
+		}
+
+		fmt.Fprintf(&b, "/%d", index)
+	}
+
+	return b.String()
+}
+
+// Derive returns the keyType private key at accountPath/index for mnemonic,
+// in the encoding keyfile stores: a 32-byte secp256k1 scalar or a 64-byte
+// ed25519 private key. The index is hardened for ed25519.
+func Derive(keyType keyfile.Type, mnemonic, accountPath string, index uint32) ([]byte, string, error) {
+	if index >= HardenedOffset {
+		return nil, "", errors.Errorf("index must be below %d", HardenedOffset)
+	}
+
+	path, err := ParsePath(accountPath)
+	if err != nil {
+		return nil, "", err
+	}
+
+	seed, err := bip39.NewSeedWithErrorChecking(normalizeMnemonic(mnemonic), "")
+	if err != nil {
+		return nil, "", errors.Wrap(err, "invalid mnemonic")
+	}
+
+	switch keyType {
+	case keyfile.ECDSA:
+		path = append(path, index)
+
+		key, err := deriveSecp256k1(seed, path)
+
+		return key, FormatPath(path), err
+	case keyfile.EDDSA:
+		path = append(path, index+HardenedOffset)
+
+		key, err := deriveEd25519(seed, path)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return ed25519.NewKeyFromSeed(key), FormatPath(path), nil
+	default:
+		return nil, "", errors.Errorf("invalid key type: %s", keyType)
+	}
+}
+
+// ValidateMnemonic reports whether mnemonic is a valid BIP-39 mnemonic.
+func ValidateMnemonic(mnemonic string) error {
+	if _, err := bip39.MnemonicToByteArray(normalizeMnemonic(mnemonic)); err != nil {
+		return errors.Wrap(err, "invalid mnemonic")
+	}
+
+	return nil
+}
+
+// normalizeMnemonic collapses the whitespace a mnemonic picks up from files
+// and prompts.
+func normalizeMnemonic(mnemonic string) string {
+	return strings.Join(strings.Fields(mnemonic), " ")
+}
+
+// deriveSecp256k1 walks path from seed per BIP-32.
+func deriveSecp256k1(seed []byte, path []uint32) ([]byte, error) {
+	key, chainCode := split(hmacSHA512(curveSecp256k1, seed))
+	if err := validSecp256k1(key); err != nil {
+		return nil, err
+	}
+
+	for _, index := range path {
+		data := make([]byte, 0, 37)
+		if index >= HardenedOffset {
+			data = append(data, 0)
+			data = append(data, key...)
+		} else {
+			data = append(data, secp256k1.PrivKeyFromBytes(key).PubKey().SerializeCompressed()...)
+		}
+		data = binary.BigEndian.AppendUint32(data, index)
+
+		tweak, nextChainCode := split(hmacSHA512(chainCode, data))
+		if err := validSecp256k1(tweak); err != nil {
+			return nil, err
+		}
+
+		var child, parent secp256k1.ModNScalar
+		child.SetByteSlice(tweak)
+		parent.SetByteSlice(key)
+		child.Add(&parent)
+
+		if child.IsZero() {
+			return nil, errors.Errorf("derived key at index %d is invalid, use another index", index)
+		}
+
+		childKey := child.Bytes()
+		key, chainCode = childKey[:], nextChainCode
+	}
+
+	return key, nil
+}
+
+// deriveEd25519 walks path from seed per SLIP-10; every segment must be
+// hardened.
+func deriveEd25519(seed []byte, path []uint32) ([]byte, error) {
+	key, chainCode := split(hmacSHA512(curveEd25519, seed))
+
+	for _, index := range path {
+		if NotHardened(index) {
+			return nil, errors.Errorf("ed25519 derivation requires hardened segments, got %s", FormatPath(path))
+		}
+
+		data := make([]byte, 0, 37)
+		data = append(data, 0)
+		data = append(data, key...)
+		data = binary.BigEndian.AppendUint32(data, index)
+
+		key, chainCode = split(hmacSHA512(chainCode, data))
+	}
+
+	return key, nil
+}
+
+func validSecp256k1(key []byte) error {
+	var scalar secp256k1.ModNScalar
+	if overflow := scalar.SetByteSlice(key); overflow || scalar.IsZero() {
+		return errors.New("derived key is invalid, use another index")
+	}
+
+	return nil
+}
+
+func hmacSHA512(key, data []byte) []byte {
+	mac := hmac.New(sha512.New, key)
+	mac.Write(data)
+
+	return mac.Sum(nil)
+}
+
+func split(digest []byte) ([]byte, []byte) {
+	return digest[:32], digest[32:]
+}
@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package hd
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc/link/keyfile"
+)
+
+// testMnemonic is the Hardhat/Anvil development mnemonic.
+const testMnemonic = "test test test test test test test test test test test junk"
+
+func TestDeriveECDSA(t *testing.T) {
+	for index, want := range []string{
+		"ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80",
+		"59c6995e998f97a5a0044966f0945389dc9e86dae88c7a8412f4603b6b78690d",
+	} {
+		key, path, err := Derive(keyfile.ECDSA, testMnemonic, DefaultECDSAPath, uint32(index))
+		require.NoError(t, err)
+		assert.Equal(t, want, hex.EncodeToString(key))
+		assert.Equal(t, fmt.Sprintf("%s/%d", DefaultECDSAPath, index), path)
+	}
+}
+
+func TestDeriveEDDSA(t *testing.T) {
+	key, path, err := Derive(keyfile.EDDSA, testMnemonic, DefaultEDDSAPath, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "m/44'/60'/0'/0'/0'", path)
+	assert.Len(t, key, ed25519.PrivateKeySize)
+
+	other, _, err := Derive(keyfile.EDDSA, testMnemonic, DefaultEDDSAPath, 1)
+	require.NoError(t, err)
+	assert.NotEqual(t, key, other)
+
+	_, _, err = Derive(keyfile.EDDSA, testMnemonic, DefaultECDSAPath, 0)
+	require.ErrorContains(t, err, "requires hardened segments")
+}
+
+func TestDeriveRejectsInvalidInput(t *testing.T) {
+	_, _, err := Derive(keyfile.ECDSA, "test test test", DefaultECDSAPath, 0)
+	require.ErrorContains(t, err, "invalid mnemonic")
+
+	_, _, err = Derive(keyfile.ECDSA, testMnemonic, "44'/60'", 0)
+	require.ErrorContains(t, err, "must start with m/")
+
+	_, _, err = Derive(keyfile.ECDSA, testMnemonic, DefaultECDSAPath, HardenedOffset)
+	require.ErrorContains(t, err, "index must be below")
+}
+
+func TestParsePath(t *testing.T) {
+	path, err := ParsePath("m/44'/60h/0'/0")
+	require.NoError(t, err)
+	assert.Equal(t, []uint32{44 + HardenedOffset, 60 + HardenedOffset, HardenedOffset, 0}, path)
+	assert.Equal(t, "m/44'/60'/0'/0", FormatPath(path))
+
+	for _, invalid := range []string{"", "m/x", "m/44''", "m/2147483648"} {
+		_, err := ParsePath(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+// BIP-32 and SLIP-10 test vector 1 share the seed 000102...0f.
+func TestDerivationVectors(t *testing.T) {
+	seed, err := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	require.NoError(t, err)
+
+	path, err := ParsePath("m/0'/1/2'/2/1000000000")
+	require.NoError(t, err)
+
+	key, err := deriveSecp256k1(seed, path)
+	require.NoError(t, err)
+	assert.Equal(t, "471b76e389e528d6de6d816857e012c5455051cad6660850e58372a6c3e6e7c8", hex.EncodeToString(key))
+
+	path, err = ParsePath("m/0'")
+	require.NoError(t, err)
+
+	key, err = deriveEd25519(seed, path)
+	require.NoError(t, err)
+	assert.Equal(t, "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3", hex.EncodeToString(key))
+}
+
+func TestNewMnemonic(t *testing.T) {
+	mnemonic, err := NewMnemonic()
+	require.NoError(t, err)
+	require.NoError(t, ValidateMnemonic(mnemonic))
+	assert.Len(t, strings.Fields(mnemonic), 24)
+}
@@ -37,13 +37,13 @@ func routedConfig() config.Config {
 					ClientA: config.ClientEnd{
 						ChainID:  testRoute.SourceChainID,
 						ClientID: testRoute.SourceClientID,
-						Signer:   "test-signer",
+						Signer:   config.SignerAliases{"test-signer"},
 						Type:     config.ClientTypeAttestation,
 					},
 					ClientB: config.ClientEnd{
 						ChainID:  testRoute.DestinationChainID,
 						ClientID: testRoute.DestinationClientID,
-						Signer:   "test-signer",
+						Signer:   config.SignerAliases{"test-signer"},
 						Type:     config.ClientTypeAttestation,
 					},
 				},
@@ -19,7 +19,8 @@ const (
 // Options the per-route pipeline settings.
 type Options struct {
 	// SourceSignerAlias and DestSignerAlias select the tx submitters used to
-	// submit on the route's source and destination chains.
+	// submit on the route's source and destination chains: a signer alias,
+	// or a signer pool's comma-joined aliases.
 	SourceSignerAlias string
 	DestSignerAlias   string
 
@@ -45,8 +46,8 @@ func OptionsFromConfig(cfg config.Config, route processors.Route) Options {
 	}
 
 	if sourceEnd, destEnd, ok := cfg.Relayer.ClientEnd(route.SourceChainID, route.SourceClientID); ok {
-		opts.SourceSignerAlias = sourceEnd.Signer
-		opts.DestSignerAlias = destEnd.Signer
+		opts.SourceSignerAlias = sourceEnd.Signer.String()
+		opts.DestSignerAlias = destEnd.Signer.String()
 	}
 
 	if src, ok := cfg.Relayer.ChainOverride(route.SourceChainID); ok {
@@ -130,9 +130,9 @@ func mockRelay(
 
 	client.EXPECT().TxPacketEvents(mock.Anything, mock.Anything).Return(events, nil).Once()
 	proofGen.EXPECT().LatestProvableHeight(mock.Anything).Return(height, time.Now(), nil).Once()
-	proofGen.EXPECT().StateProof(mock.Anything, height).Return([]byte{0x01}, nil).Once()
-	proofGen.EXPECT().PacketProofs(mock.Anything, height, mock.Anything, mock.Anything).
-		Return(make([][]byte, len(events)), nil).Once()
+	proofGen.EXPECT().BatchProofs(mock.Anything, height, mock.Anything, mock.Anything).
+		Return(v2.BatchProof{Height: height, StateProof: []byte{0x01}, PacketProofs: make([][]byte, len(events))}, nil).
+		Once()
 	txBuilder.EXPECT().BuildRelayTxs(mock.Anything, mock.Anything).
 		Return([]v2.RelayTx{{To: common.HexToAddress(to).Bytes(), Data: []byte{0xca, 0x11}}}, nil).Once()
 }
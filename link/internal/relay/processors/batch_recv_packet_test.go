@@ -103,9 +103,8 @@ func TestBatchRecvPacketSequenceAlignment(t *testing.T) {
 
 	proofGen := mocks.NewMockProofGenerator(t)
 	proofGen.EXPECT().LatestProvableHeight(mock.Anything).Return(uint64(100), time.Time{}, nil)
-	proofGen.EXPECT().StateProof(mock.Anything, uint64(100)).Return([]byte{0x01}, nil)
-	proofGen.EXPECT().PacketProofs(mock.Anything, uint64(100), v2.ProofKindPacketCommitment, mock.Anything).
-		Return([][]byte{{0x02}}, nil)
+	proofGen.EXPECT().BatchProofs(mock.Anything, uint64(100), v2.ProofKindPacketCommitment, mock.Anything).
+		Return(v2.BatchProof{Height: 100, StateProof: []byte{0x01}, PacketProofs: [][]byte{{0x02}}}, nil)
 
 	txBuilder := mocks.NewMockTxBuilder(t)
 	txBuilder.EXPECT().BuildRelayTxs(mock.Anything, mock.Anything).
@@ -200,9 +199,8 @@ func TestBatchRecvPacketToleratesPartialEventFetchFailure(t *testing.T) {
 
 	proofGen := mocks.NewMockProofGenerator(t)
 	proofGen.EXPECT().LatestProvableHeight(mock.Anything).Return(uint64(100), time.Time{}, nil)
-	proofGen.EXPECT().StateProof(mock.Anything, uint64(100)).Return([]byte{0x01}, nil)
-	proofGen.EXPECT().PacketProofs(mock.Anything, uint64(100), v2.ProofKindPacketCommitment, mock.Anything).
-		Return([][]byte{{0x02}}, nil)
+	proofGen.EXPECT().BatchProofs(mock.Anything, uint64(100), v2.ProofKindPacketCommitment, mock.Anything).
+		Return(v2.BatchProof{Height: 100, StateProof: []byte{0x01}, PacketProofs: [][]byte{{0x02}}}, nil)
 
 	txBuilder := mocks.NewMockTxBuilder(t)
 	txBuilder.EXPECT().BuildRelayTxs(mock.Anything, mock.Anything).
@@ -305,9 +303,8 @@ func TestBatchRecvPacketExcludesNotYetProvablePackets(t *testing.T) {
 
 	proofGen := mocks.NewMockProofGenerator(t)
 	proofGen.EXPECT().LatestProvableHeight(mock.Anything).Return(uint64(100), time.Time{}, nil)
-	proofGen.EXPECT().StateProof(mock.Anything, uint64(100)).Return([]byte{0x01}, nil)
-	proofGen.EXPECT().PacketProofs(mock.Anything, uint64(100), v2.ProofKindPacketCommitment, mock.Anything).
-		Return([][]byte{{0x02}}, nil)
+	proofGen.EXPECT().BatchProofs(mock.Anything, uint64(100), v2.ProofKindPacketCommitment, mock.Anything).
+		Return(v2.BatchProof{Height: 100, StateProof: []byte{0x01}, PacketProofs: [][]byte{{0x02}}}, nil)
 
 	txBuilder := mocks.NewMockTxBuilder(t)
 	txBuilder.EXPECT().BuildRelayTxs(mock.Anything, mock.Anything).
@@ -66,7 +66,7 @@ func proofKindFor(relayKind v2.RelayKind) v2.ProofKind {
 }
 
 // relayPackets generates a state proof and per-packet proofs for events at
-// proofHeight, asks txBuilder for the resulting transaction, and submits it
+// proofHeight in one batch, asks txBuilder for the resulting transaction, and submits it
 // via txSubmitter
 func relayPackets(
 	ctx context.Context,
@@ -79,19 +79,14 @@ func relayPackets(
 	proofHeight uint64,
 	events []v2.PacketEvent,
 ) (*v2.Submission, error) {
-	stateProof, err := proofGen.StateProof(ctx, proofHeight)
-	if err != nil {
-		return nil, errors.Wrap(err, "generating state proof")
-	}
-
 	packets := make([]channeltypesv2.Packet, len(events))
 	for i, event := range events {
 		packets[i] = event.Packet
 	}
 
-	packetProofs, err := proofGen.PacketProofs(ctx, proofHeight, proofKindFor(relayKind), packets)
+	proofs, err := proofGen.BatchProofs(ctx, proofHeight, proofKindFor(relayKind), packets)
 	if err != nil {
-		return nil, errors.Wrap(err, "generating packet proofs")
+		return nil, errors.Wrap(err, "generating proofs")
 	}
 
 	items := make([]v2.PacketRelayItem, len(events))
@@ -100,14 +95,14 @@ func relayPackets(
 			Kind:        relayKind,
 			Packet:      event.Packet,
 			Acks:        event.Acks,
-			Proof:       packetProofs[i],
+			Proof:       proofs.PacketProofs[i],
 			ProofHeight: proofHeight,
 		}
 	}
 
 	relayTxs, err := txBuilder.BuildRelayTxs(v2.ClientUpdate{
 		ClientID:   clientID,
-		StateProof: stateProof,
+		StateProof: proofs.StateProof,
 	}, items)
 	if err != nil {
 		return nil, errors.Wrap(err, "building relay tx")
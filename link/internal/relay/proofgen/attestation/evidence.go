@@ -0,0 +1,182 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package attestation
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cosmos/ibc/link/internal/service/attestor"
+	"github.com/cosmos/ibc/link/internal/store"
+)
+
+const (
+	// evidenceRefreshInterval how often a Ledger re-reads which attestors
+	// have uncleared evidence, picking up evidence cleared by an operator.
+	evidenceRefreshInterval = 30 * time.Second
+	evidenceWriteTimeout    = 5 * time.Second
+)
+
+// EvidenceStore persists attestor misbehaviour evidence.
+type EvidenceStore interface {
+	CreateAttestorEvidence(ctx context.Context, input store.CreateAttestorEvidence) error
+	ListAttestorEvidence(ctx context.Context, includeCleared bool) ([]store.AttestorEvidence, error)
+}
+
+// Ledger records attestor misbehaviour evidence and tracks which attestors
+// have uncleared evidence against them. One Ledger is shared by every
+// generator, so evidence collected for one client counts against the
+// attestor everywhere. A nil *Ledger records nothing and accuses no one.
+type Ledger struct {
+	store  EvidenceStore
+	logger *slog.Logger
+
+	mu sync.Mutex
+	// accused keyed by lowercased address
+	accused  map[string]struct{}
+	loadedAt time.Time
+}
+
+// NewLedger returns a Ledger persisting to st; a nil st returns nil.
+func NewLedger(st EvidenceStore) *Ledger {
+	if st == nil {
+		return nil
+	}
+
+	return &Ledger{
+		store:   st,
+		logger:  slog.With("module", "proofgen"),
+		accused: make(map[string]struct{}),
+	}
+}
+
+// record persists evidence. Failing to persist it is logged rather than
+// failing the proof the quorum was collected for.
+func (l *Ledger) record(ctx context.Context, evidence store.CreateAttestorEvidence) {
+	if l == nil {
+		return
+	}
+
+	l.logger.Warn(
+		"Attestor signed an attestation conflicting with the quorum",
+		"attestor", evidence.AttestorName,
+		"address", evidence.AttestorAddress,
+		"chainID", evidence.ChainID,
+		"kind", evidence.Kind,
+		"height", evidence.Height,
+	)
+
+	// the quorum's ctx may be cancelled right after it returns
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), evidenceWriteTimeout)
+	defer cancel()
+
+	if err := l.store.CreateAttestorEvidence(ctx, evidence); err != nil {
+		l.logger.Error("Unable to record attestor misbehaviour evidence", "attestor", evidence.AttestorName, "err", err)
+		return
+	}
+
+	l.mu.Lock()
+	l.accused[strings.ToLower(evidence.AttestorAddress)] = struct{}{}
+	l.mu.Unlock()
+}
+
+// misbehaving reports whether address has uncleared evidence against it, as
+// of the last refresh. A failed refresh keeps the previous view.
+func (l *Ledger) misbehaving(ctx context.Context, address string) bool {
+	if l == nil {
+		return false
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if time.Since(l.loadedAt) >= evidenceRefreshInterval {
+		l.refresh(ctx)
+	}
+
+	_, ok := l.accused[strings.ToLower(address)]
+
+	return ok
+}
+
+// refresh reloads the accused attestors from the store; mu must be held.
+func (l *Ledger) refresh(ctx context.Context) {
+	evidence, err := l.store.ListAttestorEvidence(ctx, false)
+	if err != nil {
+		l.logger.Error("Unable to load attestor misbehaviour evidence", "err", err)
+		return
+	}
+
+	accused := make(map[string]struct{}, len(evidence))
+	for _, e := range evidence {
+		accused[strings.ToLower(e.AttestorAddress)] = struct{}{}
+	}
+
+	l.accused = accused
+	l.loadedAt = time.Now()
+}
+
+// conflictDecoder extracts the attested height from an attestation's data.
+type conflictDecoder func(data []byte) (uint64, error)
+
+// stateHeight decodes scheme's state attestations.
+func stateHeight(scheme attestor.Scheme) conflictDecoder {
+	return func(data []byte) (uint64, error) {
+		height, _, err := scheme.DecodeState(data)
+		return height, err
+	}
+}
+
+// packetHeight decodes scheme's packet attestations.
+func packetHeight(scheme attestor.Scheme) conflictDecoder {
+	return func(data []byte) (uint64, error) {
+		height, _, err := scheme.DecodePackets(data)
+		return height, err
+	}
+}
+
+// conflictEvidence turns result's conflicting responses into evidence. Only
+// attestations for the quorum's own height count: attestors asked to pick a
+// height may legitimately attest to different ones.
+func conflictEvidence(
+	chainID string,
+	kind store.EvidenceKind,
+	decode conflictDecoder,
+	result quorumResult,
+) []store.CreateAttestorEvidence {
+	if len(result.Conflicts) == 0 {
+		return nil
+	}
+
+	height, err := decode(result.AttestationData)
+	if err != nil {
+		return nil
+	}
+
+	var evidence []store.CreateAttestorEvidence
+
+	for _, conflict := range result.Conflicts {
+		conflictHeight, err := decode(conflict.data)
+		if err != nil || conflictHeight != height || bytes.Equal(conflict.data, result.AttestationData) {
+			continue
+		}
+
+		evidence = append(evidence, store.CreateAttestorEvidence{
+			ChainID:         chainID,
+			AttestorName:    conflict.name,
+			AttestorAddress: conflict.signer,
+			Kind:            kind,
+			Height:          height,
+			Data:            conflict.data,
+			Signature:       conflict.sig,
+			QuorumData:      result.AttestationData,
+			QuorumSignature: result.Signatures[0],
+		})
+	}
+
+	return evidence
+}
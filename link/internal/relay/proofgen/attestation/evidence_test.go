@@ -0,0 +1,172 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package attestation
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	attestorevm "github.com/cosmos/ibc/link/attestor/evm"
+	"github.com/cosmos/ibc/link/internal/config"
+	"github.com/cosmos/ibc/link/internal/service/attestor"
+	"github.com/cosmos/ibc/link/internal/store"
+	"github.com/cosmos/ibc/link/internal/tests/mocks"
+)
+
+// memoryEvidence an in-memory EvidenceStore.
+type memoryEvidence struct {
+	mu       sync.Mutex
+	evidence []store.AttestorEvidence
+}
+
+func (m *memoryEvidence) CreateAttestorEvidence(_ context.Context, input store.CreateAttestorEvidence) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.evidence = append(m.evidence, store.AttestorEvidence{
+		ChainID:         input.ChainID,
+		AttestorName:    input.AttestorName,
+		AttestorAddress: input.AttestorAddress,
+		Kind:            input.Kind,
+		Height:          input.Height,
+		Data:            input.Data,
+		Signature:       input.Signature,
+		QuorumData:      input.QuorumData,
+		QuorumSignature: input.QuorumSignature,
+	})
+
+	return nil
+}
+
+func (m *memoryEvidence) ListAttestorEvidence(context.Context, bool) ([]store.AttestorEvidence, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]store.AttestorEvidence(nil), m.evidence...), nil
+}
+
+func (m *memoryEvidence) list() []store.AttestorEvidence {
+	evidence, _ := m.ListAttestorEvidence(context.Background(), false)
+	return evidence
+}
+
+// timedStateAttestor builds a attestor.MockAttestor that answers
+// StateAttestation after delay with a validly-signed claim of timestamp at
+// height, returning it with its signing address.
+func timedStateAttestor(
+	t *testing.T,
+	name string,
+	height, timestamp uint64,
+	delay time.Duration,
+) (*attestor.MockAttestor, common.Address) {
+	t.Helper()
+
+	data, err := attestorevm.EncodeStateAttestation(height, timestamp)
+	require.NoError(t, err)
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	digest := attestorevm.Digest(attestorevm.TagStateAttestation, data)
+	sig, err := crypto.Sign(digest[:], key)
+	require.NoError(t, err)
+
+	address := crypto.PubkeyToAddress(key.PublicKey)
+
+	a := attestor.NewMockAttestor(t)
+	a.EXPECT().Name().Return(name).Maybe()
+	a.EXPECT().Address().Return(address.Hex()).Maybe()
+	a.EXPECT().StateAttestation(mock.Anything, mock.Anything).Return(
+		attestor.Attestation{Height: height, AttestedData: data, Signature: sig}, nil,
+	).After(delay).Maybe()
+
+	return a, address
+}
+
+func TestGeneratorMisbehaviourEvidence(t *testing.T) {
+	ctx := context.Background()
+
+	counterpartyChain := mocks.NewMockClient(t)
+	counterpartyChain.EXPECT().ChainID().Return("8453").Maybe()
+
+	// the liar answers first, so its conflicting claim is collected before
+	// the honest quorum forms
+	honest := func() []attestor.Attestor {
+		a1, _ := timedStateAttestor(t, "a1", 10, 1700000000, 20*time.Millisecond)
+		a2, _ := timedStateAttestor(t, "a2", 10, 1700000000, 20*time.Millisecond)
+
+		return []attestor.Attestor{a1, a2}
+	}
+
+	t.Run("recordsSameHeightConflict", func(t *testing.T) {
+		liar, liarAddress := timedStateAttestor(t, "liar", 10, 1800000000, 0)
+
+		evidence := &memoryEvidence{}
+		gen := New(append(honest(), liar), 2, counterpartyChain).withLedger(NewLedger(evidence))
+
+		_, err := gen.StateProof(ctx, 10)
+		require.NoError(t, err)
+
+		recorded := evidence.list()
+		require.Len(t, recorded, 1)
+
+		got := recorded[0]
+		assert.Equal(t, "8453", got.ChainID)
+		assert.Equal(t, "liar", got.AttestorName)
+		assert.Equal(t, liarAddress.Hex(), got.AttestorAddress)
+		assert.Equal(t, store.EvidenceKindState, got.Kind)
+		assert.EqualValues(t, 10, got.Height)
+		assert.NotEqual(t, got.QuorumData, got.Data)
+		assert.NotEmpty(t, got.Signature)
+		assert.NotEmpty(t, got.QuorumSignature)
+
+		assert.True(t, gen.ledger.misbehaving(ctx, liarAddress.Hex()))
+	})
+
+	t.Run("ignoresOtherHeights", func(t *testing.T) {
+		lagging, _ := timedStateAttestor(t, "lagging", 9, 1600000000, 0)
+
+		evidence := &memoryEvidence{}
+		gen := New(append(honest(), lagging), 2, counterpartyChain).withLedger(NewLedger(evidence))
+
+		_, err := gen.StateProof(ctx, 10)
+		require.NoError(t, err)
+		assert.Empty(t, evidence.list())
+	})
+
+	t.Run("excludesMisbehavingUntilCleared", func(t *testing.T) {
+		a1, _ := timedStateAttestor(t, "a1", 10, 1700000000, 0)
+		a2, _ := timedStateAttestor(t, "a2", 10, 1700000000, 0)
+		accused := unqueriedAttestor(t, "accused")
+		accusedAddress := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+		accused.EXPECT().Address().Return(accusedAddress.Hex())
+
+		evidence := &memoryEvidence{evidence: []store.AttestorEvidence{{AttestorAddress: accusedAddress.Hex()}}}
+		ledger := NewLedger(evidence)
+
+		gen := New([]attestor.Attestor{accused, a1, a2}, 2, counterpartyChain).
+			withQuorum(config.QuorumConfig{ExcludeMisbehaving: true}).
+			withLedger(ledger)
+
+		_, err := gen.StateProof(ctx, 10)
+		require.NoError(t, err)
+
+		gen.quorum.threshold = 3
+
+		_, err = gen.StateProof(ctx, 10)
+		require.ErrorContains(t, err, "only 2 of 3 attestors have no uncleared misbehaviour evidence")
+
+		// cleared evidence is picked up on the next refresh
+		evidence.evidence = nil
+		ledger.loadedAt = time.Time{}
+		assert.False(t, ledger.misbehaving(ctx, accusedAddress.Hex()))
+	})
+}
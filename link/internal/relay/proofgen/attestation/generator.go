@@ -9,37 +9,146 @@ import (
 	"github.com/pkg/errors"
 
 	channeltypesv2 "github.com/cosmos/ibc-go/v11/modules/core/04-channel/v2/types"
-	attestorevm "github.com/cosmos/ibc/link/attestor/evm"
 	"github.com/cosmos/ibc/link/attestor/evm/ibc"
 	"github.com/cosmos/ibc/link/internal/chains"
+	"github.com/cosmos/ibc/link/internal/config"
 	"github.com/cosmos/ibc/link/internal/service/attestor"
+	"github.com/cosmos/ibc/link/internal/store"
 	v2 "github.com/cosmos/ibc/link/internal/types/v2"
 )
 
 // Generator implements proofgen.ProofGenerator for one configured
-// attestation light client: LatestProvableHeight/StateProof/PacketProofs all
-// query the same fixed attestor set with the same quorum threshold
+// attestation light client: LatestProvableHeight/StateProof/PacketProofs/
+// BatchProofs all query the same fixed attestor set with the same quorum threshold
 type Generator struct {
 	attestors         []attestor.Attestor
-	threshold         int
+	quorum            quorumSpec
 	counterpartyChain chains.Client
+
+	// heights is fed by the attestors' height subscriptions, shared by every
+	// finality check going through this generator.
+	heights *heightView
+
+	// ledger receives misbehaviour evidence found while collecting quorums
+	// and, with quorum.excludeMisbehaving, vets the attestors queried.
+	ledger *Ledger
 }
 
 func New(attestors []attestor.Attestor, threshold int, counterpartyChain chains.Client) *Generator {
-	return &Generator{attestors: attestors, threshold: threshold, counterpartyChain: counterpartyChain}
+	return &Generator{
+		attestors:         attestors,
+		quorum:            quorumSpec{threshold: threshold, health: newHealthTracker()},
+		counterpartyChain: counterpartyChain,
+		heights:           newHeightView(),
+	}
+}
+
+// withQuorum applies cfg's settings to g's quorum queries.
+func (g *Generator) withQuorum(cfg config.QuorumConfig) *Generator {
+	if cfg.Hedge != nil {
+		g.quorum.hedged = true
+		g.quorum.hedge = *cfg.Hedge
+	}
+
+	if cfg.HedgeDelay != nil {
+		g.quorum.hedgeDelay = *cfg.HedgeDelay
+	}
+
+	g.quorum.excludeMisbehaving = cfg.ExcludeMisbehaving
+
+	return g
+}
+
+// withScheme has g verify attestations and encode proofs under scheme.
+func (g *Generator) withScheme(scheme attestor.Scheme) *Generator {
+	g.quorum.scheme = scheme
+	return g
 }
 
+// withLedger has g record misbehaviour evidence to ledger, which may be nil.
+func (g *Generator) withLedger(ledger *Ledger) *Generator {
+	g.ledger = ledger
+	return g
+}
+
+// LatestProvableHeight resolves the latest height a quorum can attest to.
+// The first call starts the attestors' height subscriptions, which outlive
+// ctx's cancellation; later calls read subscribed heights from memory.
 func (g *Generator) LatestProvableHeight(ctx context.Context) (uint64, time.Time, error) {
-	return latestProvableHeight(ctx, g.attestors, g.threshold, g.counterpartyChain)
+	g.heights.start(context.WithoutCancel(ctx), g.attestors)
+
+	attestors, err := g.quorumAttestors(ctx)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+
+	return latestProvableHeight(ctx, attestors, g.quorum.threshold, g.counterpartyChain, g.heights)
 }
 
 func (g *Generator) StateProof(ctx context.Context, height uint64) ([]byte, error) {
-	result, err := queryStateQuorum(ctx, g.attestors, g.threshold, height)
+	attestors, err := g.quorumAttestors(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := queryStateQuorum(ctx, attestors, g.quorum, height)
 	if err != nil {
 		return nil, errors.Wrap(err, "querying state attestation quorum")
 	}
 
-	decodedHeight, _, err := attestorevm.DecodeStateAttestation(result.AttestationData)
+	scheme := g.quorum.attestationScheme()
+	g.recordConflicts(ctx, store.EvidenceKindState, stateHeight(scheme), result)
+
+	return stateProof(scheme, result, height)
+}
+
+// quorumAttestors returns the attestors quorums are collected from: every
+// attestor, or with excludeMisbehaving only those without uncleared
+// misbehaviour evidence, erroring if too few remain to meet threshold.
+func (g *Generator) quorumAttestors(ctx context.Context) ([]attestor.Attestor, error) {
+	if !g.quorum.excludeMisbehaving || g.ledger == nil {
+		return g.attestors, nil
+	}
+
+	eligible := make([]attestor.Attestor, 0, len(g.attestors))
+
+	for _, a := range g.attestors {
+		if !g.ledger.misbehaving(ctx, a.Address()) {
+			eligible = append(eligible, a)
+		}
+	}
+
+	if len(eligible) < g.quorum.threshold {
+		return nil, errors.Errorf(
+			"only %d of %d attestors have no uncleared misbehaviour evidence, quorum requires %d",
+			len(eligible), len(g.attestors), g.quorum.threshold,
+		)
+	}
+
+	return eligible, nil
+}
+
+// recordConflicts hands result's same-height conflicting attestations to
+// the ledger as evidence.
+func (g *Generator) recordConflicts(
+	ctx context.Context,
+	kind store.EvidenceKind,
+	decode conflictDecoder,
+	result quorumResult,
+) {
+	if g.ledger == nil {
+		return
+	}
+
+	for _, evidence := range conflictEvidence(g.counterpartyChain.ChainID(), kind, decode, result) {
+		g.ledger.record(ctx, evidence)
+	}
+}
+
+// stateProof encodes a state attestation quorum result as a proof in scheme,
+// checking it attests to height.
+func stateProof(scheme attestor.Scheme, result quorumResult, height uint64) ([]byte, error) {
+	decodedHeight, _, err := scheme.DecodeState(result.AttestationData)
 	if err != nil {
 		return nil, errors.Wrap(err, "decoding state attestation quorum result")
 	}
@@ -52,7 +161,7 @@ func (g *Generator) StateProof(ctx context.Context, height uint64) ([]byte, erro
 		)
 	}
 
-	proof, err := attestorevm.EncodeAttestationProof(result.AttestationData, result.Signatures)
+	proof, err := scheme.EncodeProof(result.AttestationData, result.Signatures)
 	if err != nil {
 		return nil, errors.Wrap(err, "encoding state attestation proof")
 	}
@@ -71,23 +180,104 @@ func (g *Generator) PacketProofs(
 		return nil, err
 	}
 
+	encodedPackets, err := encodePackets(packets)
+	if err != nil {
+		return nil, err
+	}
+
+	attestors, err := g.quorumAttestors(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := queryPacketQuorum(ctx, attestors, g.quorum, encodedPackets, height, commitmentType)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying packet attestation quorum")
+	}
+
+	scheme := g.quorum.attestationScheme()
+	g.recordConflicts(ctx, store.EvidenceKindPacket, packetHeight(scheme), result)
+
+	return packetProofs(scheme, result, height, len(packets))
+}
+
+// BatchProofs returns StateProof and PacketProofs for the same height,
+// collecting both claims from each attestor in a single AttestBatch call.
+// With a zero height the attestors pick the height; the quorum then only
+// forms if threshold of them picked the same one.
+func (g *Generator) BatchProofs(
+	ctx context.Context,
+	height uint64,
+	kind v2.ProofKind,
+	packets []channeltypesv2.Packet,
+) (v2.BatchProof, error) {
+	commitmentType, err := commitmentTypeOf(kind)
+	if err != nil {
+		return v2.BatchProof{}, err
+	}
+
+	encodedPackets, err := encodePackets(packets)
+	if err != nil {
+		return v2.BatchProof{}, err
+	}
+
+	attestors, err := g.quorumAttestors(ctx)
+	if err != nil {
+		return v2.BatchProof{}, err
+	}
+
+	result, err := queryBatchQuorum(ctx, attestors, g.quorum, attestor.BatchAttestationRequest{
+		Height:         height,
+		Packets:        encodedPackets,
+		CommitmentType: commitmentType,
+	})
+	if err != nil {
+		return v2.BatchProof{}, errors.Wrap(err, "querying batch attestation quorum")
+	}
+
+	scheme := g.quorum.attestationScheme()
+	g.recordConflicts(ctx, store.EvidenceKindState, stateHeight(scheme), result.State)
+	g.recordConflicts(ctx, store.EvidenceKindPacket, packetHeight(scheme), result.Packets)
+
+	if height == 0 {
+		height, _, err = scheme.DecodeState(result.State.AttestationData)
+		if err != nil {
+			return v2.BatchProof{}, errors.Wrap(err, "decoding state attestation quorum result")
+		}
+	}
+
+	state, err := stateProof(scheme, result.State, height)
+	if err != nil {
+		return v2.BatchProof{}, err
+	}
+
+	proofs, err := packetProofs(scheme, result.Packets, height, len(packets))
+	if err != nil {
+		return v2.BatchProof{}, err
+	}
+
+	return v2.BatchProof{Height: height, StateProof: state, PacketProofs: proofs}, nil
+}
+
+func encodePackets(packets []channeltypesv2.Packet) ([][]byte, error) {
 	encodedPackets := make([][]byte, len(packets))
 
 	for i, packet := range packets {
-		encoded, errEnc := ibc.EncodePacket(packet)
-		if errEnc != nil {
-			return nil, errors.Wrapf(errEnc, "encoding packet sequence %d", packet.Sequence)
+		encoded, err := ibc.EncodePacket(packet)
+		if err != nil {
+			return nil, errors.Wrapf(err, "encoding packet sequence %d", packet.Sequence)
 		}
 
 		encodedPackets[i] = encoded
 	}
 
-	result, err := queryPacketQuorum(ctx, g.attestors, g.threshold, encodedPackets, height, commitmentType)
-	if err != nil {
-		return nil, errors.Wrap(err, "querying packet attestation quorum")
-	}
+	return encodedPackets, nil
+}
 
-	decodedHeight, decodedPackets, err := attestorevm.DecodePacketAttestation(result.AttestationData)
+// packetProofs encodes a packet attestation quorum result as count proofs in
+// scheme, checking it attests to count packets at height.
+func packetProofs(scheme attestor.Scheme, result quorumResult, height uint64, count int) ([][]byte, error) {
+	decodedHeight, decodedCount, err := scheme.DecodePackets(result.AttestationData)
 	if err != nil {
 		return nil, errors.Wrap(err, "decoding packet attestation quorum result")
 	}
@@ -100,23 +290,23 @@ func (g *Generator) PacketProofs(
 		)
 	}
 
-	if len(decodedPackets) != len(packets) {
+	if decodedCount != count {
 		return nil, errors.Errorf(
 			"packet attestation returned %d packets, expected %d",
-			len(decodedPackets),
-			len(packets),
+			decodedCount,
+			count,
 		)
 	}
 
-	proof, err := attestorevm.EncodeAttestationProof(result.AttestationData, result.Signatures)
+	proof, err := scheme.EncodeProof(result.AttestationData, result.Signatures)
 	if err != nil {
 		return nil, errors.Wrap(err, "encoding packet attestation proof")
 	}
 
 	// The attestor returns one shared proof blob covering every packet in the
 	// batch; PacketProofs' contract is one proof per input packet, so the same
-	// blob is returned len(packets) times.
-	proofs := make([][]byte, len(packets))
+	// blob is returned count times.
+	proofs := make([][]byte, count)
 	for i := range proofs {
 		proofs[i] = proof
 	}
@@ -35,6 +35,7 @@ func signedStateAttestor(t *testing.T, name string, height uint64) *attestor.Moc
 
 	a := attestor.NewMockAttestor(t)
 	a.EXPECT().Name().Return(name).Maybe()
+	a.EXPECT().Address().Return(crypto.PubkeyToAddress(key.PublicKey).Hex()).Maybe()
 	a.EXPECT().StateAttestation(mock.Anything, mock.Anything).Return(
 		attestor.Attestation{Height: height, AttestedData: data, Signature: sig}, nil,
 	)
@@ -65,6 +66,7 @@ func signedPacketAttestor(
 
 	a := attestor.NewMockAttestor(t)
 	a.EXPECT().Name().Return(name).Maybe()
+	a.EXPECT().Address().Return(crypto.PubkeyToAddress(key.PublicKey).Hex()).Maybe()
 	a.EXPECT().PacketAttestation(mock.Anything, mock.Anything).Return(
 		attestor.Attestation{Height: height, AttestedData: dataArgs, Signature: sig}, nil,
 	)
@@ -72,6 +74,46 @@ func signedPacketAttestor(
 	return a
 }
 
+// signedBatchAttestor builds a attestor.MockAttestor that answers
+// AttestBatch with a state claim and a packet claim at height, both signed by
+// the same key.
+func signedBatchAttestor(
+	t *testing.T,
+	name string,
+	height uint64,
+	packets []attestorevm.PacketCompact,
+) *attestor.MockAttestor {
+	t.Helper()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	sign := func(typeTag byte, data []byte) attestor.Attestation {
+		digest := attestorevm.Digest(typeTag, data)
+
+		sig, err := crypto.Sign(digest[:], key)
+		require.NoError(t, err)
+
+		return attestor.Attestation{Height: height, AttestedData: data, Signature: sig}
+	}
+
+	stateData, err := attestorevm.EncodeStateAttestation(height, 1700000000)
+	require.NoError(t, err)
+
+	packetData, err := attestorevm.EncodePacketAttestation(height, packets)
+	require.NoError(t, err)
+
+	a := attestor.NewMockAttestor(t)
+	a.EXPECT().Name().Return(name).Maybe()
+	a.EXPECT().Address().Return(crypto.PubkeyToAddress(key.PublicKey).Hex()).Maybe()
+	a.EXPECT().AttestBatch(mock.Anything, mock.Anything).Return(attestor.BatchAttestation{
+		State:   sign(attestorevm.TagStateAttestation, stateData),
+		Packets: sign(attestorevm.TagPacketAttestation, packetData),
+	}, nil)
+
+	return a
+}
+
 func TestGeneratorStateProof(t *testing.T) {
 	ctx := context.Background()
 
@@ -158,3 +200,87 @@ func TestGeneratorLatestProvableHeight(t *testing.T) {
 	require.Equal(t, uint64(90), height)
 	require.Equal(t, someBlockTime, timestamp)
 }
+
+func TestGeneratorBatchProofs(t *testing.T) {
+	ctx := context.Background()
+
+	packets := []channeltypesv2.Packet{
+		{Sequence: 1, SourceClient: "src-0", DestinationClient: "dst-0", TimeoutTimestamp: 1000},
+		{Sequence: 2, SourceClient: "src-0", DestinationClient: "dst-0", TimeoutTimestamp: 1000},
+	}
+
+	compact := []attestorevm.PacketCompact{
+		{Path: [32]byte{1}, Commitment: [32]byte{2}},
+		{Path: [32]byte{3}, Commitment: [32]byte{4}},
+	}
+
+	t.Run("returnsStateAndPacketProofs", func(t *testing.T) {
+		attestors := []attestor.Attestor{
+			signedBatchAttestor(t, "a1", 20, compact),
+			signedBatchAttestor(t, "a2", 20, compact),
+		}
+
+		gen := New(attestors, 2, nil)
+
+		proofs, err := gen.BatchProofs(ctx, 20, v2.ProofKindPacketCommitment, packets)
+		require.NoError(t, err)
+		require.Equal(t, uint64(20), proofs.Height)
+		require.NotEmpty(t, proofs.StateProof)
+		require.Len(t, proofs.PacketProofs, len(packets))
+	})
+
+	t.Run("attestorsPickHeight", func(t *testing.T) {
+		attestors := []attestor.Attestor{
+			signedBatchAttestor(t, "a1", 20, compact),
+			signedBatchAttestor(t, "a2", 20, compact),
+		}
+
+		gen := New(attestors, 2, nil)
+
+		proofs, err := gen.BatchProofs(ctx, 0, v2.ProofKindPacketCommitment, packets)
+		require.NoError(t, err)
+		require.Equal(t, uint64(20), proofs.Height)
+	})
+
+	t.Run("attestorsPickDifferentHeightsErrors", func(t *testing.T) {
+		attestors := []attestor.Attestor{
+			signedBatchAttestor(t, "a1", 20, compact),
+			signedBatchAttestor(t, "a2", 21, compact),
+		}
+
+		gen := New(attestors, 2, nil)
+
+		_, err := gen.BatchProofs(ctx, 0, v2.ProofKindPacketCommitment, packets)
+		require.ErrorContains(t, err, "quorum not met")
+	})
+
+	t.Run("quorumNotMetErrors", func(t *testing.T) {
+		failing := attestor.NewMockAttestor(t)
+		failing.EXPECT().Name().Return("a2").Maybe()
+		failing.EXPECT().AttestBatch(mock.Anything, mock.Anything).Return(
+			attestor.BatchAttestation{}, attestor.ErrNotFinalized,
+		)
+
+		attestors := []attestor.Attestor{
+			signedBatchAttestor(t, "a1", 20, compact),
+			failing,
+		}
+
+		gen := New(attestors, 2, nil)
+
+		_, err := gen.BatchProofs(ctx, 20, v2.ProofKindPacketCommitment, packets)
+		require.ErrorContains(t, err, "quorum not met")
+	})
+
+	t.Run("mismatchedHeightErrors", func(t *testing.T) {
+		attestors := []attestor.Attestor{
+			signedBatchAttestor(t, "a1", 20, compact),
+			signedBatchAttestor(t, "a2", 20, compact),
+		}
+
+		gen := New(attestors, 2, nil)
+
+		_, err := gen.BatchProofs(ctx, 21, v2.ProofKindPacketCommitment, packets)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package attestation
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/cosmos/ibc/link/internal/service/attestor"
+)
+
+// responseOutcome classifies one attestor's answer to a quorum query.
+type responseOutcome int
+
+const (
+	outcomeOK responseOutcome = iota
+	// outcomeError the query itself failed (transport, protocol or attestor
+	// error).
+	outcomeError
+	// outcomeBadSignature the attestor answered, but its signature did not
+	// recover over the claimed data.
+	outcomeBadSignature
+)
+
+const (
+	// healthDecay weights the newest observation in each moving average.
+	healthDecay = 0.2
+
+	// errorPenalty and badSignaturePenalty convert error and bad-signature
+	// rates into the latency scale of a score: a failed query costs about a
+	// request timeout, and a bad signature -- possible misbehaviour -- far
+	// more.
+	errorPenalty        = 5 * time.Second
+	badSignaturePenalty = 50 * time.Second
+)
+
+// healthTracker scores attestors from their recent quorum responses so the
+// healthiest are queried first. A nil *healthTracker tracks nothing and
+// keeps the configured order.
+type healthTracker struct {
+	mu    sync.Mutex
+	stats map[string]*attestorHealth
+}
+
+// attestorHealth exponentially weighted moving averages over one attestor's
+// responses.
+type attestorHealth struct {
+	latency          time.Duration // over successful responses only
+	errorRate        float64
+	badSignatureRate float64
+}
+
+func newHealthTracker() *healthTracker {
+	return &healthTracker{stats: make(map[string]*attestorHealth)}
+}
+
+// observe records one response from name that took latency.
+func (h *healthTracker) observe(name string, latency time.Duration, outcome responseOutcome) {
+	if h == nil {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	stats, ok := h.stats[name]
+	if !ok {
+		stats = &attestorHealth{latency: latency}
+		h.stats[name] = stats
+	}
+
+	var failed, badSignature float64
+
+	switch outcome {
+	case outcomeOK:
+		stats.latency = time.Duration(ewma(float64(stats.latency), float64(latency)))
+	case outcomeError:
+		failed = 1
+	case outcomeBadSignature:
+		badSignature = 1
+	}
+
+	stats.errorRate = ewma(stats.errorRate, failed)
+	stats.badSignatureRate = ewma(stats.badSignatureRate, badSignature)
+}
+
+// score is name's expected cost of querying it; lower is healthier.
+// Attestors never observed score zero so they get tried early.
+func (h *healthTracker) score(name string) time.Duration {
+	stats, ok := h.stats[name]
+	if !ok {
+		return 0
+	}
+
+	return stats.latency +
+		time.Duration(stats.errorRate*float64(errorPenalty)) +
+		time.Duration(stats.badSignatureRate*float64(badSignaturePenalty))
+}
+
+// order returns attestors sorted healthiest first, ties keeping their
+// configured order.
+func (h *healthTracker) order(attestors []attestor.Attestor) []attestor.Attestor {
+	ordered := slices.Clone(attestors)
+	if h == nil {
+		return ordered
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	scores := make(map[string]time.Duration, len(attestors))
+	for _, a := range attestors {
+		scores[a.Name()] = h.score(a.Name())
+	}
+
+	slices.SortStableFunc(ordered, func(a, b attestor.Attestor) int {
+		return cmp.Compare(scores[a.Name()], scores[b.Name()])
+	})
+
+	return ordered
+}
+
+func ewma(average, sample float64) float64 {
+	return (1-healthDecay)*average + healthDecay*sample
+}
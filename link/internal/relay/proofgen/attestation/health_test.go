@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package attestation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc/link/internal/service/attestor"
+)
+
+func TestHealthTracker(t *testing.T) {
+	named := func(names ...string) []attestor.Attestor {
+		attestors := make([]attestor.Attestor, len(names))
+		for i, name := range names {
+			a := attestor.NewMockAttestor(t)
+			a.EXPECT().Name().Return(name).Maybe()
+			attestors[i] = a
+		}
+
+		return attestors
+	}
+
+	namesOf := func(attestors []attestor.Attestor) []string {
+		names := make([]string, len(attestors))
+		for i, a := range attestors {
+			names[i] = a.Name()
+		}
+
+		return names
+	}
+
+	t.Run("keepsConfiguredOrderWithoutObservations", func(t *testing.T) {
+		ordered := newHealthTracker().order(named("a", "b", "c"))
+		require.Equal(t, []string{"a", "b", "c"}, namesOf(ordered))
+	})
+
+	t.Run("nilKeepsConfiguredOrder", func(t *testing.T) {
+		var health *healthTracker
+		health.observe("a", time.Second, outcomeError)
+
+		require.Equal(t, []string{"a", "b"}, namesOf(health.order(named("a", "b"))))
+	})
+
+	t.Run("ordersByLatencyErrorsAndBadSignatures", func(t *testing.T) {
+		health := newHealthTracker()
+		health.observe("slow", 2*time.Second, outcomeOK)
+		health.observe("fast", 10*time.Millisecond, outcomeOK)
+		health.observe("erroring", 10*time.Millisecond, outcomeError)
+		health.observe("forging", 10*time.Millisecond, outcomeBadSignature)
+
+		ordered := health.order(named("forging", "erroring", "slow", "fast", "new"))
+		require.Equal(t, []string{"new", "fast", "erroring", "slow", "forging"}, namesOf(ordered))
+	})
+
+	t.Run("recoversAsErrorsDecay", func(t *testing.T) {
+		health := newHealthTracker()
+		health.observe("a", 100*time.Millisecond, outcomeError)
+		health.observe("b", 500*time.Millisecond, outcomeOK)
+
+		require.Equal(t, []string{"b", "a"}, namesOf(health.order(named("a", "b"))))
+
+		for range 20 {
+			health.observe("a", 100*time.Millisecond, outcomeOK)
+		}
+
+		require.Equal(t, []string{"a", "b"}, namesOf(health.order(named("a", "b"))))
+	})
+}
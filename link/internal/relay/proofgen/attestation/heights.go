@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package attestation
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/cosmos/ibc/link/internal/service/attestor"
+)
+
+const (
+	minResubscribeDelay = time.Second
+	maxResubscribeDelay = time.Minute
+)
+
+// heightView holds each attestor's latest attestable height as pushed over
+// its height subscription, so finality checks read heights from memory
+// instead of polling every attestor. An attestor only has a height here
+// while its subscription is live; callers fall back to LatestHeight
+// otherwise. The zero value is not usable, see newHeightView.
+type heightView struct {
+	once   sync.Once
+	logger *slog.Logger
+
+	mu      sync.RWMutex
+	heights map[string]uint64
+	// header memoizes the counterparty header for the last resolved height,
+	// which is looked up again on every finality check until the height
+	// advances.
+	header struct {
+		height    uint64
+		timestamp time.Time
+	}
+}
+
+func newHeightView() *heightView {
+	return &heightView{
+		logger:  slog.With("module", "proofgen"),
+		heights: make(map[string]uint64),
+	}
+}
+
+// start subscribes to every attestor that supports it, once. Subscriptions
+// live for the lifetime of ctx and are re-established with backoff when they
+// break.
+func (v *heightView) start(ctx context.Context, attestors []attestor.Attestor) {
+	v.once.Do(func() {
+		for _, a := range attestors {
+			if subscriber, ok := a.(attestor.HeightSubscriber); ok {
+				go v.follow(ctx, a.Name(), subscriber)
+			}
+		}
+	})
+}
+
+func (v *heightView) follow(ctx context.Context, name string, subscriber attestor.HeightSubscriber) {
+	delay := minResubscribeDelay
+
+	for {
+		heights, err := subscriber.SubscribeLatestHeight(ctx)
+		if err == nil {
+			for height := range heights {
+				v.set(name, height)
+				delay = minResubscribeDelay
+			}
+		} else {
+			v.logger.Debug("Unable to subscribe to attestor latest height", "attestor", name, "err", err)
+		}
+
+		v.drop(name)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+
+		delay = min(2*delay, maxResubscribeDelay)
+	}
+}
+
+// latest returns name's streamed height, if its subscription is live.
+func (v *heightView) latest(name string) (uint64, bool) {
+	if v == nil {
+		return 0, false
+	}
+
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+
+	height, ok := v.heights[name]
+
+	return height, ok
+}
+
+// set records height for name, never lowering it while the subscription
+// stays live.
+func (v *heightView) set(name string, height uint64) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if height > v.heights[name] {
+		v.heights[name] = height
+	}
+}
+
+func (v *heightView) drop(name string) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	delete(v.heights, name)
+}
+
+// headerTimestamp returns the memoized counterparty timestamp for height.
+func (v *heightView) headerTimestamp(height uint64) (time.Time, bool) {
+	if v == nil {
+		return time.Time{}, false
+	}
+
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+
+	if v.header.height != height || height == 0 {
+		return time.Time{}, false
+	}
+
+	return v.header.timestamp, true
+}
+
+func (v *heightView) setHeaderTimestamp(height uint64, timestamp time.Time) {
+	if v == nil {
+		return
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.header.height = height
+	v.header.timestamp = timestamp
+}
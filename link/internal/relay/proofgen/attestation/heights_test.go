@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package attestation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc/link/internal/service/attestor"
+)
+
+// subscribedAttestor is an attestor.Attestor whose height subscription is
+// driven by the test through heights.
+type subscribedAttestor struct {
+	*attestor.MockAttestor
+	heights chan uint64
+}
+
+func (a subscribedAttestor) SubscribeLatestHeight(context.Context) (<-chan uint64, error) {
+	return a.heights, nil
+}
+
+func TestHeightView(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mocked := attestor.NewMockAttestor(t)
+	mocked.EXPECT().Name().Return("a1")
+
+	a := subscribedAttestor{MockAttestor: mocked, heights: make(chan uint64)}
+
+	view := newHeightView()
+	view.start(ctx, []attestor.Attestor{a})
+
+	_, ok := view.latest("a1")
+	require.False(t, ok, "no height before the first push")
+
+	a.heights <- 10
+	a.heights <- 12
+
+	require.Eventually(t, func() bool {
+		height, ok := view.latest("a1")
+		return ok && height == 12
+	}, time.Second, 10*time.Millisecond)
+
+	// a broken subscription must not leave a stale height behind
+	close(a.heights)
+
+	require.Eventually(t, func() bool {
+		_, ok := view.latest("a1")
+		return !ok
+	}, time.Second, 10*time.Millisecond)
+}
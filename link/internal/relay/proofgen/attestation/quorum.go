@@ -9,10 +9,8 @@ import (
 	"sync"
 	"time"
 
-	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 
-	attestorevm "github.com/cosmos/ibc/link/attestor/evm"
 	"github.com/cosmos/ibc/link/internal/chains"
 	"github.com/cosmos/ibc/link/internal/service/attestor"
 )
@@ -22,16 +20,65 @@ import (
 type quorumResult struct {
 	AttestationData []byte
 	Signatures      [][]byte
+
+	// Conflicts the validly signed responses collected alongside the quorum
+	// whose attestationData differs from it.
+	Conflicts []quorumResponse
+}
+
+// defaultHedgeDelay how long a hedged quorum query waits before bringing in
+// the remaining attestors, unless configured.
+const defaultHedgeDelay = 500 * time.Millisecond
+
+// quorumSpec how quorums are collected for one generator. The zero value
+// beyond threshold queries every attestor at once in configured order and
+// verifies their responses under attestor.SchemeEVM.
+type quorumSpec struct {
+	threshold int
+
+	// scheme the attestors sign under; nil is attestor.SchemeEVM.
+	scheme attestor.Scheme
+
+	// hedged queries only threshold + hedge attestors up front, bringing in
+	// the rest once one fails or hedgeDelay passes without a quorum.
+	hedged     bool
+	hedge      int
+	hedgeDelay time.Duration
+
+	// health orders attestors healthiest first and is fed every response.
+	health *healthTracker
+
+	// excludeMisbehaving leaves attestors with uncleared misbehaviour
+	// evidence out of the quorum.
+	excludeMisbehaving bool
+}
+
+// initial is how many of n attestors a query starts with.
+func (q quorumSpec) initial(n int) int {
+	if !q.hedged {
+		return n
+	}
+
+	return min(q.threshold+q.hedge, n)
+}
+
+// attestationScheme is the scheme responses are verified under.
+func (q quorumSpec) attestationScheme() attestor.Scheme {
+	if q.scheme == nil {
+		return attestor.SchemeEVM
+	}
+
+	return q.scheme
 }
 
 // queryStateQuorum aggregates a StateAttestation claim across attestors.
 func queryStateQuorum(
 	ctx context.Context,
 	attestors []attestor.Attestor,
-	threshold int,
+	q quorumSpec,
 	height uint64,
 ) (quorumResult, error) {
-	return queryQuorum(ctx, attestors, threshold, attestorevm.TagStateAttestation, func(
+	return queryQuorum(ctx, attestors, q, attestor.KindState, func(
 		ctx context.Context,
 		a attestor.Attestor,
 	) (attestor.Attestation, error) {
@@ -43,12 +90,12 @@ func queryStateQuorum(
 func queryPacketQuorum(
 	ctx context.Context,
 	attestors []attestor.Attestor,
-	threshold int,
+	q quorumSpec,
 	packets [][]byte,
 	height uint64,
 	kind attestor.CommitmentType,
 ) (quorumResult, error) {
-	return queryQuorum(ctx, attestors, threshold, attestorevm.TagPacketAttestation, func(
+	return queryQuorum(ctx, attestors, q, attestor.KindPacket, func(
 		ctx context.Context,
 		a attestor.Attestor,
 	) (attestor.Attestation, error) {
@@ -60,70 +107,249 @@ func queryPacketQuorum(
 	})
 }
 
+// batchQuorumResult the state and packet quorums collected from one round of
+// AttestBatch calls. Each side is reduced independently, exactly as if it
+// had been queried on its own.
+type batchQuorumResult struct {
+	State   quorumResult
+	Packets quorumResult
+}
+
+// batchResponse one attestor's contribution to both sides of a batch quorum.
+type batchResponse struct {
+	state, packets quorumResponse
+}
+
+// queryBatchQuorum aggregates the state and packet claims of an AttestBatch
+// request across attestors, one call per attestor.
+func queryBatchQuorum(
+	ctx context.Context,
+	attestors []attestor.Attestor,
+	q quorumSpec,
+	req attestor.BatchAttestationRequest,
+) (batchQuorumResult, error) {
+	query := func(ctx context.Context, a attestor.Attestor) batchResponse {
+		batch, err := a.AttestBatch(ctx, req)
+		if err != nil {
+			resp := quorumResponse{name: a.Name(), err: errors.Wrapf(err, "attestor %q", a.Name())}
+			return batchResponse{state: resp, packets: resp}
+		}
+
+		return batchResponse{
+			state:   verifyResponse(a, q.attestationScheme(), attestor.KindState, batch.State),
+			packets: verifyResponse(a, q.attestationScheme(), attestor.KindPacket, batch.Packets),
+		}
+	}
+
+	outcome := func(resp batchResponse) responseOutcome {
+		return max(resp.state.outcome(), resp.packets.outcome())
+	}
+
+	reduce := func(responses []batchResponse) (batchQuorumResult, error) {
+		stateResponses := make([]quorumResponse, len(responses))
+		packetResponses := make([]quorumResponse, len(responses))
+
+		for i, resp := range responses {
+			stateResponses[i], packetResponses[i] = resp.state, resp.packets
+		}
+
+		state, err := reduceQuorum(stateResponses, q.threshold)
+		if err != nil {
+			return batchQuorumResult{}, errors.Wrap(err, "state attestation")
+		}
+
+		packets, err := reduceQuorum(packetResponses, q.threshold)
+		if err != nil {
+			return batchQuorumResult{}, errors.Wrap(err, "packet attestation")
+		}
+
+		return batchQuorumResult{State: state, Packets: packets}, nil
+	}
+
+	return collectQuorum(ctx, attestors, q, query, outcome, reduce)
+}
+
 type attestationQuery func(context.Context, attestor.Attestor) (attestor.Attestation, error)
 
 // quorumResponse one attestor's contribution to a quorum.
 type quorumResponse struct {
-	name   string
-	signer common.Address
+	name string
+	// signer the address the signature verified for, as the scheme formats it.
+	signer string
 	data   []byte
 	sig    []byte
 	err    error
+	// badSig err is a signature verification failure rather than a failed
+	// query.
+	badSig bool
+}
+
+func (resp quorumResponse) outcome() responseOutcome {
+	switch {
+	case resp.badSig:
+		return outcomeBadSignature
+	case resp.err != nil:
+		return outcomeError
+	default:
+		return outcomeOK
+	}
 }
 
-// queryQuorum fans query out to every attestor concurrently, keeps only
-// responses whose signature recovers over the domain-tagged digest, requires
+// queryQuorum collects query's answers from attestors, keeps only responses
+// whose signature verifies under kind's domain in q's scheme, requires
 // byte-equality of attestationData across kept responses, and requires
 // the number of distinct signers to reach threshold.
 func queryQuorum(
 	ctx context.Context,
 	attestors []attestor.Attestor,
-	threshold int,
-	typeTag byte,
+	q quorumSpec,
+	kind attestor.AttestationKind,
 	query attestationQuery,
 ) (quorumResult, error) {
+	return collectQuorum(
+		ctx,
+		attestors,
+		q,
+		func(ctx context.Context, a attestor.Attestor) quorumResponse {
+			return queryOne(ctx, a, q.attestationScheme(), kind, query)
+		},
+		quorumResponse.outcome,
+		func(responses []quorumResponse) (quorumResult, error) {
+			return reduceQuorum(responses, q.threshold)
+		},
+	)
+}
+
+// collectQuorum queries attestors concurrently, healthiest first, and
+// returns as soon as reduce succeeds over the responses collected so far,
+// cancelling the queries still in flight. Hedged specs start with only
+// q.initial attestors and query one more per failed response, or all the
+// rest after q.hedgeDelay; any attestors left once every query in flight
+// has answered without a quorum are queried too. Fails with reduce's error
+// over every response once all attestors have answered.
+func collectQuorum[R, T any](
+	ctx context.Context,
+	attestors []attestor.Attestor,
+	q quorumSpec,
+	query func(context.Context, attestor.Attestor) R,
+	outcome func(R) responseOutcome,
+	reduce func([]R) (T, error),
+) (T, error) {
 	if len(attestors) == 0 {
-		return quorumResult{}, errors.New("no attestors configured")
+		var zero T
+		return zero, errors.New("no attestors configured")
 	}
 
-	responses := make([]quorumResponse, len(attestors))
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
 
-	var wg sync.WaitGroup
+	var (
+		ordered = q.health.order(attestors)
+		// buffered so stragglers never block once collection returns
+		results   = make(chan R, len(ordered))
+		launched  int
+		responses []R
+	)
 
-	for i, a := range attestors {
-		wg.Add(1)
+	launch := func(n int) {
+		for ; n > 0 && launched < len(ordered); n-- {
+			a := ordered[launched]
+			launched++
 
-		go func(i int, a attestor.Attestor) {
-			defer wg.Done()
+			go func() {
+				start := time.Now()
+				resp := query(ctx, a)
 
-			responses[i] = queryOne(ctx, a, typeTag, query)
-		}(i, a)
+				// an attestor cut off by early exit is not at fault
+				if ctx.Err() == nil {
+					q.health.observe(a.Name(), time.Since(start), outcome(resp))
+				}
+
+				results <- resp
+			}()
+		}
 	}
 
-	wg.Wait()
+	launch(q.initial(len(ordered)))
+
+	var hedge <-chan time.Time
+
+	if launched < len(ordered) {
+		delay := q.hedgeDelay
+		if delay <= 0 {
+			delay = defaultHedgeDelay
+		}
+
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+
+		hedge = timer.C
+	}
+
+	for len(responses) < len(ordered) {
+		if len(responses) == launched {
+			// every query in flight answered without a quorum
+			launch(len(ordered))
+		}
+
+		select {
+		case resp := <-results:
+			responses = append(responses, resp)
+
+			if result, err := reduce(responses); err == nil {
+				return result, nil
+			}
 
-	return reduceQuorum(responses, threshold)
+			if outcome(resp) != outcomeOK {
+				launch(1)
+			}
+		case <-hedge:
+			hedge = nil
+
+			launch(len(ordered))
+		}
+	}
+
+	return reduce(responses)
 }
 
-func queryOne(ctx context.Context, a attestor.Attestor, typeTag byte, query attestationQuery) quorumResponse {
+func queryOne(
+	ctx context.Context,
+	a attestor.Attestor,
+	scheme attestor.Scheme,
+	kind attestor.AttestationKind,
+	query attestationQuery,
+) quorumResponse {
 	attestation, err := query(ctx, a)
 	if err != nil {
 		return quorumResponse{name: a.Name(), err: errors.Wrapf(err, "attestor %q", a.Name())}
 	}
 
+	return verifyResponse(a, scheme, kind, attestation)
+}
+
+// verifyResponse verifies a's attestation as a kind signature in scheme,
+// resolving the address it was signed by.
+func verifyResponse(
+	a attestor.Attestor,
+	scheme attestor.Scheme,
+	kind attestor.AttestationKind,
+	attestation attestor.Attestation,
+) quorumResponse {
 	data := attestation.AttestedData
 	sig := attestation.Signature
 
-	signer, err := attestorevm.RecoverSigner(attestorevm.Digest(typeTag, data), sig)
+	signer, err := scheme.Verify(a.Address(), kind, data, sig)
 	if err != nil {
-		return quorumResponse{name: a.Name(), err: errors.Wrapf(err, "attestor %q", a.Name())}
+		return quorumResponse{name: a.Name(), err: errors.Wrapf(err, "attestor %q", a.Name()), badSig: true}
 	}
 
 	return quorumResponse{name: a.Name(), signer: signer, data: data, sig: sig}
 }
 
 // reduceQuorum groups responses by their exact attestationData value
-// and returns the first value whose distinct signers reach threshold.
+// and returns the first value whose distinct signers reach threshold,
+// along with the responses that disagree with it.
 func reduceQuorum(responses []quorumResponse, threshold int) (quorumResult, error) {
 	buckets := make(map[string][]quorumResponse)
 
@@ -136,21 +362,26 @@ func reduceQuorum(responses []quorumResponse, threshold int) (quorumResult, erro
 	}
 
 	for _, bucket := range buckets {
-		seenSigners := make(map[common.Address]struct{})
+		seenSigners := make(map[string]struct{})
 
 		var signatures [][]byte
 
 		for _, resp := range bucket {
-			if _, dup := seenSigners[resp.signer]; dup {
+			signer := strings.ToLower(resp.signer)
+			if _, dup := seenSigners[signer]; dup {
 				continue
 			}
 
-			seenSigners[resp.signer] = struct{}{}
+			seenSigners[signer] = struct{}{}
 			signatures = append(signatures, resp.sig)
 		}
 
 		if len(signatures) >= threshold {
-			return quorumResult{AttestationData: bucket[0].data, Signatures: signatures}, nil
+			return quorumResult{
+				AttestationData: bucket[0].data,
+				Signatures:      signatures,
+				Conflicts:       conflicts(buckets, bucket[0].data),
+			}, nil
 		}
 	}
 
@@ -160,6 +391,19 @@ func reduceQuorum(responses []quorumResponse, threshold int) (quorumResult, erro
 	)
 }
 
+// conflicts returns the responses in every bucket but agreed's.
+func conflicts(buckets map[string][]quorumResponse, agreed []byte) []quorumResponse {
+	var out []quorumResponse
+
+	for data, bucket := range buckets {
+		if data != string(agreed) {
+			out = append(out, bucket...)
+		}
+	}
+
+	return out
+}
+
 // joinResponseErrors summarizes why each non-contributing attestor was
 // excluded, so a quorum failure doesn't hide the underlying per-attestor
 // errors (network, protocol, or bad signature) behind just a vote count.
@@ -181,12 +425,15 @@ func joinResponseErrors(responses []quorumResponse) string {
 
 // latestProvableHeight finds a height every attestor in the quorum has. It fans
 // LatestHeight out concurrently and takes the minimum among the attestors
-// that answered, requiring at least threshold of them to respond.
+// that answered, requiring at least threshold of them to respond. Attestors
+// with a live height subscription in view are read from memory instead; view
+// may be nil.
 func latestProvableHeight(
 	ctx context.Context,
 	attestors []attestor.Attestor,
 	threshold int,
 	counterpartyChain chains.Client,
+	view *heightView,
 ) (uint64, time.Time, error) {
 	type heightResponse struct {
 		height uint64
@@ -198,6 +445,12 @@ func latestProvableHeight(
 	var wg sync.WaitGroup
 
 	for i, a := range attestors {
+		if height, ok := view.latest(a.Name()); ok {
+			responses[i] = heightResponse{height: height}
+
+			continue
+		}
+
 		wg.Add(1)
 
 		go func(i int, a attestor.Attestor) {
@@ -245,10 +498,16 @@ func latestProvableHeight(
 	sort.Slice(heights, func(i, j int) bool { return heights[i] > heights[j] })
 	height := heights[threshold-1]
 
+	if timestamp, ok := view.headerTimestamp(height); ok {
+		return height, timestamp, nil
+	}
+
 	header, err := counterpartyChain.GetBlockHeader(ctx, height)
 	if err != nil {
 		return 0, time.Time{}, errors.Wrapf(err, "getting header for resolved height %d", height)
 	}
 
+	view.setHeaderTimestamp(height, header.Timestamp)
+
 	return height, header.Timestamp, nil
 }
@@ -4,6 +4,8 @@ package attestation
 
 import (
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
 	"testing"
 	"time"
 
@@ -12,6 +14,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	attestoreddsa "github.com/cosmos/ibc/link/attestor/eddsa"
 	attestorevm "github.com/cosmos/ibc/link/attestor/evm"
 	"github.com/cosmos/ibc/link/internal/service/attestor"
 	"github.com/cosmos/ibc/link/internal/tests/mocks"
@@ -20,7 +23,8 @@ import (
 
 // signedAttestor builds a attestor.MockAttestor whose StateAttestation call
 // returns attestedData signed by a freshly generated key over the correct
-// domain-tagged digest.
+// domain-tagged digest. The call is optional: collection returns as soon as
+// a quorum forms, possibly before every attestor has been asked.
 func signedAttestor(t *testing.T, name string, attestedData []byte) *attestor.MockAttestor {
 	t.Helper()
 
@@ -33,9 +37,10 @@ func signedAttestor(t *testing.T, name string, attestedData []byte) *attestor.Mo
 
 	a := attestor.NewMockAttestor(t)
 	a.EXPECT().Name().Return(name).Maybe()
+	a.EXPECT().Address().Return(crypto.PubkeyToAddress(key.PublicKey).Hex()).Maybe()
 	a.EXPECT().StateAttestation(mock.Anything, mock.Anything).Return(
 		attestor.Attestation{Height: 10, AttestedData: attestedData, Signature: sig}, nil,
-	)
+	).Maybe()
 
 	return a
 }
@@ -50,7 +55,7 @@ func TestQueryQuorum(t *testing.T) {
 			signedAttestor(t, "a2", data),
 		}
 
-		result, err := queryStateQuorum(ctx, attestors, 2, 10)
+		result, err := queryStateQuorum(ctx, attestors, quorumSpec{threshold: 2}, 10)
 		require.NoError(t, err)
 		require.Equal(t, data, result.AttestationData)
 		require.Len(t, result.Signatures, 2)
@@ -61,7 +66,7 @@ func TestQueryQuorum(t *testing.T) {
 			signedAttestor(t, "a1", data),
 		}
 
-		_, err := queryStateQuorum(ctx, attestors, 2, 10)
+		_, err := queryStateQuorum(ctx, attestors, quorumSpec{threshold: 2}, 10)
 		require.ErrorContains(t, err, "quorum not met")
 	})
 
@@ -71,7 +76,7 @@ func TestQueryQuorum(t *testing.T) {
 			signedAttestor(t, "a2", []byte("a different claim entirely")),
 		}
 
-		_, err := queryStateQuorum(ctx, attestors, 2, 10)
+		_, err := queryStateQuorum(ctx, attestors, quorumSpec{threshold: 2}, 10)
 		require.ErrorContains(
 			t,
 			err,
@@ -91,7 +96,7 @@ func TestQueryQuorum(t *testing.T) {
 			signedAttestor(t, "a3", data),
 		}
 
-		result, err := queryStateQuorum(ctx, attestors, 2, 10)
+		result, err := queryStateQuorum(ctx, attestors, quorumSpec{threshold: 2}, 10)
 		require.NoError(t, err)
 		require.Equal(t, data, result.AttestationData)
 		require.Len(t, result.Signatures, 2)
@@ -100,7 +105,7 @@ func TestQueryQuorum(t *testing.T) {
 	t.Run("queryErrorExcludedNotFatal", func(t *testing.T) {
 		erroring := attestor.NewMockAttestor(t)
 		erroring.EXPECT().Name().Return("erroring").Maybe()
-		erroring.EXPECT().StateAttestation(mock.Anything, mock.Anything).Return(attestor.Attestation{}, assert.AnError)
+		erroring.EXPECT().StateAttestation(mock.Anything, mock.Anything).Return(attestor.Attestation{}, assert.AnError).Maybe()
 
 		attestors := []attestor.Attestor{
 			signedAttestor(t, "a1", data),
@@ -108,7 +113,7 @@ func TestQueryQuorum(t *testing.T) {
 			erroring,
 		}
 
-		result, err := queryStateQuorum(ctx, attestors, 2, 10)
+		result, err := queryStateQuorum(ctx, attestors, quorumSpec{threshold: 2}, 10)
 		require.NoError(t, err)
 		require.Len(t, result.Signatures, 2)
 	})
@@ -116,9 +121,10 @@ func TestQueryQuorum(t *testing.T) {
 	t.Run("badSignatureExcludedNotFatal", func(t *testing.T) {
 		badAttestor := attestor.NewMockAttestor(t)
 		badAttestor.EXPECT().Name().Return("bad").Maybe()
+		badAttestor.EXPECT().Address().Return("0x00000000000000000000000000000000000000bb").Maybe()
 		badAttestor.EXPECT().StateAttestation(mock.Anything, mock.Anything).Return(
 			attestor.Attestation{Height: 10, AttestedData: data, Signature: []byte("not a valid signature")}, nil,
-		)
+		).Maybe()
 
 		attestors := []attestor.Attestor{
 			signedAttestor(t, "a1", data),
@@ -127,7 +133,7 @@ func TestQueryQuorum(t *testing.T) {
 		}
 
 		// threshold 2 still met by the two valid attestors despite the bad one
-		result, err := queryStateQuorum(ctx, attestors, 2, 10)
+		result, err := queryStateQuorum(ctx, attestors, quorumSpec{threshold: 2}, 10)
 		require.NoError(t, err)
 		require.Len(t, result.Signatures, 2)
 	})
@@ -143,6 +149,7 @@ func TestQueryQuorum(t *testing.T) {
 		makeAttestor := func(name string) *attestor.MockAttestor {
 			a := attestor.NewMockAttestor(t)
 			a.EXPECT().Name().Return(name).Maybe()
+			a.EXPECT().Address().Return(crypto.PubkeyToAddress(key.PublicKey).Hex()).Maybe()
 			a.EXPECT().StateAttestation(mock.Anything, mock.Anything).Return(
 				attestor.Attestation{Height: 10, AttestedData: data, Signature: sig}, nil,
 			)
@@ -155,7 +162,7 @@ func TestQueryQuorum(t *testing.T) {
 			makeAttestor("a2-same-key"),
 		}
 
-		_, err = queryStateQuorum(ctx, attestors, 2, 10)
+		_, err = queryStateQuorum(ctx, attestors, quorumSpec{threshold: 2}, 10)
 		require.ErrorContains(
 			t,
 			err,
@@ -163,6 +170,173 @@ func TestQueryQuorum(t *testing.T) {
 			"the same recovered signer answering twice must not count twice",
 		)
 	})
+
+	t.Run("eddsaScheme", func(t *testing.T) {
+		// ARRANGE
+		attestors := []attestor.Attestor{
+			eddsaAttestor(t, "a1", data, ""),
+			eddsaAttestor(t, "a2", data, ""),
+			// reports another key's address than the one it signs with
+			eddsaAttestor(t, "impostor", data, eddsaAddress(t)),
+		}
+
+		// ACT
+		result, err := queryStateQuorum(ctx, attestors, quorumSpec{threshold: 2, scheme: attestor.SchemeEdDSA}, 10)
+
+		// ASSERT
+		require.NoError(t, err)
+		require.Len(t, result.Signatures, 2)
+
+		_, err = queryStateQuorum(ctx, attestors, quorumSpec{threshold: 3, scheme: attestor.SchemeEdDSA}, 10)
+		require.ErrorContains(t, err, `attestor "impostor": verify signature by`,
+			"a signature by any key but the reported address's must not count")
+	})
+}
+
+// eddsaAttestor builds a attestor.MockAttestor whose StateAttestation
+// returns attestedData signed by a fresh ed25519 key. It reports address, or
+// the key's own address when empty.
+func eddsaAttestor(t *testing.T, name string, attestedData []byte, address string) *attestor.MockAttestor {
+	t.Helper()
+
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	if address == "" {
+		address, err = attestoreddsa.Address(publicKey)
+		require.NoError(t, err)
+	}
+
+	sig := ed25519.Sign(privateKey, attestoreddsa.Message(attestoreddsa.TagStateAttestation, attestedData))
+
+	a := attestor.NewMockAttestor(t)
+	a.EXPECT().Name().Return(name).Maybe()
+	a.EXPECT().Address().Return(address).Maybe()
+	a.EXPECT().StateAttestation(mock.Anything, mock.Anything).Return(
+		attestor.Attestation{Height: 10, AttestedData: attestedData, Signature: sig}, nil,
+	).Maybe()
+
+	return a
+}
+
+func eddsaAddress(t *testing.T) string {
+	t.Helper()
+
+	publicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	address, err := attestoreddsa.Address(publicKey)
+	require.NoError(t, err)
+
+	return address
+}
+
+// stalledAttestor builds a attestor.MockAttestor whose StateAttestation
+// only returns once its context is cancelled.
+func stalledAttestor(t *testing.T, name string) *attestor.MockAttestor {
+	t.Helper()
+
+	a := attestor.NewMockAttestor(t)
+	a.EXPECT().Name().Return(name).Maybe()
+	a.EXPECT().StateAttestation(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, _ uint64) (attestor.Attestation, error) {
+			<-ctx.Done()
+			return attestor.Attestation{}, ctx.Err()
+		},
+	).Maybe()
+
+	return a
+}
+
+// unqueriedAttestor builds a attestor.MockAttestor that fails the test if it
+// is asked for an attestation.
+func unqueriedAttestor(t *testing.T, name string) *attestor.MockAttestor {
+	t.Helper()
+
+	a := attestor.NewMockAttestor(t)
+	a.EXPECT().Name().Return(name).Maybe()
+
+	return a
+}
+
+func TestCollectQuorum(t *testing.T) {
+	ctx := context.Background()
+	data := []byte("attested state data")
+
+	t.Run("returnsWithoutWaitingForStragglers", func(t *testing.T) {
+		attestors := []attestor.Attestor{
+			stalledAttestor(t, "slow"),
+			signedAttestor(t, "a1", data),
+			signedAttestor(t, "a2", data),
+		}
+
+		result, err := queryStateQuorum(ctx, attestors, quorumSpec{threshold: 2}, 10)
+		require.NoError(t, err)
+		require.Len(t, result.Signatures, 2)
+	})
+
+	t.Run("hedgedQueriesOnlyThresholdPlusHedgeUpFront", func(t *testing.T) {
+		attestors := []attestor.Attestor{
+			signedAttestor(t, "a1", data),
+			signedAttestor(t, "a2", data),
+			unqueriedAttestor(t, "a3"),
+			unqueriedAttestor(t, "a4"),
+		}
+
+		q := quorumSpec{threshold: 2, hedged: true, hedge: 0, hedgeDelay: time.Minute}
+
+		result, err := queryStateQuorum(ctx, attestors, q, 10)
+		require.NoError(t, err)
+		require.Len(t, result.Signatures, 2)
+	})
+
+	t.Run("hedgedReplacesFailedAttestor", func(t *testing.T) {
+		erroring := attestor.NewMockAttestor(t)
+		erroring.EXPECT().Name().Return("erroring").Maybe()
+		erroring.EXPECT().StateAttestation(mock.Anything, mock.Anything).Return(attestor.Attestation{}, assert.AnError)
+
+		attestors := []attestor.Attestor{
+			erroring,
+			signedAttestor(t, "a1", data),
+			signedAttestor(t, "a2", data),
+		}
+
+		q := quorumSpec{threshold: 2, hedged: true, hedge: 0, hedgeDelay: time.Minute}
+
+		result, err := queryStateQuorum(ctx, attestors, q, 10)
+		require.NoError(t, err)
+		require.Len(t, result.Signatures, 2)
+	})
+
+	t.Run("hedgeDelayBringsInTheRest", func(t *testing.T) {
+		attestors := []attestor.Attestor{
+			stalledAttestor(t, "slow"),
+			signedAttestor(t, "a1", data),
+			signedAttestor(t, "a2", data),
+		}
+
+		q := quorumSpec{threshold: 2, hedged: true, hedge: 0, hedgeDelay: 10 * time.Millisecond}
+
+		result, err := queryStateQuorum(ctx, attestors, q, 10)
+		require.NoError(t, err)
+		require.Len(t, result.Signatures, 2)
+	})
+
+	t.Run("healthOrdersHedgedQueries", func(t *testing.T) {
+		health := newHealthTracker()
+		health.observe("flaky", time.Millisecond, outcomeError)
+
+		attestors := []attestor.Attestor{
+			unqueriedAttestor(t, "flaky"),
+			signedAttestor(t, "a1", data),
+			signedAttestor(t, "a2", data),
+		}
+
+		q := quorumSpec{threshold: 2, hedged: true, hedge: 0, hedgeDelay: time.Minute, health: health}
+
+		_, err := queryStateQuorum(ctx, attestors, q, 10)
+		require.NoError(t, err)
+	})
 }
 
 // heightAttestor builds a attestor.MockAttestor that only answers LatestHeight,
@@ -205,7 +379,7 @@ func TestLatestProvableHeight(t *testing.T) {
 			GetBlockHeader(mock.Anything, uint64(100)).
 			Return(v2.BlockHeader{Timestamp: someBlockTime}, nil)
 
-		height, timestamp, err := latestProvableHeight(ctx, attestors, 2, counterpartyChain)
+		height, timestamp, err := latestProvableHeight(ctx, attestors, 2, counterpartyChain, nil)
 		require.NoError(t, err)
 		require.Equal(
 			t,
@@ -228,7 +402,7 @@ func TestLatestProvableHeight(t *testing.T) {
 			GetBlockHeader(mock.Anything, uint64(95)).
 			Return(v2.BlockHeader{Timestamp: someBlockTime}, nil)
 
-		height, _, err := latestProvableHeight(ctx, attestors, 2, counterpartyChain)
+		height, _, err := latestProvableHeight(ctx, attestors, 2, counterpartyChain, nil)
 		require.NoError(t, err)
 		require.Equal(t, uint64(95), height)
 	})
@@ -244,7 +418,7 @@ func TestLatestProvableHeight(t *testing.T) {
 		// before ever consulting the chain
 		counterpartyChain := mocks.NewMockClient(t)
 
-		_, _, err := latestProvableHeight(ctx, attestors, 2, counterpartyChain)
+		_, _, err := latestProvableHeight(ctx, attestors, 2, counterpartyChain, nil)
 		require.ErrorContains(t, err, "quorum not met")
 	})
 
@@ -257,7 +431,40 @@ func TestLatestProvableHeight(t *testing.T) {
 		counterpartyChain := mocks.NewMockClient(t)
 		counterpartyChain.EXPECT().GetBlockHeader(mock.Anything, uint64(100)).Return(v2.BlockHeader{}, assert.AnError)
 
-		_, _, err := latestProvableHeight(ctx, attestors, 2, counterpartyChain)
+		_, _, err := latestProvableHeight(ctx, attestors, 2, counterpartyChain, nil)
 		require.ErrorContains(t, err, "getting header")
 	})
+	t.Run("readsSubscribedHeightsFromView", func(t *testing.T) {
+		// no LatestHeight expectations: subscribed attestors must not be
+		// polled
+		subscribed := func(name string) *attestor.MockAttestor {
+			a := attestor.NewMockAttestor(t)
+			a.EXPECT().Name().Return(name).Maybe()
+
+			return a
+		}
+
+		attestors := []attestor.Attestor{
+			subscribed("a1"),
+			subscribed("a2"),
+			heightAttestor(t, "polled", 90),
+		}
+
+		view := newHeightView()
+		view.set("a1", 100)
+		view.set("a2", 110)
+
+		counterpartyChain := mocks.NewMockClient(t)
+		counterpartyChain.EXPECT().
+			GetBlockHeader(mock.Anything, uint64(100)).
+			Return(v2.BlockHeader{Timestamp: someBlockTime}, nil).
+			Once()
+
+		for range 2 {
+			height, timestamp, err := latestProvableHeight(ctx, attestors, 2, counterpartyChain, view)
+			require.NoError(t, err)
+			require.Equal(t, uint64(100), height)
+			require.Equal(t, someBlockTime, timestamp, "the resolved height's header is looked up once")
+		}
+	})
 }
@@ -16,24 +16,62 @@ import (
 
 // ResolveGenerator builds a Generator for self, tracking counterparty, once
 // enough attestors satisfy self's on-chain attestation set to meet its
-// threshold.
+// threshold. The matched attestors must share one attestation scheme, which
+// the generator verifies and encodes proofs in. Misbehaviour evidence goes
+// to ledger, which may be nil.
 func ResolveGenerator(
 	ctx context.Context,
 	self, counterparty config.ClientEnd,
 	clientSet *chains.ClientSet,
 	attestors []attestor.Attestor,
+	ledger *Ledger,
 ) (*Generator, error) {
 	matched, minRequiredSigs, err := MatchAttestors(ctx, self, counterparty, clientSet, attestors)
 	if err != nil {
 		return nil, err
 	}
 
+	scheme, err := quorumScheme(matched)
+	if err != nil {
+		return nil, errors.Wrapf(err, "client %q", self.ClientID)
+	}
+
 	counterpartyChain, ok := clientSet.Get(counterparty.ChainID)
 	if !ok {
 		return nil, errors.Errorf("no configured chain client for counterparty chain %q", counterparty.ChainID)
 	}
 
-	return New(matched, int(minRequiredSigs), counterpartyChain), nil
+	return New(matched, int(minRequiredSigs), counterpartyChain).
+		withQuorum(self.Quorum).
+		withScheme(scheme).
+		withLedger(ledger), nil
+}
+
+// quorumScheme infers the attestation scheme attestors sign under from their
+// addresses, erroring unless they all agree: a quorum's signatures go into
+// one proof, which only one counterparty light client can verify.
+func quorumScheme(attestors []attestor.Attestor) (attestor.Scheme, error) {
+	var scheme attestor.Scheme
+
+	for _, a := range attestors {
+		s := attestor.SchemeOf(a.Address())
+
+		switch {
+		case scheme == nil:
+			scheme = s
+		case s != scheme:
+			return nil, errors.Errorf(
+				"attestor %q signs %s attestations, others sign %s",
+				a.Name(), s.Name(), scheme.Name(),
+			)
+		}
+	}
+
+	if scheme == nil {
+		return attestor.SchemeEVM, nil
+	}
+
+	return scheme, nil
 }
 
 // MatchAttestors resolves self's on-chain attestation set and returns the
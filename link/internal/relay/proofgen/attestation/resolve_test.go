@@ -20,13 +20,13 @@ func testConnection() config.ConnectionConfig {
 		Alias: "eth-base",
 		ClientA: config.ClientEnd{
 			ChainID:  "1",
-			Signer:   "relayer-key",
+			Signer:   config.SignerAliases{"relayer-key"},
 			ClientID: "base-0",
 			Type:     config.ClientTypeAttestation,
 		},
 		ClientB: config.ClientEnd{
 			ChainID:  "8453",
-			Signer:   "relayer-key",
+			Signer:   config.SignerAliases{"relayer-key"},
 			ClientID: "ethereum-0",
 			Type:     config.ClientTypeAttestation,
 		},
@@ -61,7 +61,7 @@ func TestResolveGenerator(t *testing.T) {
 
 		candidate := localCandidate(t, "watches-b", conn.ClientB.ChainID, "0xAAA")
 
-		gen, err := ResolveGenerator(ctx, conn.ClientA, conn.ClientB, clientSet, []attestor.Attestor{candidate})
+		gen, err := ResolveGenerator(ctx, conn.ClientA, conn.ClientB, clientSet, []attestor.Attestor{candidate}, nil)
 
 		require.NoError(t, err, "address match is case-insensitive")
 		require.NotNil(t, gen)
@@ -79,7 +79,7 @@ func TestResolveGenerator(t *testing.T) {
 
 		candidate := localCandidate(t, "watcher", conn.ClientB.ChainID, "0xaaa")
 
-		_, err := ResolveGenerator(ctx, conn.ClientA, conn.ClientB, clientSet, []attestor.Attestor{candidate})
+		_, err := ResolveGenerator(ctx, conn.ClientA, conn.ClientB, clientSet, []attestor.Attestor{candidate}, nil)
 
 		require.ErrorContains(t, err, `only 1 reachable/matching attestors for chain "8453"`)
 		require.ErrorContains(t, err, "on-chain quorum requires 2")
@@ -99,7 +99,7 @@ func TestResolveGenerator(t *testing.T) {
 		first := localCandidate(t, "watcher-1", conn.ClientB.ChainID, "0xaaa")
 		second := localCandidate(t, "watcher-2", conn.ClientB.ChainID, "0xAAA")
 
-		_, err := ResolveGenerator(ctx, conn.ClientA, conn.ClientB, clientSet, []attestor.Attestor{first, second})
+		_, err := ResolveGenerator(ctx, conn.ClientA, conn.ClientB, clientSet, []attestor.Attestor{first, second}, nil)
 
 		require.ErrorContains(t, err, `attestors "watcher-1" and "watcher-2" share address "0xAAA"`)
 	})
@@ -115,11 +115,34 @@ func TestResolveGenerator(t *testing.T) {
 		// address not registered on-chain
 		candidate := localCandidate(t, "watcher", conn.ClientB.ChainID, "0xdeadbeef")
 
-		_, err := ResolveGenerator(ctx, conn.ClientA, conn.ClientB, clientSet, []attestor.Attestor{candidate})
+		_, err := ResolveGenerator(ctx, conn.ClientA, conn.ClientB, clientSet, []attestor.Attestor{candidate}, nil)
 
 		require.ErrorContains(t, err, "only 0 reachable/matching attestors")
 	})
 
+	t.Run("mixedSchemesErrors", func(t *testing.T) {
+		conn := testConnection()
+
+		evmAddress := "0x00000000000000000000000000000000000000aa"
+		eddsaAddress := "0x00000000000000000000000000000000000000000000000000000000000000bb"
+
+		selfChain := mocks.NewMockClient(t)
+		selfChain.EXPECT().
+			GetAttestationSet(ctx, conn.ClientA.ClientID).
+			Return([]string{evmAddress, eddsaAddress}, uint8(2), nil)
+
+		clientSet := chains.NewClientSet(map[string]chains.Client{conn.ClientA.ChainID: selfChain})
+
+		attestors := []attestor.Attestor{
+			localCandidate(t, "secp", conn.ClientB.ChainID, evmAddress),
+			localCandidate(t, "ed", conn.ClientB.ChainID, eddsaAddress),
+		}
+
+		_, err := ResolveGenerator(ctx, conn.ClientA, conn.ClientB, clientSet, attestors, nil)
+
+		require.ErrorContains(t, err, `attestor "ed" signs eddsa attestations, others sign evm`)
+	})
+
 	t.Run("wrongChainExcluded", func(t *testing.T) {
 		conn := testConnection()
 
@@ -131,7 +154,7 @@ func TestResolveGenerator(t *testing.T) {
 		// wrong chain, despite matching address
 		candidate := localCandidate(t, "watcher", "some-other-chain", "0xaaa")
 
-		_, err := ResolveGenerator(ctx, conn.ClientA, conn.ClientB, clientSet, []attestor.Attestor{candidate})
+		_, err := ResolveGenerator(ctx, conn.ClientA, conn.ClientB, clientSet, []attestor.Attestor{candidate}, nil)
 
 		require.ErrorContains(t, err, "only 0 reachable/matching attestors")
 	})
@@ -140,7 +163,7 @@ func TestResolveGenerator(t *testing.T) {
 		conn := testConnection()
 		clientSet := chains.NewClientSet(nil)
 
-		_, err := ResolveGenerator(ctx, conn.ClientA, conn.ClientB, clientSet, nil)
+		_, err := ResolveGenerator(ctx, conn.ClientA, conn.ClientB, clientSet, nil, nil)
 
 		require.ErrorContains(t, err, `no configured chain client for "1"`)
 	})
@@ -153,7 +176,7 @@ func TestResolveGenerator(t *testing.T) {
 
 		clientSet := chains.NewClientSet(map[string]chains.Client{conn.ClientA.ChainID: selfChain})
 
-		_, err := ResolveGenerator(ctx, conn.ClientA, conn.ClientB, clientSet, nil)
+		_, err := ResolveGenerator(ctx, conn.ClientA, conn.ClientB, clientSet, nil, nil)
 
 		require.ErrorContains(t, err, `no configured chain client for counterparty chain "8453"`)
 	})
@@ -39,6 +39,17 @@ type ProofGenerator interface {
 		kind v2.ProofKind,
 		packets []channeltypesv2.Packet,
 	) ([][]byte, error)
+
+	// BatchProofs returns what StateProof and PacketProofs would at height,
+	// collecting both in one round of attestor queries. A zero height lets
+	// the attestors pick their latest attestable height, reported back in the
+	// result
+	BatchProofs(
+		ctx context.Context,
+		height uint64,
+		kind v2.ProofKind,
+		packets []channeltypesv2.Packet,
+	) (v2.BatchProof, error)
 }
 
 var _ ProofGenerator = (*attestation.Generator)(nil)
@@ -69,17 +80,20 @@ func (s *Set) Get(chainID, clientID string) (ProofGenerator, bool) {
 
 // NewSetFromConfig resolves a ProofGenerator for every client end of every
 // configured connection, matching against attestors (this process's own
-// local attestors plus every resolved remote one).
+// local attestors plus every resolved remote one). Generators record
+// attestor misbehaviour evidence to evidence, which may be nil.
 func NewSetFromConfig(
 	ctx context.Context,
 	cfg config.Config,
 	clientSet *chains.ClientSet,
 	attestors []attestor.Attestor,
+	evidence attestation.EvidenceStore,
 ) (*Set, error) {
 	generators := make(map[string]ProofGenerator, len(cfg.Relayer.Connections)*2)
+	ledger := attestation.NewLedger(evidence)
 
 	err := forEachClientEnd(cfg, func(connAlias string, self, counterparty config.ClientEnd) error {
-		return addGenerator(ctx, generators, connAlias, self, counterparty, clientSet, attestors)
+		return addGenerator(ctx, generators, connAlias, self, counterparty, clientSet, attestors, ledger)
 	})
 	if err != nil {
 		return nil, err
@@ -114,10 +128,11 @@ func addGenerator(
 	client, clientCounterparty config.ClientEnd,
 	clientSet *chains.ClientSet,
 	attestors []attestor.Attestor,
+	ledger *attestation.Ledger,
 ) error {
 	switch client.Type {
 	case config.ClientTypeAttestation:
-		gen, err := attestation.ResolveGenerator(ctx, client, clientCounterparty, clientSet, attestors)
+		gen, err := attestation.ResolveGenerator(ctx, client, clientCounterparty, clientSet, attestors, ledger)
 		if err != nil {
 			return err
 		}
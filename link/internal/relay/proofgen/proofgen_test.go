@@ -19,13 +19,13 @@ func testConnection() config.ConnectionConfig {
 		Alias: "eth-base",
 		ClientA: config.ClientEnd{
 			ChainID:  "1",
-			Signer:   "relayer-key",
+			Signer:   config.SignerAliases{"relayer-key"},
 			ClientID: "base-0",
 			Type:     config.ClientTypeAttestation,
 		},
 		ClientB: config.ClientEnd{
 			ChainID:  "8453",
-			Signer:   "relayer-key",
+			Signer:   config.SignerAliases{"relayer-key"},
 			ClientID: "ethereum-0",
 			Type:     config.ClientTypeAttestation,
 		},
@@ -85,7 +85,7 @@ func TestNewSetFromConfig(t *testing.T) {
 		cfg, clientSet, attestors := testConfig(t)
 		conn := cfg.Relayer.Connections[0]
 
-		set, err := NewSetFromConfig(ctx, cfg, clientSet, attestors)
+		set, err := NewSetFromConfig(ctx, cfg, clientSet, attestors, nil)
 		require.NoError(t, err)
 
 		_, ok := set.Get(conn.ClientA.ChainID, conn.ClientA.ClientID)
@@ -109,7 +109,7 @@ func TestNewSetFromConfig(t *testing.T) {
 		cfg := config.Config{Relayer: config.RelayerConfig{Connections: []config.ConnectionConfig{conn}}}
 
 		// ACT
-		_, err := NewSetFromConfig(ctx, cfg, clientSet, nil)
+		_, err := NewSetFromConfig(ctx, cfg, clientSet, nil, nil)
 
 		// ASSERT
 		require.ErrorContains(t, err, `unsupported client type "tendermint"`)
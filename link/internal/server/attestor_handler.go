@@ -23,12 +23,18 @@ type AttestorHandler struct {
 // AttestorService defines attestor business logic.
 type AttestorService interface {
 	LatestHeight(ctx context.Context, attestor string) (uint64, error)
+	SubscribeLatestHeight(ctx context.Context, attestor string) (<-chan uint64, error)
 	StateAttestation(ctx context.Context, attestor string, height uint64) (attestor.Attestation, error)
 	PacketAttestation(
 		ctx context.Context,
 		attestor string,
 		req attestor.PacketAttestationRequest,
 	) (attestor.Attestation, error)
+	AttestBatch(
+		ctx context.Context,
+		attestor string,
+		req attestor.BatchAttestationRequest,
+	) (attestor.BatchAttestation, error)
 	Info(alias string) (attestor.Info, bool)
 }
 
@@ -71,6 +77,30 @@ func (h *AttestorHandler) LatestHeight(
 	return connect.NewResponse(&proto.LatestHeightResponse{Height: height}), nil
 }
 
+func (h *AttestorHandler) SubscribeLatestHeight(
+	ctx context.Context,
+	req *connect.Request[proto.SubscribeLatestHeightRequest],
+	stream *connect.ServerStream[proto.SubscribeLatestHeightResponse],
+) error {
+	heights, err := h.service.SubscribeLatestHeight(ctx, req.Msg.Attestor)
+	switch {
+	case errors.Is(err, attestor.ErrNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case err != nil:
+		// todo: move to interceptor
+		h.logger.Error("SubscribeLatestHeight", "err", err)
+		return errInternal
+	}
+
+	for height := range heights {
+		if err := stream.Send(&proto.SubscribeLatestHeightResponse{Height: height}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (h *AttestorHandler) StateAttestation(
 	ctx context.Context,
 	req *connect.Request[proto.StateAttestationRequest],
@@ -122,6 +152,35 @@ func (h *AttestorHandler) PacketAttestation(
 	}), nil
 }
 
+func (h *AttestorHandler) AttestBatch(
+	ctx context.Context,
+	req *connect.Request[proto.AttestBatchRequest],
+) (*connect.Response[proto.AttestBatchResponse], error) {
+	batchReq, err := batchAttestationRequestFromProto(req.Msg)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	batch, err := h.service.AttestBatch(ctx, req.Msg.Attestor, batchReq)
+	switch {
+	case errors.Is(err, attestor.ErrNotFound), errors.Is(err, attestor.ErrCommitmentNotFound):
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, attestor.ErrInvalidInput):
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, attestor.ErrNotFinalized), errors.Is(err, attestor.ErrReceiptExists):
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	case err != nil:
+		// todo: move to interceptor
+		h.logger.Error("AttestBatch", "err", err)
+		return nil, errInternal
+	}
+
+	return connect.NewResponse(&proto.AttestBatchResponse{
+		State:   attestationToProto(batch.State),
+		Packets: attestationToProto(batch.Packets),
+	}), nil
+}
+
 func (h *AttestorHandler) Info(
 	_ context.Context,
 	req *connect.Request[proto.InfoRequest],
@@ -134,9 +193,26 @@ func (h *AttestorHandler) Info(
 	return connect.NewResponse(&proto.InfoResponse{
 		ChainId: info.ChainID,
 		Address: info.Address,
+		Cache:   cacheStatsToProto(info.Cache),
 	}), nil
 }
 
+func cacheStatsToProto(stats *attestor.CacheStats) *proto.CacheStats {
+	if stats == nil {
+		return nil
+	}
+
+	counter := func(c attestor.CacheCounter) *proto.CacheCounter {
+		return &proto.CacheCounter{Hits: c.Hits, Misses: c.Misses}
+	}
+
+	return &proto.CacheStats{
+		States:      counter(stats.States),
+		Commitments: counter(stats.Commitments),
+		Signatures:  counter(stats.Signatures),
+	}
+}
+
 func attestationToProto(a attestor.Attestation) *proto.Attestation {
 	var timestamp *uint64
 	if a.Timestamp != nil {
@@ -168,3 +244,20 @@ func packetAttestationRequestFromProto(req *proto.PacketAttestationRequest) (att
 		CommitmentType: ct,
 	}, nil
 }
+
+func batchAttestationRequestFromProto(req *proto.AttestBatchRequest) (attestor.BatchAttestationRequest, error) {
+	if len(req.Packets) == 0 {
+		return attestor.BatchAttestationRequest{}, errors.New("packets must be provided")
+	}
+
+	ct, err := attestor.CommitmentTypeFromProto(req.CommitmentType)
+	if err != nil {
+		return attestor.BatchAttestationRequest{}, err
+	}
+
+	return attestor.BatchAttestationRequest{
+		Height:         req.Height,
+		Packets:        req.Packets,
+		CommitmentType: ct,
+	}, nil
+}
@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	healthPath  = "/healthz"
+	metricsPath = "/metrics"
+
+	healthTimeout = 5 * time.Second
+)
+
+// HealthChecker reports the health of one component on /healthz.
+type HealthChecker interface {
+	Name() string
+	Health(ctx context.Context) (details any, healthy bool)
+}
+
+type healthResponse struct {
+	Healthy bool                   `json:"healthy"`
+	Checks  map[string]healthCheck `json:"checks"`
+}
+
+type healthCheck struct {
+	Healthy bool `json:"healthy"`
+	Details any  `json:"details,omitempty"`
+}
+
+// RegisterHealth serves the checks' combined health on /healthz: 200 when
+// every check is healthy, 503 otherwise.
+func (s *Server) RegisterHealth(checks ...HealthChecker) {
+	s.mux.HandleFunc("GET "+healthPath, func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), healthTimeout)
+		defer cancel()
+
+		response := healthResponse{Healthy: true, Checks: make(map[string]healthCheck, len(checks))}
+		for _, check := range checks {
+			details, healthy := check.Health(ctx)
+			response.Checks[check.Name()] = healthCheck{Healthy: healthy, Details: details}
+			response.Healthy = response.Healthy && healthy
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if !response.Healthy {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			s.logger.Error("Failed to write health response", "err", err)
+		}
+	})
+
+	s.logger.Debug("Registered handler", "prefix", healthPath)
+}
+
+// RegisterMetrics serves the gatherer's metrics on /metrics in the
+// Prometheus exposition format.
+func (s *Server) RegisterMetrics(gatherer prometheus.Gatherer) {
+	s.mux.Handle(metricsPath, promhttp.HandlerFor(gatherer, promhttp.HandlerOpts{}))
+
+	s.logger.Debug("Registered handler", "prefix", metricsPath)
+}
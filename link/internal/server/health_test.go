@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type healthStub struct {
+	name    string
+	healthy bool
+}
+
+func (h healthStub) Name() string { return h.name }
+
+func (h healthStub) Health(context.Context) (any, bool) { return h.name + " details", h.healthy }
+
+func TestRegisterHealth(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		checks []HealthChecker
+		status int
+	}{
+		{
+			name:   "healthy",
+			checks: []HealthChecker{healthStub{name: "a", healthy: true}, healthStub{name: "b", healthy: true}},
+			status: http.StatusOK,
+		},
+		{
+			name:   "oneUnhealthy",
+			checks: []HealthChecker{healthStub{name: "a", healthy: true}, healthStub{name: "b"}},
+			status: http.StatusServiceUnavailable,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			// ARRANGE
+			srv := New("127.0.0.1:0", false)
+			srv.RegisterHealth(tt.checks...)
+
+			recorder := httptest.NewRecorder()
+
+			// ACT
+			srv.mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, healthPath, nil))
+
+			// ASSERT
+			require.Equal(t, tt.status, recorder.Code)
+
+			var response healthResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+			assert.Equal(t, tt.status == http.StatusOK, response.Healthy)
+			assert.Len(t, response.Checks, len(tt.checks))
+			assert.Equal(t, "b details", response.Checks["b"].Details)
+		})
+	}
+}
+
+func TestRegisterMetrics(t *testing.T) {
+	// ARRANGE
+	registry := prometheus.NewRegistry()
+	gauge := prometheus.NewGauge(prometheus.GaugeOpts{Name: "test_gauge", Help: "A test gauge."})
+	registry.MustRegister(gauge)
+	gauge.Set(7)
+
+	srv := New("127.0.0.1:0", false)
+	srv.RegisterMetrics(registry)
+
+	recorder := httptest.NewRecorder()
+
+	// ACT
+	srv.mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, metricsPath, nil))
+
+	// ASSERT
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "test_gauge 7")
+}
@@ -12,6 +12,7 @@ import (
 
 	proto "github.com/cosmos/ibc/link/api/v2/relayer"
 	"github.com/cosmos/ibc/link/internal/service/relayer"
+	"github.com/cosmos/ibc/link/internal/store"
 )
 
 // RelayerHandler handles relayer RPC requests.
@@ -24,6 +25,8 @@ type RelayerHandler struct {
 type RelayerService interface {
 	Relay(ctx context.Context, request relayer.RelayRequest) error
 	Status(ctx context.Context, chainID string, txHash string) ([]relayer.PacketStatus, error)
+	AttestorEvidence(ctx context.Context, includeCleared bool) ([]store.AttestorEvidence, error)
+	ClearAttestorEvidence(ctx context.Context, address string) (int64, error)
 }
 
 var (
@@ -117,6 +120,80 @@ func (h *RelayerHandler) Status(
 	return connect.NewResponse(&proto.StatusResponse{PacketStatuses: packetStatuses}), nil
 }
 
+func (h *RelayerHandler) ListAttestorEvidence(
+	ctx context.Context,
+	req *connect.Request[proto.ListAttestorEvidenceRequest],
+) (*connect.Response[proto.ListAttestorEvidenceResponse], error) {
+	h.logger.Info("ListAttestorEvidence", "includeCleared", req.Msg.IncludeCleared)
+
+	evidence, err := h.srv.AttestorEvidence(ctx, req.Msg.IncludeCleared)
+	if err != nil {
+		// todo: move to interceptor
+		h.logger.Error("ListAttestorEvidence", "err", err)
+		return nil, errInternal
+	}
+
+	records := make([]*proto.AttestorEvidence, len(evidence))
+	for i, e := range evidence {
+		records[i] = attestorEvidenceToProto(e)
+	}
+
+	return connect.NewResponse(&proto.ListAttestorEvidenceResponse{Evidence: records}), nil
+}
+
+func (h *RelayerHandler) ClearAttestorEvidence(
+	ctx context.Context,
+	req *connect.Request[proto.ClearAttestorEvidenceRequest],
+) (*connect.Response[proto.ClearAttestorEvidenceResponse], error) {
+	h.logger.Info("ClearAttestorEvidence", "attestor", req.Msg.AttestorAddress)
+
+	cleared, err := h.srv.ClearAttestorEvidence(ctx, req.Msg.AttestorAddress)
+	switch {
+	case errors.Is(err, relayer.ErrInvalidInput):
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	case err != nil:
+		// todo: move to interceptor
+		h.logger.Error("ClearAttestorEvidence", "err", err)
+		return nil, errInternal
+	}
+
+	return connect.NewResponse(&proto.ClearAttestorEvidenceResponse{Cleared: uint64(cleared)}), nil //nolint:gosec // row counts are non-negative
+}
+
+func attestorEvidenceToProto(e store.AttestorEvidence) *proto.AttestorEvidence {
+	record := &proto.AttestorEvidence{
+		Id:              uint64(e.ID), //nolint:gosec // ids are positive
+		ChainId:         e.ChainID,
+		AttestorName:    e.AttestorName,
+		AttestorAddress: e.AttestorAddress,
+		Kind:            evidenceKindToProto(e.Kind),
+		Height:          e.Height,
+		Data:            e.Data,
+		Signature:       e.Signature,
+		QuorumData:      e.QuorumData,
+		QuorumSignature: e.QuorumSignature,
+		CreatedAt:       uint64(e.CreatedAt.Unix()), //nolint:gosec // post-epoch timestamps
+	}
+
+	if e.ClearedAt != nil {
+		clearedAt := uint64(e.ClearedAt.Unix()) //nolint:gosec // post-epoch timestamps
+		record.ClearedAt = &clearedAt
+	}
+
+	return record
+}
+
+func evidenceKindToProto(kind store.EvidenceKind) proto.EvidenceKind {
+	switch kind {
+	case store.EvidenceKindState:
+		return proto.EvidenceKind_EVIDENCE_KIND_STATE
+	case store.EvidenceKindPacket:
+		return proto.EvidenceKind_EVIDENCE_KIND_PACKET
+	default:
+		return proto.EvidenceKind_EVIDENCE_KIND_UNSPECIFIED
+	}
+}
+
 func packetStateToProto(state relayer.PacketState) proto.PacketState {
 	switch state {
 	case relayer.StateNotSelected:
@@ -5,6 +5,7 @@ package server
 import (
 	"context"
 	"testing"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/stretchr/testify/assert"
@@ -12,11 +13,13 @@ import (
 
 	proto "github.com/cosmos/ibc/link/api/v2/relayer"
 	relayerservice "github.com/cosmos/ibc/link/internal/service/relayer"
+	"github.com/cosmos/ibc/link/internal/store"
 )
 
 type relayerServiceStub struct {
-	relay  func(relayerservice.RelayRequest) error
-	status []relayerservice.PacketStatus
+	relay    func(relayerservice.RelayRequest) error
+	status   []relayerservice.PacketStatus
+	evidence []store.AttestorEvidence
 }
 
 func (s *relayerServiceStub) Relay(_ context.Context, request relayerservice.RelayRequest) error {
@@ -27,6 +30,14 @@ func (s *relayerServiceStub) Status(context.Context, string, string) ([]relayers
 	return s.status, nil
 }
 
+func (s *relayerServiceStub) AttestorEvidence(context.Context, bool) ([]store.AttestorEvidence, error) {
+	return s.evidence, nil
+}
+
+func (s *relayerServiceStub) ClearAttestorEvidence(context.Context, string) (int64, error) {
+	return int64(len(s.evidence)), nil
+}
+
 func TestRelayerHandlerRelaySelection(t *testing.T) {
 	t.Run("all", func(t *testing.T) {
 		handler := NewRelayerHandler(&relayerServiceStub{relay: func(request relayerservice.RelayRequest) error {
@@ -87,3 +98,40 @@ func TestRelayerHandlerStatusMapsNotSelected(t *testing.T) {
 	require.Len(t, response.Msg.PacketStatuses, 1)
 	assert.Equal(t, proto.PacketState_PACKET_STATE_NOT_SELECTED, response.Msg.PacketStatuses[0].State)
 }
+
+func TestRelayerHandlerListAttestorEvidence(t *testing.T) {
+	clearedAt := time.Unix(1700000100, 0)
+	handler := NewRelayerHandler(&relayerServiceStub{
+		evidence: []store.AttestorEvidence{{
+			ID:              7,
+			CreatedAt:       time.Unix(1700000000, 0),
+			ChainID:         "8453",
+			AttestorName:    "attestor-a",
+			AttestorAddress: "0xaa",
+			Kind:            store.EvidenceKindPacket,
+			Height:          100,
+			Data:            []byte("false"),
+			Signature:       []byte("sig-false"),
+			QuorumData:      []byte("true"),
+			QuorumSignature: []byte("sig-true"),
+			ClearedAt:       &clearedAt,
+		}},
+	})
+
+	response, err := handler.ListAttestorEvidence(
+		context.Background(),
+		connect.NewRequest(&proto.ListAttestorEvidenceRequest{IncludeCleared: true}),
+	)
+	require.NoError(t, err)
+	require.Len(t, response.Msg.Evidence, 1)
+
+	got := response.Msg.Evidence[0]
+	assert.EqualValues(t, 7, got.Id)
+	assert.Equal(t, proto.EvidenceKind_EVIDENCE_KIND_PACKET, got.Kind)
+	assert.EqualValues(t, 100, got.Height)
+	assert.Equal(t, []byte("false"), got.Data)
+	assert.Equal(t, []byte("sig-true"), got.QuorumSignature)
+	assert.EqualValues(t, 1700000000, got.CreatedAt)
+	require.NotNil(t, got.ClearedAt)
+	assert.EqualValues(t, 1700000100, *got.ClearedAt)
+}
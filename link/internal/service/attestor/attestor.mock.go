@@ -80,6 +80,72 @@ func (_c *MockAttestor_Address_Call) RunAndReturn(run func() string) *MockAttest
 	return _c
 }
 
+// AttestBatch provides a mock function for the type MockAttestor
+func (_mock *MockAttestor) AttestBatch(ctx context.Context, req BatchAttestationRequest) (BatchAttestation, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AttestBatch")
+	}
+
+	var r0 BatchAttestation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, BatchAttestationRequest) (BatchAttestation, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, BatchAttestationRequest) BatchAttestation); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Get(0).(BatchAttestation)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, BatchAttestationRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAttestor_AttestBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AttestBatch'
+type MockAttestor_AttestBatch_Call struct {
+	*mock.Call
+}
+
+// AttestBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req BatchAttestationRequest
+func (_e *MockAttestor_Expecter) AttestBatch(ctx any, req any) *MockAttestor_AttestBatch_Call {
+	return &MockAttestor_AttestBatch_Call{Call: _e.mock.On("AttestBatch", ctx, req)}
+}
+
+func (_c *MockAttestor_AttestBatch_Call) Run(run func(ctx context.Context, req BatchAttestationRequest)) *MockAttestor_AttestBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 BatchAttestationRequest
+		if args[1] != nil {
+			arg1 = args[1].(BatchAttestationRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAttestor_AttestBatch_Call) Return(batchAttestation BatchAttestation, err error) *MockAttestor_AttestBatch_Call {
+	_c.Call.Return(batchAttestation, err)
+	return _c
+}
+
+func (_c *MockAttestor_AttestBatch_Call) RunAndReturn(run func(ctx context.Context, req BatchAttestationRequest) (BatchAttestation, error)) *MockAttestor_AttestBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ChainID provides a mock function for the type MockAttestor
 func (_mock *MockAttestor) ChainID() string {
 	ret := _mock.Called()
@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package attestor
+
+import (
+	"sync/atomic"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+)
+
+// DefaultCacheSize is the per-cache entry bound used when an attestor's
+// config leaves cacheSize unset.
+const DefaultCacheSize = 1024
+
+// CacheStats reports a local attestor's cache hit/miss counters since start.
+type CacheStats struct {
+	// States counts StateAttestation lookups, keyed by height.
+	States CacheCounter
+	// Commitments counts per-packet commitment lookups, keyed by
+	// (height, commitment path).
+	Commitments CacheCounter
+	// Signatures counts packet attestation signature lookups, keyed by the
+	// digest of the attested data.
+	Signatures CacheCounter
+}
+
+// CacheCounter is a single cache's hit/miss tally.
+type CacheCounter struct {
+	Hits   uint64
+	Misses uint64
+}
+
+// HitRate returns hits / (hits + misses), or 0 before any lookup.
+func (c CacheCounter) HitRate() float64 {
+	total := c.Hits + c.Misses
+	if total == 0 {
+		return 0
+	}
+
+	return float64(c.Hits) / float64(total)
+}
+
+// commitmentKey identifies one commitment read: the hashed commitment path
+// at a given height.
+type commitmentKey struct {
+	height uint64
+	path   [32]byte
+}
+
+// attestationCache memoizes the chain reads and signatures behind local
+// attestations. Every entry is derived from state at an already-attestable
+// height, which never changes once observed, so entries are never
+// invalidated -- only evicted. A nil *attestationCache caches nothing.
+type attestationCache struct {
+	states      *lru.Cache[uint64, Attestation]
+	commitments *lru.Cache[commitmentKey, [32]byte]
+	signatures  *lru.Cache[[32]byte, []byte]
+
+	stateHits, stateMisses           atomic.Uint64
+	commitmentHits, commitmentMisses atomic.Uint64
+	signatureHits, signatureMisses   atomic.Uint64
+}
+
+// newAttestationCache returns a cache bounding each of its LRUs to size
+// entries; size <= 0 disables caching (returns nil).
+func newAttestationCache(size int) (*attestationCache, error) {
+	if size <= 0 {
+		return nil, nil
+	}
+
+	states, err := lru.New[uint64, Attestation](size)
+	if err != nil {
+		return nil, err
+	}
+
+	commitments, err := lru.New[commitmentKey, [32]byte](size)
+	if err != nil {
+		return nil, err
+	}
+
+	signatures, err := lru.New[[32]byte, []byte](size)
+	if err != nil {
+		return nil, err
+	}
+
+	return &attestationCache{
+		states:      states,
+		commitments: commitments,
+		signatures:  signatures,
+	}, nil
+}
+
+func (c *attestationCache) state(height uint64) (Attestation, bool) {
+	if c == nil {
+		return Attestation{}, false
+	}
+
+	return count(c.states, height, &c.stateHits, &c.stateMisses)
+}
+
+func (c *attestationCache) addState(attestation Attestation) {
+	if c != nil {
+		c.states.Add(attestation.Height, attestation)
+	}
+}
+
+func (c *attestationCache) commitment(height uint64, path [32]byte) ([32]byte, bool) {
+	if c == nil {
+		return [32]byte{}, false
+	}
+
+	return count(c.commitments, commitmentKey{height, path}, &c.commitmentHits, &c.commitmentMisses)
+}
+
+func (c *attestationCache) addCommitment(height uint64, path, commitment [32]byte) {
+	if c != nil {
+		c.commitments.Add(commitmentKey{height, path}, commitment)
+	}
+}
+
+func (c *attestationCache) signature(digest [32]byte) ([]byte, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	return count(c.signatures, digest, &c.signatureHits, &c.signatureMisses)
+}
+
+func (c *attestationCache) addSignature(digest [32]byte, signature []byte) {
+	if c != nil {
+		c.signatures.Add(digest, signature)
+	}
+}
+
+func (c *attestationCache) stats() CacheStats {
+	if c == nil {
+		return CacheStats{}
+	}
+
+	return CacheStats{
+		States:      CacheCounter{Hits: c.stateHits.Load(), Misses: c.stateMisses.Load()},
+		Commitments: CacheCounter{Hits: c.commitmentHits.Load(), Misses: c.commitmentMisses.Load()},
+		Signatures:  CacheCounter{Hits: c.signatureHits.Load(), Misses: c.signatureMisses.Load()},
+	}
+}
+
+// count looks key up in cache, tallying the outcome into hits or misses.
+func count[K comparable, V any](cache *lru.Cache[K, V], key K, hits, misses *atomic.Uint64) (V, bool) {
+	value, ok := cache.Get(key)
+	if ok {
+		hits.Add(1)
+	} else {
+		misses.Add(1)
+	}
+
+	return value, ok
+}
@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package attestor
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+const (
+	// heightPollInterval is how often a local attestor's height feed
+	// re-reads the chain while anyone is subscribed.
+	heightPollInterval = time.Second
+	heightPollTimeout  = 5 * time.Second
+)
+
+// HeightSubscriber is implemented by attestors that can push their latest
+// attestable height as it advances instead of being polled for it.
+type HeightSubscriber interface {
+	// SubscribeLatestHeight returns a channel that receives the latest
+	// attestable height, first as soon as it is known and then each time it
+	// advances. Receivers that fall behind only see the newest height. The
+	// channel is closed once ctx is done or the subscription breaks.
+	SubscribeLatestHeight(ctx context.Context) (<-chan uint64, error)
+}
+
+// heightFeed fans one LatestHeight poller out to any number of subscribers.
+// The poller only runs while there is at least one subscriber.
+type heightFeed struct {
+	poll     func(context.Context) (uint64, error)
+	interval time.Duration
+	logger   *slog.Logger
+
+	mu     sync.Mutex
+	subs   map[chan uint64]struct{}
+	latest uint64
+	stop   context.CancelFunc
+}
+
+func newHeightFeed(poll func(context.Context) (uint64, error), interval time.Duration, logger *slog.Logger) *heightFeed {
+	return &heightFeed{
+		poll:     poll,
+		interval: interval,
+		logger:   logger,
+		subs:     make(map[chan uint64]struct{}),
+	}
+}
+
+func (f *heightFeed) subscribe(ctx context.Context) <-chan uint64 {
+	ch := make(chan uint64, 1)
+
+	f.mu.Lock()
+	f.subs[ch] = struct{}{}
+	if f.latest > 0 {
+		ch <- f.latest
+	}
+	if f.stop == nil {
+		pollCtx, stop := context.WithCancel(context.Background())
+		f.stop = stop
+		go f.run(pollCtx)
+	}
+	f.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		f.unsubscribe(ch)
+	}()
+
+	return ch
+}
+
+func (f *heightFeed) unsubscribe(ch chan uint64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.subs, ch)
+	close(ch)
+
+	if len(f.subs) == 0 && f.stop != nil {
+		f.stop()
+		f.stop = nil
+	}
+}
+
+func (f *heightFeed) run(ctx context.Context) {
+	ticker := time.NewTicker(f.interval)
+	defer ticker.Stop()
+
+	for {
+		f.pollOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (f *heightFeed) pollOnce(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, heightPollTimeout)
+	defer cancel()
+
+	height, err := f.poll(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			f.logger.Debug("Unable to poll latest height", "err", err)
+		}
+
+		return
+	}
+
+	f.publish(height)
+}
+
+// publish delivers height to every subscriber if it advances the feed,
+// replacing any height a slow subscriber has not received yet.
+func (f *heightFeed) publish(height uint64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if height <= f.latest {
+		return
+	}
+
+	f.latest = height
+
+	for ch := range f.subs {
+		select {
+		case ch <- height:
+		default:
+			// only publish writes while holding mu, so after draining the
+			// stale value the buffered send cannot block
+			select {
+			case <-ch:
+			default:
+			}
+			ch <- height
+		}
+	}
+}
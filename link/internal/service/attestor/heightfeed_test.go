@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package attestor
+
+import (
+	"context"
+	"log/slog"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeightFeed(t *testing.T) {
+	var (
+		height atomic.Uint64
+		polls  atomic.Int64
+	)
+
+	height.Store(10)
+
+	feed := newHeightFeed(func(context.Context) (uint64, error) {
+		polls.Add(1)
+		return height.Load(), nil
+	}, 10*time.Millisecond, slog.Default())
+
+	t.Run("pushesCurrentThenAdvancedHeights", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		heights := feed.subscribe(ctx)
+		require.Equal(t, uint64(10), receive(t, heights))
+
+		height.Store(11)
+		require.Equal(t, uint64(11), receive(t, heights))
+	})
+
+	t.Run("lateSubscriberGetsLatestImmediately", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		heights := feed.subscribe(ctx)
+		require.Equal(t, uint64(11), receive(t, heights))
+	})
+
+	t.Run("stopsPollingWithoutSubscribers", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		heights := feed.subscribe(ctx)
+		cancel()
+
+		// drain until unsubscribe closes the channel
+		for range heights {
+		}
+
+		require.Eventually(t, func() bool {
+			feed.mu.Lock()
+			defer feed.mu.Unlock()
+			return feed.stop == nil
+		}, time.Second, 10*time.Millisecond)
+
+		before := polls.Load()
+		time.Sleep(50 * time.Millisecond)
+		require.LessOrEqual(t, polls.Load(), before+1, "at most one in-flight poll after the last unsubscribe")
+	})
+}
+
+func receive(t *testing.T, heights <-chan uint64) uint64 {
+	t.Helper()
+
+	select {
+	case height := <-heights:
+		return height
+	case <-time.After(time.Second):
+		require.FailNow(t, "no height received")
+		return 0
+	}
+}
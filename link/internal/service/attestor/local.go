@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"sync/atomic"
 
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/pkg/errors"
@@ -20,9 +21,10 @@ import (
 	v2 "github.com/cosmos/ibc/link/internal/types/v2"
 )
 
-// LocalAttestor provides attestation data from the local process.
-// Right now we support only EVM attestations. Then porting Cosmos/Solana attestors, we'd need to refactor
-// LocalAttestor into LocalEVMAttestor, LocalCosmosAttestor, LocalSolanaAttestor, etc.
+// LocalAttestor provides attestation data from the local process. It watches
+// an EVM chain and encodes and signs its attestations with its configured
+// Scheme, so they verify on whichever counterparty light client the scheme
+// targets.
 type LocalAttestor struct {
 	chainID        string
 	name           string
@@ -31,11 +33,24 @@ type LocalAttestor struct {
 
 	client chains.Client
 	signer signer.Signer
+	scheme Scheme
+
+	// cache memoizes attestations over already-attestable heights; nil when
+	// disabled via a negative cacheSize.
+	cache *attestationCache
+	// attestable is the highest height LatestHeight has reported, so
+	// requests at or below it skip the latest-height RPC.
+	attestable atomic.Uint64
+	// heights pushes LatestHeight to SubscribeLatestHeight callers.
+	heights *heightFeed
 
 	logger *slog.Logger
 }
 
-var _ Attestor = &LocalAttestor{}
+var (
+	_ Attestor         = &LocalAttestor{}
+	_ HeightSubscriber = &LocalAttestor{}
+)
 
 func NewLocal(cfg config.AttestorConfig, client chains.Client, backingSigner signer.Signer) (*LocalAttestor, error) {
 	switch {
@@ -49,19 +64,41 @@ func NewLocal(cfg config.AttestorConfig, client chains.Client, backingSigner sig
 		return nil, fmt.Errorf("client chainID mismatch: got %s, want %s", client.ChainID(), cfg.ChainID)
 	case backingSigner == nil:
 		return nil, fmt.Errorf("signer required")
-	case backingSigner.Type() != signer.ECDSA:
-		return nil, fmt.Errorf("ECDSA signer required, got %s", backingSigner.Type())
 	}
 
-	address, err := signer.PublicKeyToEVMAddress(backingSigner.PublicKey())
+	scheme, err := SchemeByName(cfg.Scheme)
+	if err != nil {
+		return nil, err
+	}
+
+	if backingSigner.Type() != scheme.KeyType() {
+		return nil, fmt.Errorf(
+			"%s signer required for %s attestations, got %s",
+			scheme.KeyType(),
+			scheme.Name(),
+			backingSigner.Type(),
+		)
+	}
+
+	address, err := scheme.Address(backingSigner.PublicKey())
 	if err != nil {
 		return nil, fmt.Errorf("derive address from signer public key: %w", err)
 	}
 
+	cacheSize := cfg.CacheSize
+	if cacheSize == 0 {
+		cacheSize = DefaultCacheSize
+	}
+
+	cache, err := newAttestationCache(cacheSize)
+	if err != nil {
+		return nil, fmt.Errorf("create attestation cache: %w", err)
+	}
+
 	fqn := attestorFQN("local", cfg.ChainID, cfg.Name)
 	logger := slog.With("module", "attestor", "name", fqn)
 
-	return &LocalAttestor{
+	a := &LocalAttestor{
 		chainID:        cfg.ChainID,
 		name:           cfg.Name,
 		address:        address,
@@ -69,9 +106,14 @@ func NewLocal(cfg config.AttestorConfig, client chains.Client, backingSigner sig
 
 		client: client,
 		signer: backingSigner,
+		scheme: scheme,
+		cache:  cache,
 
 		logger: logger,
-	}, nil
+	}
+	a.heights = newHeightFeed(a.LatestHeight, heightPollInterval, logger)
+
+	return a, nil
 }
 
 // LatestHeight returns the highest block number that is *attestable*.
@@ -83,7 +125,6 @@ func (a *LocalAttestor) LatestHeight(ctx context.Context) (uint64, error) {
 		actualHeight = v2.LatestBlock
 	}
 
-	// TODO: cache last N headers to avoid extra RPC calls
 	header, err := a.client.GetBlockHeader(ctx, actualHeight)
 	if err != nil {
 		return 0, err
@@ -100,20 +141,59 @@ func (a *LocalAttestor) LatestHeight(ctx context.Context) (uint64, error) {
 		)
 	}
 
-	return actualHeight - offset, nil
+	attestable := actualHeight - offset
+	a.raiseAttestable(attestable)
+
+	return attestable, nil
 }
 
-func (a *LocalAttestor) StateAttestation(ctx context.Context, height uint64) (Attestation, error) {
-	if err := validateHeight(height); err != nil {
-		return Attestation{}, errors.Wrapf(ErrInvalidInput, "%s", err)
+// SubscribeLatestHeight streams LatestHeight as it advances. All subscribers
+// share one chain poller.
+func (a *LocalAttestor) SubscribeLatestHeight(ctx context.Context) (<-chan uint64, error) {
+	return a.heights.subscribe(ctx), nil
+}
+
+// raiseAttestable advances the attestable watermark to height, never
+// lowering it.
+func (a *LocalAttestor) raiseAttestable(height uint64) {
+	for {
+		current := a.attestable.Load()
+		if height <= current || a.attestable.CompareAndSwap(current, height) {
+			return
+		}
+	}
+}
+
+// ensureAttestable errors with ErrNotFinalized unless height is within the
+// attestable range. Heights at or below the last reported latest height are
+// accepted without querying the chain again.
+func (a *LocalAttestor) ensureAttestable(ctx context.Context, height uint64) error {
+	if height <= a.attestable.Load() {
+		return nil
 	}
 
 	latestHeight, err := a.LatestHeight(ctx)
 	switch {
 	case err != nil:
-		return Attestation{}, errors.Wrapf(err, "get latest attestable height")
+		return errors.Wrapf(err, "get latest attestable height")
 	case height > latestHeight:
-		return Attestation{}, errors.Wrapf(ErrNotFinalized, "latest %d, requested %d", latestHeight, height)
+		return errors.Wrapf(ErrNotFinalized, "latest %d, requested %d", latestHeight, height)
+	}
+
+	return nil
+}
+
+func (a *LocalAttestor) StateAttestation(ctx context.Context, height uint64) (Attestation, error) {
+	if err := validateHeight(height); err != nil {
+		return Attestation{}, errors.Wrapf(ErrInvalidInput, "%s", err)
+	}
+
+	if cached, ok := a.cache.state(height); ok {
+		return cached, nil
+	}
+
+	if err := a.ensureAttestable(ctx, height); err != nil {
+		return Attestation{}, err
 	}
 
 	header, err := a.client.GetBlockHeader(ctx, height)
@@ -121,22 +201,25 @@ func (a *LocalAttestor) StateAttestation(ctx context.Context, height uint64) (At
 		return Attestation{}, errors.Wrapf(err, "get header at height %d", height)
 	}
 
-	attestedData, err := evm.EncodeStateAttestation(height, uint64(header.Timestamp.Unix()))
+	attestedData, err := a.scheme.EncodeState(height, uint64(header.Timestamp.Unix()))
 	if err != nil {
 		return Attestation{}, err
 	}
 
-	signature, err := evm.SignABI(ctx, a.signer, evm.TagStateAttestation, attestedData)
+	signature, err := a.scheme.Sign(ctx, a.signer, KindState, attestedData)
 	if err != nil {
 		return Attestation{}, fmt.Errorf("sign state attestation: %w", err)
 	}
 
-	return Attestation{
+	attestation := Attestation{
 		Height:       height,
 		Timestamp:    &header.Timestamp,
 		AttestedData: attestedData,
 		Signature:    signature,
-	}, nil
+	}
+	a.cache.addState(attestation)
+
+	return attestation, nil
 }
 
 func (a *LocalAttestor) PacketAttestation(ctx context.Context, req PacketAttestationRequest) (Attestation, error) {
@@ -156,12 +239,8 @@ func (a *LocalAttestor) PacketAttestation(ctx context.Context, req PacketAttesta
 	}
 
 	// 3. ensure height is in within attestable range
-	latestHeight, err := a.LatestHeight(ctx)
-	switch {
-	case err != nil:
-		return Attestation{}, errors.Wrapf(err, "get latest attestable height")
-	case req.Height > latestHeight:
-		return Attestation{}, errors.Wrapf(ErrNotFinalized, "latest %d, requested %d", latestHeight, req.Height)
+	if err := a.ensureAttestable(ctx, req.Height); err != nil {
+		return Attestation{}, err
 	}
 
 	// 4. convert packets to their "compact" form
@@ -175,14 +254,14 @@ func (a *LocalAttestor) PacketAttestation(ctx context.Context, req PacketAttesta
 	}
 
 	// 5. encode & sign the attested data
-	attestedData, err := evm.EncodePacketAttestation(req.Height, compacts)
+	attestedData, err := a.scheme.EncodePackets(req.Height, compacts)
 	if err != nil {
 		return Attestation{}, err
 	}
 
-	signature, err := evm.SignABI(ctx, a.signer, evm.TagPacketAttestation, attestedData)
+	signature, err := a.signPacketAttestation(ctx, attestedData)
 	if err != nil {
-		return Attestation{}, fmt.Errorf("sign packet attestation: %w", err)
+		return Attestation{}, err
 	}
 
 	return Attestation{
@@ -193,6 +272,29 @@ func (a *LocalAttestor) PacketAttestation(ctx context.Context, req PacketAttesta
 	}, nil
 }
 
+// AttestBatch attests state and packets at one height. Locally there is no
+// round-trip to save, so it composes the single-purpose calls.
+func (a *LocalAttestor) AttestBatch(ctx context.Context, req BatchAttestationRequest) (BatchAttestation, error) {
+	return attestSeparately(ctx, a, req)
+}
+
+// signPacketAttestation signs attestedData, reusing the signature of an
+// identical earlier request when cached.
+func (a *LocalAttestor) signPacketAttestation(ctx context.Context, attestedData []byte) ([]byte, error) {
+	digest := [32]byte(crypto.Keccak256Hash(attestedData))
+	if signature, ok := a.cache.signature(digest); ok {
+		return signature, nil
+	}
+
+	signature, err := a.scheme.Sign(ctx, a.signer, KindPacket, attestedData)
+	if err != nil {
+		return nil, fmt.Errorf("sign packet attestation: %w", err)
+	}
+	a.cache.addSignature(digest, signature)
+
+	return signature, nil
+}
+
 func (a *LocalAttestor) packetCompact(
 	ctx context.Context,
 	height uint64,
@@ -212,9 +314,9 @@ func (a *LocalAttestor) packetCompact(
 	}
 
 	pathHash := [32]byte(crypto.Keccak256Hash(path))
-	commitment, err := a.client.GetCommitment(ctx, height, pathHash)
+	commitment, err := a.commitment(ctx, height, pathHash)
 	if err != nil {
-		return evm.PacketCompact{}, errors.Wrapf(err, "get commitment at height %d", height)
+		return evm.PacketCompact{}, err
 	}
 
 	switch commitmentType {
@@ -261,11 +363,34 @@ func (a *LocalAttestor) packetCompact(
 	}, nil
 }
 
+// commitment reads the commitment stored under pathHash at height, serving
+// repeat reads from the cache. The caller still checks the value against the
+// requested packet, so a cached read is only reused, never its verdict.
+func (a *LocalAttestor) commitment(ctx context.Context, height uint64, pathHash [32]byte) ([32]byte, error) {
+	if commitment, ok := a.cache.commitment(height, pathHash); ok {
+		return commitment, nil
+	}
+
+	commitment, err := a.client.GetCommitment(ctx, height, pathHash)
+	if err != nil {
+		return [32]byte{}, errors.Wrapf(err, "get commitment at height %d", height)
+	}
+	a.cache.addCommitment(height, pathHash, commitment)
+
+	return commitment, nil
+}
+
+// CacheStats reports the attestor's cache hit/miss counters.
+func (a *LocalAttestor) CacheStats() CacheStats { return a.cache.stats() }
+
 func (a *LocalAttestor) Name() string    { return a.name }
 func (a *LocalAttestor) ChainID() string { return a.chainID }
 func (a *LocalAttestor) IsLocal() bool   { return true }
 func (a *LocalAttestor) Address() string { return a.address }
 
+// Scheme returns the scheme the attestor encodes and signs with.
+func (a *LocalAttestor) Scheme() Scheme { return a.scheme }
+
 func attestorFQN(connection, chainID, name string) string {
 	return fmt.Sprintf("%s-%s-%s", chainID, connection, name)
 }
@@ -6,6 +6,7 @@ import (
 	"context"
 	"crypto/rand"
 	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"testing"
 	"time"
@@ -18,6 +19,7 @@ import (
 
 	channeltypesv2 "github.com/cosmos/ibc-go/v11/modules/core/04-channel/v2/types"
 	hostv2 "github.com/cosmos/ibc-go/v11/modules/core/24-host/v2"
+	attestoreddsa "github.com/cosmos/ibc/link/attestor/eddsa"
 	attestorevm "github.com/cosmos/ibc/link/attestor/evm"
 	"github.com/cosmos/ibc/link/attestor/evm/ibc"
 	"github.com/cosmos/ibc/link/internal/chains"
@@ -41,6 +43,7 @@ func TestLocal(t *testing.T) {
 			chainID      string
 			client       chains.Client
 			signer       signer.Signer
+			scheme       config.AttestationScheme
 
 			errContains string
 		}{
@@ -73,7 +76,24 @@ func TestLocal(t *testing.T) {
 				chainID:      "chain-1",
 				client:       stubChainClient(t, "chain-1"),
 				signer:       eddsaSigner,
-				errContains:  "ECDSA signer required, got eddsa",
+				errContains:  "ecdsa signer required for evm attestations, got eddsa",
+			},
+			{
+				name:         "eddsaScheme",
+				attestorName: "alice",
+				chainID:      "chain-1",
+				client:       stubChainClient(t, "chain-1"),
+				signer:       eddsaSigner,
+				scheme:       config.AttestationSchemeEdDSA,
+			},
+			{
+				name:         "eddsaSchemeECDSASigner",
+				attestorName: "alice",
+				chainID:      "chain-1",
+				client:       stubChainClient(t, "chain-1"),
+				signer:       ecdsaSigner,
+				scheme:       config.AttestationSchemeEdDSA,
+				errContains:  "eddsa signer required for eddsa attestations, got ecdsa",
 			},
 			{
 				name:         "emptyChainID",
@@ -105,6 +125,7 @@ func TestLocal(t *testing.T) {
 				cfg := config.AttestorConfig{
 					ChainID: tt.chainID,
 					Name:    tt.attestorName,
+					Scheme:  tt.scheme,
 				}
 				attestor, err := NewLocal(cfg, tt.client, tt.signer)
 
@@ -244,6 +265,44 @@ func TestLocal(t *testing.T) {
 			assertSignatureFromSigner(t, ecdsaSigner, expectedDigest, result.Signature)
 		})
 
+		t.Run("signsWithEdDSAScheme", func(t *testing.T) {
+			// ARRANGE
+			client := stubChainClient(t, "chain-1")
+			client.EXPECT().
+				GetBlockHeader(mock.Anything, uint64(v2.FinalizedBlock)).
+				Return(v2.BlockHeader{Height: 100}, nil).
+				Once()
+			client.EXPECT().
+				GetBlockHeader(mock.Anything, uint64(42)).
+				Return(v2.BlockHeader{Height: 42, Timestamp: time.Unix(1_700_000_000, 0).UTC()}, nil).
+				Once()
+
+			attestor, err := NewLocal(config.AttestorConfig{
+				ChainID: "chain-1",
+				Name:    "alice",
+				Scheme:  config.AttestationSchemeEdDSA,
+			}, client, eddsaSigner)
+			require.NoError(t, err)
+
+			// ACT
+			result, err := attestor.StateAttestation(context.Background(), 42)
+
+			// ASSERT
+			require.NoError(t, err)
+			assert.Equal(t, attestoreddsa.EncodeStateAttestation(42, 1_700_000_000), result.AttestedData)
+			assert.Equal(t, "0x"+hex.EncodeToString(eddsaSigner.PublicKey()), attestor.Address())
+			require.NoError(t, attestoreddsa.Verify(
+				eddsaSigner.PublicKey(),
+				attestoreddsa.TagStateAttestation,
+				result.AttestedData,
+				result.Signature,
+			))
+
+			signer, err := SchemeEdDSA.Verify(attestor.Address(), KindState, result.AttestedData, result.Signature)
+			require.NoError(t, err)
+			assert.Equal(t, attestor.Address(), signer)
+		})
+
 		t.Run("rejectsUnfinalizedHeight", func(t *testing.T) {
 			// ARRANGE
 			client := stubChainClient(t, "chain-1")
@@ -586,6 +645,168 @@ func TestLocal(t *testing.T) {
 			}
 		})
 	})
+
+	t.Run("Cache", func(t *testing.T) {
+		validPacket := sampleEvmPacket(t)
+		decodedPacket, err := ibc.DecodePacket(validPacket)
+		require.NoError(t, err)
+		pathHash := [32]byte(
+			crypto.Keccak256Hash(hostv2.PacketCommitmentKey(decodedPacket.SourceClient, decodedPacket.Sequence)),
+		)
+		packetCommitment := [32]byte(channeltypesv2.CommitPacket(decodedPacket))
+
+		t.Run("servesRepeatStateAttestation", func(t *testing.T) {
+			// ARRANGE
+			const height = uint64(42)
+			client := stubChainClient(t, "chain-1")
+			client.EXPECT().
+				GetBlockHeader(mock.Anything, uint64(v2.FinalizedBlock)).
+				Return(v2.BlockHeader{Height: 100}, nil).
+				Once()
+			client.EXPECT().
+				GetBlockHeader(mock.Anything, height).
+				Return(v2.BlockHeader{Height: height, Timestamp: time.Unix(1_700_000_000, 0).UTC()}, nil).
+				Once()
+
+			attestor, err := NewLocal(config.AttestorConfig{ChainID: "chain-1", Name: "alice"}, client, ecdsaSigner)
+			require.NoError(t, err)
+
+			// ACT
+			first, err := attestor.StateAttestation(context.Background(), height)
+			require.NoError(t, err)
+			second, err := attestor.StateAttestation(context.Background(), height)
+			require.NoError(t, err)
+
+			// ASSERT
+			assert.Equal(t, first, second)
+			assert.Equal(t, CacheCounter{Hits: 1, Misses: 1}, attestor.CacheStats().States)
+		})
+
+		t.Run("skipsLatestHeightBelowWatermark", func(t *testing.T) {
+			// ARRANGE
+			client := stubChainClient(t, "chain-1")
+			client.EXPECT().
+				GetBlockHeader(mock.Anything, uint64(v2.FinalizedBlock)).
+				Return(v2.BlockHeader{Height: 100}, nil).
+				Once()
+			for _, height := range []uint64{42, 43} {
+				client.EXPECT().
+					GetBlockHeader(mock.Anything, height).
+					Return(v2.BlockHeader{Height: height, Timestamp: time.Unix(1_700_000_000, 0).UTC()}, nil).
+					Once()
+			}
+
+			attestor, err := NewLocal(config.AttestorConfig{ChainID: "chain-1", Name: "alice"}, client, ecdsaSigner)
+			require.NoError(t, err)
+
+			// ACT
+			_, err = attestor.StateAttestation(context.Background(), 42)
+			require.NoError(t, err)
+			_, err = attestor.StateAttestation(context.Background(), 43)
+
+			// ASSERT
+			require.NoError(t, err)
+			assert.Equal(t, CacheCounter{Hits: 0, Misses: 2}, attestor.CacheStats().States)
+		})
+
+		t.Run("servesRepeatPacketAttestation", func(t *testing.T) {
+			// ARRANGE
+			const height = uint64(10)
+			client := stubChainClient(t, "chain-1")
+			client.EXPECT().
+				GetBlockHeader(mock.Anything, uint64(v2.FinalizedBlock)).
+				Return(v2.BlockHeader{Height: height}, nil).
+				Once()
+			client.EXPECT().
+				GetCommitment(mock.Anything, height, pathHash).
+				Return(packetCommitment, nil).
+				Once()
+
+			attestor, err := NewLocal(config.AttestorConfig{ChainID: "chain-1", Name: "alice"}, client, ecdsaSigner)
+			require.NoError(t, err)
+
+			req := PacketAttestationRequest{
+				Height:         height,
+				Packets:        [][]byte{validPacket},
+				CommitmentType: CommitmentTypePacket,
+			}
+
+			// ACT
+			first, err := attestor.PacketAttestation(context.Background(), req)
+			require.NoError(t, err)
+			second, err := attestor.PacketAttestation(context.Background(), req)
+			require.NoError(t, err)
+
+			// ASSERT
+			assert.Equal(t, first, second)
+			stats := attestor.CacheStats()
+			assert.Equal(t, CacheCounter{Hits: 1, Misses: 1}, stats.Commitments)
+			assert.Equal(t, CacheCounter{Hits: 1, Misses: 1}, stats.Signatures)
+			assert.InDelta(t, 0.5, stats.Commitments.HitRate(), 1e-9)
+		})
+
+		t.Run("reverifiesCachedCommitment", func(t *testing.T) {
+			// ARRANGE
+			const height = uint64(10)
+			client := stubChainClient(t, "chain-1")
+			client.EXPECT().
+				GetBlockHeader(mock.Anything, uint64(v2.FinalizedBlock)).
+				Return(v2.BlockHeader{Height: height}, nil).
+				Once()
+			client.EXPECT().
+				GetCommitment(mock.Anything, height, pathHash).
+				Return([32]byte{1}, nil).
+				Once()
+
+			attestor, err := NewLocal(config.AttestorConfig{ChainID: "chain-1", Name: "alice"}, client, ecdsaSigner)
+			require.NoError(t, err)
+
+			req := PacketAttestationRequest{
+				Height:         height,
+				Packets:        [][]byte{validPacket},
+				CommitmentType: CommitmentTypePacket,
+			}
+
+			// ACT
+			_, firstErr := attestor.PacketAttestation(context.Background(), req)
+			_, secondErr := attestor.PacketAttestation(context.Background(), req)
+
+			// ASSERT
+			require.ErrorContains(t, firstErr, "packet commitment mismatch")
+			require.ErrorContains(t, secondErr, "packet commitment mismatch")
+			assert.Equal(t, CacheCounter{Hits: 1, Misses: 1}, attestor.CacheStats().Commitments)
+		})
+
+		t.Run("disabled", func(t *testing.T) {
+			// ARRANGE
+			const height = uint64(42)
+			client := stubChainClient(t, "chain-1")
+			client.EXPECT().
+				GetBlockHeader(mock.Anything, uint64(v2.FinalizedBlock)).
+				Return(v2.BlockHeader{Height: 100}, nil).
+				Once()
+			client.EXPECT().
+				GetBlockHeader(mock.Anything, height).
+				Return(v2.BlockHeader{Height: height, Timestamp: time.Unix(1_700_000_000, 0).UTC()}, nil).
+				Twice()
+
+			attestor, err := NewLocal(
+				config.AttestorConfig{ChainID: "chain-1", Name: "alice", CacheSize: -1},
+				client,
+				ecdsaSigner,
+			)
+			require.NoError(t, err)
+
+			// ACT
+			_, err = attestor.StateAttestation(context.Background(), height)
+			require.NoError(t, err)
+			_, err = attestor.StateAttestation(context.Background(), height)
+
+			// ASSERT
+			require.NoError(t, err)
+			assert.Equal(t, CacheStats{}, attestor.CacheStats())
+		})
+	})
 }
 
 func stubChainClient(t *testing.T, chainID string) *mocks.MockClient {
@@ -6,6 +6,7 @@ import (
 	"context"
 	"log/slog"
 	"net/http"
+	"sync/atomic"
 	"time"
 
 	"connectrpc.com/connect"
@@ -22,9 +23,17 @@ type RemoteAttestor struct {
 	address string
 	client  proto.AttestationServiceClient
 	logger  *slog.Logger
+
+	// batchUnsupported is set once the remote answers AttestBatch with
+	// Unimplemented (an attestor predating the RPC); later batches go
+	// straight to the single-purpose RPCs.
+	batchUnsupported atomic.Bool
 }
 
-var _ Attestor = &RemoteAttestor{}
+var (
+	_ Attestor         = &RemoteAttestor{}
+	_ HeightSubscriber = &RemoteAttestor{}
+)
 
 const remoteRequestTimeout = 5 * time.Second
 
@@ -83,6 +92,40 @@ func (a *RemoteAttestor) LatestHeight(ctx context.Context) (uint64, error) {
 	return res.Msg.Height, nil
 }
 
+// SubscribeLatestHeight opens a SubscribeLatestHeight stream. The returned
+// channel closes when the stream ends for any reason; callers resubscribe.
+func (a *RemoteAttestor) SubscribeLatestHeight(ctx context.Context) (<-chan uint64, error) {
+	req := &proto.SubscribeLatestHeightRequest{
+		Attestor: a.name,
+	}
+
+	stream, err := a.client.SubscribeLatestHeight(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+
+	heights := make(chan uint64)
+
+	go func() {
+		defer close(heights)
+		defer stream.Close()
+
+		for stream.Receive() {
+			select {
+			case heights <- stream.Msg().Height:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		if err := stream.Err(); err != nil && ctx.Err() == nil {
+			a.logger.Debug("Latest height stream ended", "err", err)
+		}
+	}()
+
+	return heights, nil
+}
+
 func (a *RemoteAttestor) StateAttestation(ctx context.Context, height uint64) (Attestation, error) {
 	ctx, cancel := context.WithTimeout(ctx, remoteRequestTimeout)
 	defer cancel()
@@ -124,6 +167,54 @@ func (a *RemoteAttestor) PacketAttestation(ctx context.Context, req PacketAttest
 	return attestationFromProto(res.Msg.Attestation)
 }
 
+func (a *RemoteAttestor) AttestBatch(ctx context.Context, req BatchAttestationRequest) (BatchAttestation, error) {
+	if !a.batchUnsupported.Load() {
+		batch, err := a.attestBatch(ctx, req)
+		if connect.CodeOf(err) != connect.CodeUnimplemented {
+			return batch, err
+		}
+
+		a.logger.Info("Attestor does not support AttestBatch, falling back to separate RPCs")
+		a.batchUnsupported.Store(true)
+	}
+
+	return attestSeparately(ctx, a, req)
+}
+
+func (a *RemoteAttestor) attestBatch(ctx context.Context, req BatchAttestationRequest) (BatchAttestation, error) {
+	ctx, cancel := context.WithTimeout(ctx, remoteRequestTimeout)
+	defer cancel()
+
+	ct, err := CommitmentTypeToProto(req.CommitmentType)
+	if err != nil {
+		return BatchAttestation{}, err
+	}
+
+	protoReq := &proto.AttestBatchRequest{
+		Attestor:       a.name,
+		Height:         req.Height,
+		Packets:        req.Packets,
+		CommitmentType: ct,
+	}
+
+	res, err := a.client.AttestBatch(ctx, connect.NewRequest(protoReq))
+	if err != nil {
+		return BatchAttestation{}, err
+	}
+
+	state, err := attestationFromProto(res.Msg.State)
+	if err != nil {
+		return BatchAttestation{}, errors.Wrap(err, "state attestation")
+	}
+
+	packets, err := attestationFromProto(res.Msg.Packets)
+	if err != nil {
+		return BatchAttestation{}, errors.Wrap(err, "packet attestation")
+	}
+
+	return BatchAttestation{State: state, Packets: packets}, nil
+}
+
 func (a *RemoteAttestor) Name() string    { return a.name }
 func (a *RemoteAttestor) ChainID() string { return a.chainID }
 func (a *RemoteAttestor) IsLocal() bool   { return false }
@@ -34,10 +34,78 @@ func TestRemoteAttestorRequestTimeout(t *testing.T) {
 		CommitmentType: CommitmentTypePacket,
 	})
 	require.NoError(t, err)
+	_, err = remote.AttestBatch(context.Background(), BatchAttestationRequest{
+		Height:         1,
+		CommitmentType: CommitmentTypePacket,
+	})
+	require.NoError(t, err)
 	_, err = queryAttestorInfo(context.Background(), client, "name")
 	require.NoError(t, err)
 }
 
+func TestRemoteAttestorAttestBatchFallback(t *testing.T) {
+	// ARRANGE
+	client := &legacyAttestationClient{latest: 42}
+	remote := newTestRemoteAttestor("name", client)
+	req := BatchAttestationRequest{
+		Packets:        [][]byte{{0x01}},
+		CommitmentType: CommitmentTypePacket,
+	}
+
+	for range 2 {
+		// ACT
+		batch, err := remote.AttestBatch(context.Background(), req)
+
+		// ASSERT
+		require.NoError(t, err)
+		require.Equal(t, uint64(42), batch.State.Height)
+		require.Equal(t, uint64(42), batch.Packets.Height)
+	}
+
+	// the batch RPC is only probed once
+	require.Equal(t, 1, client.batchCalls)
+}
+
+// legacyAttestationClient mimics an attestor that predates AttestBatch.
+type legacyAttestationClient struct {
+	timeoutAttestationClient
+	latest     uint64
+	batchCalls int
+}
+
+func (c *legacyAttestationClient) LatestHeight(
+	_ context.Context,
+	_ *connect.Request[proto.LatestHeightRequest],
+) (*connect.Response[proto.LatestHeightResponse], error) {
+	return connect.NewResponse(&proto.LatestHeightResponse{Height: c.latest}), nil
+}
+
+func (c *legacyAttestationClient) StateAttestation(
+	_ context.Context,
+	req *connect.Request[proto.StateAttestationRequest],
+) (*connect.Response[proto.StateAttestationResponse], error) {
+	return connect.NewResponse(&proto.StateAttestationResponse{
+		Attestation: &proto.Attestation{Height: req.Msg.Height},
+	}), nil
+}
+
+func (c *legacyAttestationClient) PacketAttestation(
+	_ context.Context,
+	req *connect.Request[proto.PacketAttestationRequest],
+) (*connect.Response[proto.PacketAttestationResponse], error) {
+	return connect.NewResponse(&proto.PacketAttestationResponse{
+		Attestation: &proto.Attestation{Height: req.Msg.Height},
+	}), nil
+}
+
+func (c *legacyAttestationClient) AttestBatch(
+	_ context.Context,
+	_ *connect.Request[proto.AttestBatchRequest],
+) (*connect.Response[proto.AttestBatchResponse], error) {
+	c.batchCalls++
+	return nil, connect.NewError(connect.CodeUnimplemented, nil)
+}
+
 type timeoutAttestationClient struct {
 	t *testing.T
 }
@@ -66,6 +134,24 @@ func (c timeoutAttestationClient) PacketAttestation(
 	return connect.NewResponse(&proto.PacketAttestationResponse{Attestation: &proto.Attestation{}}), nil
 }
 
+func (c timeoutAttestationClient) AttestBatch(
+	ctx context.Context,
+	_ *connect.Request[proto.AttestBatchRequest],
+) (*connect.Response[proto.AttestBatchResponse], error) {
+	c.requireTimeout(ctx)
+	return connect.NewResponse(&proto.AttestBatchResponse{
+		State:   &proto.Attestation{},
+		Packets: &proto.Attestation{},
+	}), nil
+}
+
+func (c timeoutAttestationClient) SubscribeLatestHeight(
+	_ context.Context,
+	_ *connect.Request[proto.SubscribeLatestHeightRequest],
+) (*connect.ServerStreamForClient[proto.SubscribeLatestHeightResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, nil)
+}
+
 func (c timeoutAttestationClient) Info(
 	ctx context.Context,
 	_ *connect.Request[proto.InfoRequest],
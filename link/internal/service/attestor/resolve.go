@@ -16,7 +16,9 @@ import (
 
 // ResolveFromConfig resolves every entry in the unified attestors[] config
 // list into a live Attestor, split by whether it runs in this process
-// (local) or is queried over gRPC (remote).
+// (local) or is queried over gRPC (remote). A local attestor with a
+// nextSigner resolves to two attestors, the second serving the next key
+// under its NextName.
 func ResolveFromConfig(
 	ctx context.Context,
 	entries config.Attestors,
@@ -32,6 +34,21 @@ func ResolveFromConfig(
 			}
 
 			local = append(local, a)
+
+			if entry.NextSigner == "" {
+				continue
+			}
+
+			// mid key rotation: serve the next key alongside the current one
+			next := entry
+			next.Name, next.Signer, next.NextSigner = entry.NextName(), entry.NextSigner, ""
+
+			a, errLocal = resolveLocal(next, clients, signers)
+			if errLocal != nil {
+				return nil, nil, fmt.Errorf("attestor %s: next signer: %w", entry.Name, errLocal)
+			}
+
+			local = append(local, a)
 		case config.AttestorTypeRemote:
 			a, errRemote := resolveRemote(ctx, entry)
 			if errRemote != nil {
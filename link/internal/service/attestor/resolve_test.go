@@ -38,6 +38,34 @@ func TestResolveFromConfig(t *testing.T) {
 		require.Empty(t, remote, "an unreachable remote is skipped, not fatal")
 	})
 
+	t.Run("localRotatingServesBothKeys", func(t *testing.T) {
+		current, err := signer.GenerateLocalSecp256k1Signer()
+		require.NoError(t, err)
+		next, err := signer.GenerateLocalSecp256k1Signer()
+		require.NoError(t, err)
+
+		signers := signer.NewSet()
+		signers.Set("current", current)
+		signers.Set("next", next)
+
+		clients := chains.NewClientSet(map[string]chains.Client{"1": stubChainClient(t, "1")})
+
+		entries := config.Attestors{
+			{Name: "alice", Type: config.AttestorTypeLocal, ChainID: "1", Signer: "current", NextSigner: "next"},
+		}
+
+		local, _, err := ResolveFromConfig(ctx, entries, clients, signers)
+
+		require.NoError(t, err)
+		require.Len(t, local, 2)
+		require.Equal(t, "alice", local[0].Name())
+		require.Equal(t, "alice-next", local[1].Name())
+
+		nextAddress, err := signer.PublicKeyToEVMAddress(next.PublicKey())
+		require.NoError(t, err)
+		require.Equal(t, nextAddress, local[1].Address())
+	})
+
 	t.Run("localMissingClientErrorsFatally", func(t *testing.T) {
 		signers := signer.NewSet()
 		s, err := signer.GenerateLocalSecp256k1Signer()
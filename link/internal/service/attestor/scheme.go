@@ -0,0 +1,195 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package attestor
+
+import (
+	"context"
+	"crypto/ed25519"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/cosmos/ibc/link/attestor/eddsa"
+	"github.com/cosmos/ibc/link/attestor/evm"
+	"github.com/cosmos/ibc/link/internal/config"
+	"github.com/cosmos/ibc/link/internal/service/signer"
+	"github.com/cosmos/ibc/link/keyfile"
+)
+
+// AttestationKind the claim an attestation makes, which selects the domain
+// it is signed under.
+type AttestationKind int
+
+const (
+	KindState AttestationKind = iota
+	KindPacket
+)
+
+// Scheme how attestations are encoded, signed and verified for one family of
+// counterparty light clients. Every attestor in a quorum must share one.
+type Scheme interface {
+	Name() config.AttestationScheme
+
+	// KeyType the signer key type the scheme signs with.
+	KeyType() keyfile.Type
+	// Address derives the attestor address of a signer's public key, as
+	// listed in the on-chain attestation set.
+	Address(publicKey []byte) (string, error)
+
+	EncodeState(height, timestamp uint64) ([]byte, error)
+	DecodeState(data []byte) (height, timestamp uint64, err error)
+	EncodePackets(height uint64, packets []evm.PacketCompact) ([]byte, error)
+	// DecodePackets returns the attested height and packet count.
+	DecodePackets(data []byte) (height uint64, count int, err error)
+	// EncodeProof combines attested data and its quorum's signatures into
+	// the proof the counterparty light client verifies.
+	EncodeProof(data []byte, signatures [][]byte) ([]byte, error)
+
+	Sign(ctx context.Context, s signer.Signer, kind AttestationKind, data []byte) ([]byte, error)
+	// Verify checks sig is a valid kind signature over data by the attestor
+	// reporting address, and returns the signer's address. Schemes that
+	// can recover the signer from sig may return a different address, which
+	// the quorum then counts instead.
+	Verify(address string, kind AttestationKind, data, sig []byte) (string, error)
+}
+
+var (
+	SchemeEVM   Scheme = evmScheme{}
+	SchemeEdDSA Scheme = eddsaScheme{}
+)
+
+// SchemeByName resolves a configured scheme; empty selects SchemeEVM.
+func SchemeByName(name config.AttestationScheme) (Scheme, error) {
+	switch name {
+	case "", config.AttestationSchemeEVM:
+		return SchemeEVM, nil
+	case config.AttestationSchemeEdDSA:
+		return SchemeEdDSA, nil
+	default:
+		return nil, errors.Errorf("unknown attestation scheme %q", name)
+	}
+}
+
+// SchemeOf infers an attestor's scheme from its address, which works for
+// remote attestors too: a 32-byte address is an ed25519 public key, anything
+// else is taken for SchemeEVM.
+func SchemeOf(address string) Scheme {
+	if len(strings.TrimPrefix(address, "0x")) == 2*ed25519.PublicKeySize {
+		return SchemeEdDSA
+	}
+
+	return SchemeEVM
+}
+
+type evmScheme struct{}
+
+func (evmScheme) Name() config.AttestationScheme { return config.AttestationSchemeEVM }
+func (evmScheme) KeyType() keyfile.Type          { return keyfile.ECDSA }
+
+func (evmScheme) Address(publicKey []byte) (string, error) {
+	return signer.PublicKeyToEVMAddress(publicKey)
+}
+
+func (evmScheme) EncodeState(height, timestamp uint64) ([]byte, error) {
+	return evm.EncodeStateAttestation(height, timestamp)
+}
+
+func (evmScheme) DecodeState(data []byte) (uint64, uint64, error) {
+	return evm.DecodeStateAttestation(data)
+}
+
+func (evmScheme) EncodePackets(height uint64, packets []evm.PacketCompact) ([]byte, error) {
+	return evm.EncodePacketAttestation(height, packets)
+}
+
+func (evmScheme) DecodePackets(data []byte) (uint64, int, error) {
+	height, packets, err := evm.DecodePacketAttestation(data)
+	return height, len(packets), err
+}
+
+func (evmScheme) EncodeProof(data []byte, signatures [][]byte) ([]byte, error) {
+	return evm.EncodeAttestationProof(data, signatures)
+}
+
+func (evmScheme) Sign(ctx context.Context, s signer.Signer, kind AttestationKind, data []byte) ([]byte, error) {
+	return evm.SignABI(ctx, s, evmTag(kind), data)
+}
+
+// Verify recovers the signer from sig; address is not consulted.
+func (evmScheme) Verify(_ string, kind AttestationKind, data, sig []byte) (string, error) {
+	recovered, err := evm.RecoverSigner(evm.Digest(evmTag(kind), data), sig)
+	if err != nil {
+		return "", err
+	}
+
+	return recovered.Hex(), nil
+}
+
+func evmTag(kind AttestationKind) byte {
+	if kind == KindPacket {
+		return evm.TagPacketAttestation
+	}
+
+	return evm.TagStateAttestation
+}
+
+type eddsaScheme struct{}
+
+func (eddsaScheme) Name() config.AttestationScheme { return config.AttestationSchemeEdDSA }
+func (eddsaScheme) KeyType() keyfile.Type          { return keyfile.EDDSA }
+
+func (eddsaScheme) Address(publicKey []byte) (string, error) {
+	return eddsa.Address(publicKey)
+}
+
+func (eddsaScheme) EncodeState(height, timestamp uint64) ([]byte, error) {
+	return eddsa.EncodeStateAttestation(height, timestamp), nil
+}
+
+func (eddsaScheme) DecodeState(data []byte) (uint64, uint64, error) {
+	return eddsa.DecodeStateAttestation(data)
+}
+
+func (eddsaScheme) EncodePackets(height uint64, packets []evm.PacketCompact) ([]byte, error) {
+	compacts := make([]eddsa.PacketCompact, len(packets))
+	for i, packet := range packets {
+		compacts[i] = eddsa.PacketCompact{Path: packet.Path, Commitment: packet.Commitment}
+	}
+
+	return eddsa.EncodePacketAttestation(height, compacts), nil
+}
+
+func (eddsaScheme) DecodePackets(data []byte) (uint64, int, error) {
+	height, packets, err := eddsa.DecodePacketAttestation(data)
+	return height, len(packets), err
+}
+
+func (eddsaScheme) EncodeProof(data []byte, signatures [][]byte) ([]byte, error) {
+	return eddsa.EncodeAttestationProof(data, signatures)
+}
+
+func (eddsaScheme) Sign(ctx context.Context, s signer.Signer, kind AttestationKind, data []byte) ([]byte, error) {
+	return eddsa.Sign(ctx, s, eddsaTag(kind), data)
+}
+
+// Verify checks sig against the public key address encodes.
+func (eddsaScheme) Verify(address string, kind AttestationKind, data, sig []byte) (string, error) {
+	publicKey, err := eddsa.PublicKey(address)
+	if err != nil {
+		return "", err
+	}
+
+	if err := eddsa.Verify(publicKey, eddsaTag(kind), data, sig); err != nil {
+		return "", errors.Wrapf(err, "verify signature by %s", address)
+	}
+
+	return strings.ToLower(address), nil
+}
+
+func eddsaTag(kind AttestationKind) string {
+	if kind == KindPacket {
+		return eddsa.TagPacketAttestation
+	}
+
+	return eddsa.TagStateAttestation
+}
@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package attestor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc/link/attestor/evm"
+	"github.com/cosmos/ibc/link/internal/config"
+	"github.com/cosmos/ibc/link/internal/service/signer"
+)
+
+func TestScheme(t *testing.T) {
+	ctx := context.Background()
+
+	eddsaSigner, err := signer.GenerateLocalEd25519Signer()
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		name   string
+		scheme Scheme
+		signer signer.Signer
+	}{
+		{name: "evm", scheme: SchemeEVM, signer: generateECDSASigner(t)},
+		{name: "eddsa", scheme: SchemeEdDSA, signer: eddsaSigner},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			// ARRANGE
+			address, err := tt.scheme.Address(tt.signer.PublicKey())
+			require.NoError(t, err)
+
+			packets := []evm.PacketCompact{{Path: [32]byte{0x01}, Commitment: [32]byte{0x02}}}
+			data, err := tt.scheme.EncodePackets(42, packets)
+			require.NoError(t, err)
+
+			// ACT
+			sig, err := tt.scheme.Sign(ctx, tt.signer, KindPacket, data)
+			require.NoError(t, err)
+
+			// ASSERT
+			signer, err := tt.scheme.Verify(address, KindPacket, data, sig)
+			require.NoError(t, err)
+			assert.Equal(t, address, signer)
+
+			// a packet signature must not pass as a state one
+			if signer, err := tt.scheme.Verify(address, KindState, data, sig); err == nil {
+				assert.NotEqual(t, address, signer)
+			}
+
+			height, count, err := tt.scheme.DecodePackets(data)
+			require.NoError(t, err)
+			assert.Equal(t, uint64(42), height)
+			assert.Equal(t, 1, count)
+
+			assert.Equal(t, tt.scheme, SchemeOf(address))
+
+			byName, err := SchemeByName(tt.scheme.Name())
+			require.NoError(t, err)
+			assert.Equal(t, tt.scheme, byName)
+		})
+	}
+
+	t.Run("unknownName", func(t *testing.T) {
+		_, err := SchemeByName(config.AttestationScheme("schnorr"))
+		require.ErrorContains(t, err, `unknown attestation scheme "schnorr"`)
+	})
+}
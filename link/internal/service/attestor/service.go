@@ -35,12 +35,23 @@ type Attestor interface {
 	LatestHeight(ctx context.Context) (uint64, error)
 	StateAttestation(ctx context.Context, height uint64) (Attestation, error)
 	PacketAttestation(ctx context.Context, req PacketAttestationRequest) (Attestation, error)
+
+	// AttestBatch returns a state attestation and a packet attestation at the
+	// same height, saving a round-trip over calling both separately.
+	AttestBatch(ctx context.Context, req BatchAttestationRequest) (BatchAttestation, error)
 }
 
 // Info identifies one attestor.
 type Info struct {
 	ChainID string
 	Address string
+	// Cache is set for attestors that cache attestations (local ones).
+	Cache *CacheStats
+}
+
+// cacheReporter is implemented by attestors that cache attestations.
+type cacheReporter interface {
+	CacheStats() CacheStats
 }
 
 // PacketAttestationRequest is a request for packet commitment attestations.
@@ -50,6 +61,23 @@ type PacketAttestationRequest struct {
 	CommitmentType CommitmentType
 }
 
+// BatchAttestationRequest is a request for a state attestation and packet
+// commitment attestations at one height.
+type BatchAttestationRequest struct {
+	// Height to attest at. Zero lets the attestor pick its latest attestable
+	// height.
+	Height         uint64
+	Packets        [][]byte
+	CommitmentType CommitmentType
+}
+
+// BatchAttestation pairs a state attestation with a packet attestation at
+// the same height.
+type BatchAttestation struct {
+	State   Attestation
+	Packets Attestation
+}
+
 // Attestation is a signed attestation over chain state or packet commitments.
 type Attestation struct {
 	Height       uint64
@@ -122,10 +150,16 @@ func (s *Service) Info(alias string) (Info, bool) {
 		return Info{}, false
 	}
 
-	return Info{
+	info := Info{
 		ChainID: a.ChainID(),
 		Address: a.Address(),
-	}, true
+	}
+	if reporter, ok := a.(cacheReporter); ok {
+		stats := reporter.CacheStats()
+		info.Cache = &stats
+	}
+
+	return info, true
 }
 
 func (s *Service) LatestHeight(ctx context.Context, attestor string) (uint64, error) {
@@ -159,6 +193,75 @@ func (s *Service) PacketAttestation(
 	return a.PacketAttestation(ctx, req)
 }
 
+func (s *Service) AttestBatch(
+	ctx context.Context,
+	attestor string,
+	req BatchAttestationRequest,
+) (BatchAttestation, error) {
+	a, ok := s.attestors[attestor]
+	if !ok {
+		return BatchAttestation{}, ErrNotFound
+	}
+
+	return a.AttestBatch(ctx, req)
+}
+
+// attestSeparately serves a batch request through LatestHeight,
+// StateAttestation and PacketAttestation, for attestors without a native
+// batch path.
+func attestSeparately(ctx context.Context, a Attestor, req BatchAttestationRequest) (BatchAttestation, error) {
+	height := req.Height
+	if height == 0 {
+		latest, err := a.LatestHeight(ctx)
+		if err != nil {
+			return BatchAttestation{}, errors.Wrap(err, "get latest attestable height")
+		}
+		height = latest
+	}
+
+	packetReq := req.packetRequest(height)
+	if err := packetReq.Validate(); err != nil {
+		return BatchAttestation{}, errors.Wrapf(ErrInvalidInput, "%s", err)
+	}
+
+	state, err := a.StateAttestation(ctx, height)
+	if err != nil {
+		return BatchAttestation{}, errors.Wrap(err, "state attestation")
+	}
+
+	packets, err := a.PacketAttestation(ctx, packetReq)
+	if err != nil {
+		return BatchAttestation{}, errors.Wrap(err, "packet attestation")
+	}
+
+	return BatchAttestation{State: state, Packets: packets}, nil
+}
+
+// packetRequest is req's packet attestation part at height.
+func (req BatchAttestationRequest) packetRequest(height uint64) PacketAttestationRequest {
+	return PacketAttestationRequest{
+		Height:         height,
+		Packets:        req.Packets,
+		CommitmentType: req.CommitmentType,
+	}
+}
+
+// SubscribeLatestHeight streams attestor's latest attestable height, see
+// HeightSubscriber.
+func (s *Service) SubscribeLatestHeight(ctx context.Context, attestor string) (<-chan uint64, error) {
+	a, ok := s.attestors[attestor]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	subscriber, ok := a.(HeightSubscriber)
+	if !ok {
+		return nil, errors.Errorf("attestor %q does not support height subscriptions", attestor)
+	}
+
+	return subscriber.SubscribeLatestHeight(ctx)
+}
+
 func (req PacketAttestationRequest) Validate() error {
 	switch req.CommitmentType {
 	case CommitmentTypePacket, CommitmentTypeAck, CommitmentTypeReceipt:
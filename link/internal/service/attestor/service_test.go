@@ -165,6 +165,20 @@ func (c attestationClient) PacketAttestation(
 	return nil, connect.NewError(connect.CodeUnimplemented, nil)
 }
 
+func (c attestationClient) AttestBatch(
+	_ context.Context,
+	_ *connect.Request[proto.AttestBatchRequest],
+) (*connect.Response[proto.AttestBatchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, nil)
+}
+
+func (c attestationClient) SubscribeLatestHeight(
+	_ context.Context,
+	_ *connect.Request[proto.SubscribeLatestHeightRequest],
+) (*connect.ServerStreamForClient[proto.SubscribeLatestHeightResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, nil)
+}
+
 func (c attestationClient) Info(
 	_ context.Context,
 	_ *connect.Request[proto.InfoRequest],
@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package relayer
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/cosmos/ibc/link/internal/store"
+)
+
+// AttestorEvidence returns the misbehaviour evidence collected against
+// attestors, oldest first; evidence already cleared only if includeCleared.
+func (s *Service) AttestorEvidence(ctx context.Context, includeCleared bool) ([]store.AttestorEvidence, error) {
+	evidence, err := s.store.ListAttestorEvidence(ctx, includeCleared)
+	if err != nil {
+		return nil, errors.Wrap(err, "listing attestor evidence")
+	}
+
+	return evidence, nil
+}
+
+// ClearAttestorEvidence clears the uncleared evidence against address,
+// returning how many records it cleared.
+func (s *Service) ClearAttestorEvidence(ctx context.Context, address string) (int64, error) {
+	if !common.IsHexAddress(address) {
+		return 0, errors.Wrapf(ErrInvalidInput, "invalid attestor address %q", address)
+	}
+
+	// evidence is recorded under the checksummed address
+	address = common.HexToAddress(address).Hex()
+
+	cleared, err := s.store.ClearAttestorEvidence(ctx, address)
+	if err != nil {
+		return 0, errors.Wrap(err, "clearing attestor evidence")
+	}
+
+	s.logger.Info("Cleared attestor evidence", "attestor", address, "records", cleared)
+
+	return cleared, nil
+}
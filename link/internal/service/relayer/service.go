@@ -44,6 +44,8 @@ type Store interface {
 	GetRelayRequest(ctx context.Context, chainID string, txHash string) (*store.RelayRequest, error)
 	ListPacketsBySourceTx(ctx context.Context, chainID string, txHash string) ([]store.Packet, error)
 	Transact(ctx context.Context, call func(store.Repository) error) error
+	ListAttestorEvidence(ctx context.Context, includeCleared bool) ([]store.AttestorEvidence, error)
+	ClearAttestorEvidence(ctx context.Context, attestorAddress string) (int64, error)
 }
 
 // Relay errors
@@ -127,6 +127,72 @@ func (_m *MockStore) EXPECT() *MockStore_Expecter {
 	return &MockStore_Expecter{mock: &_m.Mock}
 }
 
+// ClearAttestorEvidence provides a mock function for the type MockStore
+func (_mock *MockStore) ClearAttestorEvidence(ctx context.Context, attestorAddress string) (int64, error) {
+	ret := _mock.Called(ctx, attestorAddress)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClearAttestorEvidence")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return returnFunc(ctx, attestorAddress)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = returnFunc(ctx, attestorAddress)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, attestorAddress)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_ClearAttestorEvidence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClearAttestorEvidence'
+type MockStore_ClearAttestorEvidence_Call struct {
+	*mock.Call
+}
+
+// ClearAttestorEvidence is a helper method to define mock.On call
+//   - ctx context.Context
+//   - attestorAddress string
+func (_e *MockStore_Expecter) ClearAttestorEvidence(ctx any, attestorAddress any) *MockStore_ClearAttestorEvidence_Call {
+	return &MockStore_ClearAttestorEvidence_Call{Call: _e.mock.On("ClearAttestorEvidence", ctx, attestorAddress)}
+}
+
+func (_c *MockStore_ClearAttestorEvidence_Call) Run(run func(ctx context.Context, attestorAddress string)) *MockStore_ClearAttestorEvidence_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_ClearAttestorEvidence_Call) Return(n int64, err error) *MockStore_ClearAttestorEvidence_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockStore_ClearAttestorEvidence_Call) RunAndReturn(run func(ctx context.Context, attestorAddress string) (int64, error)) *MockStore_ClearAttestorEvidence_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetRelayRequest provides a mock function for the type MockStore
 func (_mock *MockStore) GetRelayRequest(ctx context.Context, chainID string, txHash string) (*store.RelayRequest, error) {
 	ret := _mock.Called(ctx, chainID, txHash)
@@ -201,6 +267,74 @@ func (_c *MockStore_GetRelayRequest_Call) RunAndReturn(run func(ctx context.Cont
 	return _c
 }
 
+// ListAttestorEvidence provides a mock function for the type MockStore
+func (_mock *MockStore) ListAttestorEvidence(ctx context.Context, includeCleared bool) ([]store.AttestorEvidence, error) {
+	ret := _mock.Called(ctx, includeCleared)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAttestorEvidence")
+	}
+
+	var r0 []store.AttestorEvidence
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, bool) ([]store.AttestorEvidence, error)); ok {
+		return returnFunc(ctx, includeCleared)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, bool) []store.AttestorEvidence); ok {
+		r0 = returnFunc(ctx, includeCleared)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]store.AttestorEvidence)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, bool) error); ok {
+		r1 = returnFunc(ctx, includeCleared)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStore_ListAttestorEvidence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAttestorEvidence'
+type MockStore_ListAttestorEvidence_Call struct {
+	*mock.Call
+}
+
+// ListAttestorEvidence is a helper method to define mock.On call
+//   - ctx context.Context
+//   - includeCleared bool
+func (_e *MockStore_Expecter) ListAttestorEvidence(ctx any, includeCleared any) *MockStore_ListAttestorEvidence_Call {
+	return &MockStore_ListAttestorEvidence_Call{Call: _e.mock.On("ListAttestorEvidence", ctx, includeCleared)}
+}
+
+func (_c *MockStore_ListAttestorEvidence_Call) Run(run func(ctx context.Context, includeCleared bool)) *MockStore_ListAttestorEvidence_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 bool
+		if args[1] != nil {
+			arg1 = args[1].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStore_ListAttestorEvidence_Call) Return(attestorEvidences []store.AttestorEvidence, err error) *MockStore_ListAttestorEvidence_Call {
+	_c.Call.Return(attestorEvidences, err)
+	return _c
+}
+
+func (_c *MockStore_ListAttestorEvidence_Call) RunAndReturn(run func(ctx context.Context, includeCleared bool) ([]store.AttestorEvidence, error)) *MockStore_ListAttestorEvidence_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListPacketsBySourceTx provides a mock function for the type MockStore
 func (_mock *MockStore) ListPacketsBySourceTx(ctx context.Context, chainID string, txHash string) ([]store.Packet, error) {
 	ret := _mock.Called(ctx, chainID, txHash)
@@ -559,3 +559,25 @@ func TestMapPacketState(t *testing.T) {
 	assert.Equal(t, StateRejected, mapPacketState(store.RelayStatusCompleteWithWriteAckError))
 	assert.Equal(t, StateRelayFailed, mapPacketState(store.RelayStatusFailed))
 }
+
+func TestClearAttestorEvidence(t *testing.T) {
+	t.Run("clearsChecksummedAddress", func(t *testing.T) {
+		st := NewMockStore(t)
+		st.EXPECT().
+			ClearAttestorEvidence(mock.Anything, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed").
+			Return(2, nil)
+
+		service := New(relayerConfig(), st, NewMockChainClients(t), nil)
+
+		cleared, err := service.ClearAttestorEvidence(context.Background(), "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed")
+		require.NoError(t, err)
+		assert.EqualValues(t, 2, cleared)
+	})
+
+	t.Run("invalidAddress", func(t *testing.T) {
+		service := New(relayerConfig(), NewMockStore(t), NewMockChainClients(t), nil)
+
+		_, err := service.ClearAttestorEvidence(context.Background(), "attestor-a")
+		require.ErrorIs(t, err, ErrInvalidInput)
+	})
+}
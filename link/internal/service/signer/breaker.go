@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package signer
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrCircuitOpen is returned without contacting the remote signer while its
+// circuit breaker is open.
+var ErrCircuitOpen = errors.New("remote signer circuit breaker open")
+
+// circuitBreaker fails calls fast once threshold consecutive calls failed,
+// for cooldown. After the cooldown one trial call goes through: success
+// closes the breaker, failure opens it for another cooldown.
+type circuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+	// trial a call is probing a breaker whose cooldown has passed.
+	trial bool
+}
+
+func newCircuitBreaker(threshold int, cooldown time.Duration) *circuitBreaker {
+	return &circuitBreaker{threshold: threshold, cooldown: cooldown}
+}
+
+// allow errors with ErrCircuitOpen unless a call may go through.
+func (b *circuitBreaker) allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.failures < b.threshold {
+		return nil
+	}
+
+	if remaining := time.Until(b.openUntil); remaining > 0 || b.trial {
+		return errors.Wrapf(ErrCircuitOpen, "%d consecutive failures, retrying in %s",
+			b.failures, max(remaining, 0).Round(time.Second))
+	}
+
+	b.trial = true
+
+	return nil
+}
+
+// record reports the outcome of a call allow let through.
+func (b *circuitBreaker) record(failed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.trial = false
+
+	if !failed {
+		b.failures = 0
+		return
+	}
+
+	b.failures++
+	if b.failures >= b.threshold {
+		b.openUntil = time.Now().Add(b.cooldown)
+	}
+}
@@ -18,11 +18,13 @@ type LocalKey interface {
 	Signer
 	PrivateKey() []byte
 	StoreToFile(path string) error
+	StoreToEncryptedFile(path string, passphrase []byte, kdf keyfile.KDF) error
 }
 
-// PersistedLocalKey is a local key loaded from disk.
-type PersistedLocalKey struct {
-	LocalKey
+// KeyFile is a local key file found on disk. Encrypted files are listed
+// without being decrypted.
+type KeyFile struct {
+	keyfile.Info
 	Path string
 }
 
@@ -43,25 +45,40 @@ func GenerateLocalKey(keyType keyfile.Type) (LocalKey, error) {
 	}
 }
 
-// LocalKeyFromFile loads a local key from the first path that resolves.
+// LocalKeyFromFile loads a plaintext local key from the first path that
+// resolves.
 func LocalKeyFromFile(path ...string) (LocalKey, error) {
+	return LocalKeyFromFileWithPassphrase(nil, path...)
+}
+
+// LocalKeyFromFileWithPassphrase loads a local key from the first path that
+// exists, calling passphrase if the file is encrypted.
+func LocalKeyFromFileWithPassphrase(passphrase keyfile.Passphrase, path ...string) (LocalKey, error) {
 	var (
 		err error
 		key LocalKey
 	)
 
 	for _, tryPath := range path {
-		key, err = localKeyFromFile(tryPath)
+		key, err = localKeyFromFile(tryPath, passphrase)
 		if err == nil {
 			return key, nil
 		}
+
+		// an existing file that fails to load is the one meant, don't mask
+		// its error with a missing fallback
+		if !os.IsNotExist(err) {
+			return nil, err
+		}
 	}
 
 	return nil, err
 }
 
-func LocalKeysFromDirectory(keysDirectory string) ([]PersistedLocalKey, error) {
-	keys := []PersistedLocalKey{}
+// KeyFilesFromDirectory lists the key files in keysDirectory, skipping
+// files that are not key files.
+func KeyFilesFromDirectory(keysDirectory string) ([]KeyFile, error) {
+	keys := []KeyFile{}
 
 	entries, err := os.ReadDir(keysDirectory)
 	if err != nil {
@@ -77,26 +94,30 @@ func LocalKeysFromDirectory(keysDirectory string) ([]PersistedLocalKey, error) {
 		}
 
 		path := filepath.Join(keysDirectory, entry.Name())
-		key, err := LocalKeyFromFile(path)
+		info, err := keyfile.Inspect(path)
 		if err != nil {
 			continue
 		}
 
-		keys = append(keys, PersistedLocalKey{
-			LocalKey: key,
-			Path:     path,
+		keys = append(keys, KeyFile{
+			Info: info,
+			Path: path,
 		})
 	}
 
 	return keys, nil
 }
 
-func localKeyFromFile(path string) (LocalKey, error) {
-	keyType, privateKey, err := keyfile.Load(path)
+func localKeyFromFile(path string, passphrase keyfile.Passphrase) (LocalKey, error) {
+	keyType, privateKey, err := keyfile.LoadWithPassphrase(path, passphrase)
 	if err != nil {
 		return nil, err
 	}
 
+	return newLocalKey(keyType, privateKey)
+}
+
+func newLocalKey(keyType keyfile.Type, privateKey []byte) (LocalKey, error) {
 	switch keyType {
 	case EDDSA:
 		return NewLocalEd25519Signer(privateKey)
@@ -111,6 +132,10 @@ func storeKeyToFile(path string, keyType keyfile.Type, privateKey []byte) error
 	return keyfile.Store(path, keyType, privateKey)
 }
 
-func (k *PersistedLocalKey) Name() string {
+func storeKeyToEncryptedFile(path string, keyType keyfile.Type, privateKey, passphrase []byte, kdf keyfile.KDF) error {
+	return keyfile.StoreEncrypted(path, keyType, privateKey, passphrase, kdf)
+}
+
+func (k KeyFile) Name() string {
 	return strings.TrimSuffix(filepath.Base(k.Path), ".json")
 }
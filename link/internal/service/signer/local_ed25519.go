@@ -67,3 +67,7 @@ func (s *LocalEd25519Signer) Sign(ctx context.Context, message []byte) ([]byte,
 func (s *LocalEd25519Signer) StoreToFile(path string) error {
 	return storeKeyToFile(path, s.Type(), s.PrivateKey())
 }
+
+func (s *LocalEd25519Signer) StoreToEncryptedFile(path string, passphrase []byte, kdf keyfile.KDF) error {
+	return storeKeyToEncryptedFile(path, s.Type(), s.PrivateKey(), passphrase, kdf)
+}
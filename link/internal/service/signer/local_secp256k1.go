@@ -59,3 +59,7 @@ func (s *LocalSecp256k1Signer) Sign(ctx context.Context, digest []byte) ([]byte,
 func (s *LocalSecp256k1Signer) StoreToFile(path string) error {
 	return storeKeyToFile(path, s.Type(), s.pk.Serialize())
 }
+
+func (s *LocalSecp256k1Signer) StoreToEncryptedFile(path string, passphrase []byte, kdf keyfile.KDF) error {
+	return storeKeyToEncryptedFile(path, s.Type(), s.pk.Serialize(), passphrase, kdf)
+}
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package signer
+
+import (
+	"os"
+
+	"github.com/pkg/errors"
+
+	"github.com/cosmos/ibc/link/internal/config"
+	"github.com/cosmos/ibc/link/internal/pkg/hd"
+	"github.com/cosmos/ibc/link/keyfile"
+)
+
+// DeriveLocalKey derives the keyType key at accountPath/index from a BIP-39
+// mnemonic, returning it with its full derivation path.
+func DeriveLocalKey(keyType keyfile.Type, mnemonic, accountPath string, index uint32) (LocalKey, string, error) {
+	privateKey, path, err := hd.Derive(keyType, mnemonic, accountPath, index)
+	if err != nil {
+		return nil, "", err
+	}
+
+	key, err := newLocalKey(keyType, privateKey)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return key, path, nil
+}
+
+// mnemonicKeyFromConfig derives a mnemonic signer's key.
+func mnemonicKeyFromConfig(cfg config.SignerConfig) (LocalKey, error) {
+	mnemonic, err := mnemonicFromConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	key, _, err := DeriveLocalKey(cfg.MnemonicKeyType(), mnemonic, cfg.MnemonicHDPath(), cfg.Index)
+
+	return key, err
+}
+
+func mnemonicFromConfig(cfg config.SignerConfig) (string, error) {
+	if cfg.MnemonicEnv != "" {
+		mnemonic := os.Getenv(cfg.MnemonicEnv)
+		if mnemonic == "" {
+			return "", errors.Errorf("mnemonic env %s is not set", cfg.MnemonicEnv)
+		}
+
+		return mnemonic, nil
+	}
+
+	path, err := config.ExpandHome(cfg.MnemonicFile)
+	if err != nil {
+		return "", errors.Wrap(err, "expand mnemonic file")
+	}
+
+	mnemonic, err := os.ReadFile(path)
+	if err != nil {
+		return "", errors.Wrap(err, "read mnemonic file")
+	}
+
+	return string(mnemonic), nil
+}
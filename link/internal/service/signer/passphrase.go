@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package signer
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	"golang.org/x/term"
+
+	"github.com/cosmos/ibc/link/internal/config"
+	"github.com/cosmos/ibc/link/keyfile"
+)
+
+// LocalKeyFromConfig loads the key of a signer holding its key in-process:
+// a local signer's key file, resolving its passphrase with
+// PassphraseFromConfig if the file is encrypted, or a mnemonic signer's
+// derived key.
+func LocalKeyFromConfig(cfg config.SignerConfig) (LocalKey, error) {
+	if cfg.Type == config.SignerMnemonic {
+		return mnemonicKeyFromConfig(cfg)
+	}
+
+	if cfg.Type != config.SignerLocal {
+		return nil, errors.Errorf("signer %q does not hold a local key", cfg.Alias)
+	}
+
+	path, err := config.ExpandHome(cfg.File)
+	if err != nil {
+		return nil, errors.Wrap(err, "expand local signer file")
+	}
+
+	return LocalKeyFromFileWithPassphrase(PassphraseFromConfig(cfg), config.KeyFileFallbacks(path)...)
+}
+
+// PassphraseFromConfig returns the passphrase source of a local signer, or
+// the PIN source of a pkcs11 signer: its passphraseEnv, its passphraseFile,
+// or otherwise a terminal prompt.
+func PassphraseFromConfig(cfg config.SignerConfig) keyfile.Passphrase {
+	return func() ([]byte, error) {
+		switch {
+		case cfg.PassphraseEnv != "":
+			passphrase := os.Getenv(cfg.PassphraseEnv)
+			if passphrase == "" {
+				return nil, errors.Errorf("passphrase env %s is not set", cfg.PassphraseEnv)
+			}
+
+			return []byte(passphrase), nil
+		case cfg.PassphraseFile != "":
+			path, err := config.ExpandHome(cfg.PassphraseFile)
+			if err != nil {
+				return nil, errors.Wrap(err, "expand passphrase file")
+			}
+
+			passphrase, err := os.ReadFile(path)
+			if err != nil {
+				return nil, errors.Wrap(err, "read passphrase file")
+			}
+
+			// editors and `echo` leave a trailing newline behind
+			return bytes.TrimRight(passphrase, "\r\n"), nil
+		default:
+			prompt := "Passphrase"
+			if cfg.Type == config.SignerPKCS11 {
+				prompt = "PIN"
+			}
+
+			return ReadPassphrase(fmt.Sprintf("%s for signer %q: ", prompt, cfg.Alias))
+		}
+	}
+}
+
+// ReadPassphrase prompts for a passphrase on the terminal without echoing
+// it. It fails when stdin is not a terminal.
+func ReadPassphrase(prompt string) ([]byte, error) {
+	fd := int(os.Stdin.Fd()) //nolint:gosec // file descriptors fit in an int
+
+	if !term.IsTerminal(fd) {
+		return nil, errors.New("stdin is not a terminal: set passphraseEnv or passphraseFile for encrypted key files")
+	}
+
+	fmt.Fprint(os.Stderr, prompt)
+	passphrase, err := term.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "read passphrase")
+	}
+
+	return passphrase, nil
+}
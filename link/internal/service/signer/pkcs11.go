@@ -0,0 +1,162 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package signer
+
+import (
+	"bytes"
+	"encoding/asn1"
+	"math/big"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/pkg/errors"
+
+	"github.com/cosmos/ibc/link/internal/config"
+)
+
+// secp256k1Params DER encoding of the secp256k1 curve OID (1.3.132.0.10),
+// the CKA_EC_PARAMS of a secp256k1 key.
+var secp256k1Params = []byte{0x06, 0x05, 0x2b, 0x81, 0x04, 0x00, 0x0a}
+
+// PKCS11Key locates a private key on a PKCS#11 token.
+type PKCS11Key struct {
+	// Module path to the PKCS#11 library of the token's vendor
+	Module string
+
+	// TokenLabel label of the token holding the key
+	TokenLabel string
+
+	// KeyLabel CKA_LABEL of the key, optional if KeyID is set
+	KeyLabel string
+
+	// KeyID CKA_ID of the key, optional if KeyLabel is set
+	KeyID []byte
+}
+
+// NewPKCS11FromConfig opens a pkcs11 signer, logging in with the PIN
+// resolved by PassphraseFromConfig.
+func NewPKCS11FromConfig(cfg config.SignerConfig) (*PKCS11Signer, error) {
+	key := PKCS11Key{
+		Module:     cfg.PKCS11Module,
+		TokenLabel: cfg.TokenLabel,
+		KeyLabel:   cfg.KeyLabel,
+	}
+
+	if cfg.KeyID != "" {
+		id, err := DecodeHex(cfg.KeyID)
+		if err != nil {
+			return nil, errors.Wrap(err, "decode key id")
+		}
+
+		key.KeyID = id
+	}
+
+	modulePath, err := config.ExpandHome(key.Module)
+	if err != nil {
+		return nil, errors.Wrap(err, "expand pkcs11 module")
+	}
+
+	key.Module = modulePath
+
+	pin, err := PassphraseFromConfig(cfg)()
+	if err != nil {
+		return nil, errors.Wrap(err, "resolve pin")
+	}
+
+	return NewPKCS11(key, string(pin))
+}
+
+// recoverableSignature converts an ECDSA signature over digest, either raw
+// r||s as PKCS#11's CKM_ECDSA returns it or DER as some tokens do, into the
+// 65-byte r||s||v form local secp256k1 signers produce: s is normalized to
+// the lower half of the order, as Ethereum requires, and v (0 or 1) is the
+// recovery ID that yields the compressed publicKey.
+func recoverableSignature(digest, signature, publicKey []byte) ([]byte, error) {
+	r, s, err := parseECDSASignature(signature)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.IsOverHalfOrder() {
+		s.Negate()
+	}
+
+	// compact form: header || r || s, with header 27 + v + 4 for a
+	// compressed key
+	compact := make([]byte, 65)
+	r.PutBytesUnchecked(compact[1:33])
+	s.PutBytesUnchecked(compact[33:65])
+
+	for v := byte(0); v < 2; v++ {
+		compact[0] = 27 + v + 4
+
+		recovered, _, err := ecdsa.RecoverCompact(compact, digest)
+		if err != nil {
+			continue
+		}
+
+		if bytes.Equal(recovered.SerializeCompressed(), publicKey) {
+			return append(compact[1:], v), nil
+		}
+	}
+
+	return nil, errors.New("signature does not recover to the signer's public key")
+}
+
+// parseECDSASignature parses a raw 64-byte r||s or DER-encoded signature.
+func parseECDSASignature(signature []byte) (*secp256k1.ModNScalar, *secp256k1.ModNScalar, error) {
+	var rBytes, sBytes []byte
+
+	if len(signature) == 64 {
+		rBytes, sBytes = signature[:32], signature[32:]
+	} else {
+		var der struct{ R, S *big.Int }
+
+		rest, err := asn1.Unmarshal(signature, &der)
+		switch {
+		case err != nil:
+			return nil, nil, errors.Wrapf(err, "signature is neither 64 bytes nor DER (%d bytes)", len(signature))
+		case len(rest) != 0:
+			return nil, nil, errors.New("trailing data after DER signature")
+		case der.R.Sign() <= 0 || der.S.Sign() <= 0:
+			return nil, nil, errors.New("signature r and s must be positive")
+		}
+
+		rBytes, sBytes = der.R.Bytes(), der.S.Bytes()
+	}
+
+	var r, s secp256k1.ModNScalar
+
+	if len(rBytes) > 32 || r.SetByteSlice(rBytes) || r.IsZero() {
+		return nil, nil, errors.New("signature r out of range")
+	}
+
+	if len(sBytes) > 32 || s.SetByteSlice(sBytes) || s.IsZero() {
+		return nil, nil, errors.New("signature s out of range")
+	}
+
+	return &r, &s, nil
+}
+
+// unwrapECPoint returns the raw point of a CKA_EC_POINT of size bytes.
+// PKCS#11 specifies it as a DER OCTET STRING, but some tokens return the
+// bare point.
+func unwrapECPoint(ecPoint []byte, size int) ([]byte, error) {
+	if len(ecPoint) == size {
+		return ecPoint, nil
+	}
+
+	var point []byte
+
+	rest, err := asn1.Unmarshal(ecPoint, &point)
+	switch {
+	case err != nil:
+		return nil, errors.Wrap(err, "decode ec point")
+	case len(rest) != 0:
+		return nil, errors.New("trailing data after ec point")
+	case len(point) != size:
+		return nil, errors.Errorf("ec point must be %d bytes, got %d", size, len(point))
+	}
+
+	return point, nil
+}
@@ -0,0 +1,414 @@
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build cgo
+
+package signer
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"encoding/binary"
+	"encoding/hex"
+	"log/slog"
+	"sync"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/miekg/pkcs11"
+	"github.com/pkg/errors"
+
+	"github.com/cosmos/ibc/link/keyfile"
+)
+
+// PKCS#11 v3.0 Edwards-curve constants, missing from miekg/pkcs11.
+const (
+	ckkECEdwards = 0x40
+	ckmEDDSA     = 0x1057
+)
+
+// uncompressed secp256k1 point: 0x04 || x || y
+const secp256k1PointSize = 65
+
+// PKCS11Signer signs with a key held on a PKCS#11 token, e.g. an HSM. The
+// private key never leaves the token: secp256k1 digests are signed with
+// CKM_ECDSA and ed25519 messages with CKM_EDDSA.
+type PKCS11Signer struct {
+	module *pkcs11.Ctx
+	key    PKCS11Key
+	pin    string
+
+	// mu guards session: a PKCS#11 session runs one operation at a time
+	mu      sync.Mutex
+	session pkcs11.SessionHandle
+	handle  pkcs11.ObjectHandle
+
+	keyType   keyfile.Type
+	publicKey []byte
+
+	logger *slog.Logger
+}
+
+var _ Signer = (*PKCS11Signer)(nil)
+
+// pkcs11Modules initialized PKCS#11 libraries by path. A library is
+// initialized once per process and shared by every signer using it.
+var (
+	pkcs11ModulesMu sync.Mutex
+	pkcs11Modules   = make(map[string]*pkcs11.Ctx)
+)
+
+// NewPKCS11 logs in to key's token with pin and locates the key.
+func NewPKCS11(key PKCS11Key, pin string) (*PKCS11Signer, error) {
+	module, err := loadPKCS11Module(key.Module)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &PKCS11Signer{
+		module: module,
+		key:    key,
+		pin:    pin,
+		logger: slog.With(
+			"module", "signer",
+			"source", "pkcs11",
+			"token", key.TokenLabel,
+			"key_label", key.KeyLabel,
+			"key_id", hex.EncodeToString(key.KeyID),
+		),
+	}
+
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+
+	if err := s.setup(); err != nil {
+		s.Close()
+		return nil, errors.Wrap(err, "setup failed")
+	}
+
+	return s, nil
+}
+
+func (s *PKCS11Signer) IsLocal() bool      { return false }
+func (s *PKCS11Signer) Type() keyfile.Type { return s.keyType }
+
+func (s *PKCS11Signer) PublicKey() []byte {
+	return s.publicKey
+}
+
+// Sign signs a 32-byte digest for secp256k1 keys, returning r||s||v, or a
+// message for ed25519 keys. A session the token dropped, e.g. after a
+// restart, is reopened once.
+func (s *PKCS11Signer) Sign(ctx context.Context, message []byte) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if s.keyType == ECDSA && len(message) != 32 {
+		return nil, errors.Errorf("secp256k1 signers sign 32-byte digests, got %d bytes", len(message))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	signature, err := s.sign(message)
+	if isPKCS11SessionLost(err) {
+		s.logger.Warn("PKCS#11 session lost, reopening", "err", err)
+
+		if err := s.reopen(); err != nil {
+			return nil, errors.Wrap(err, "reopen session")
+		}
+
+		signature, err = s.sign(message)
+	}
+
+	if err != nil {
+		return nil, errors.Wrap(err, "pkcs11 sign")
+	}
+
+	if s.keyType == EDDSA {
+		return signature, nil
+	}
+
+	return recoverableSignature(message, signature, s.publicKey)
+}
+
+// Close closes the signer's session. The library stays loaded for other
+// signers.
+func (s *PKCS11Signer) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.module.CloseSession(s.session); err != nil {
+		s.logger.Error("Unable to close PKCS#11 session", "err", err)
+	}
+}
+
+// sign runs one signing operation; mu must be held.
+func (s *PKCS11Signer) sign(message []byte) ([]byte, error) {
+	mechanism := pkcs11.NewMechanism(pkcs11.CKM_ECDSA, nil)
+	if s.keyType == EDDSA {
+		mechanism = pkcs11.NewMechanism(ckmEDDSA, nil)
+	}
+
+	if err := s.module.SignInit(s.session, []*pkcs11.Mechanism{mechanism}, s.handle); err != nil {
+		return nil, err
+	}
+
+	return s.module.Sign(s.session, message)
+}
+
+// open opens a session on the key's token and logs in. Sessions of one
+// process share the token's login state, so a token another signer already
+// logged in to is not an error.
+func (s *PKCS11Signer) open() error {
+	slot, err := findPKCS11Slot(s.module, s.key.TokenLabel)
+	if err != nil {
+		return err
+	}
+
+	session, err := s.module.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION)
+	if err != nil {
+		return errors.Wrap(err, "open session")
+	}
+
+	err = s.module.Login(session, pkcs11.CKU_USER, s.pin)
+	if err != nil && !errors.Is(err, pkcs11.Error(pkcs11.CKR_USER_ALREADY_LOGGED_IN)) {
+		_ = s.module.CloseSession(session)
+		return errors.Wrap(err, "login")
+	}
+
+	s.session = session
+
+	return nil
+}
+
+// reopen replaces a lost session and locates the key again, as object
+// handles are only valid within a session; mu must be held.
+func (s *PKCS11Signer) reopen() error {
+	_ = s.module.CloseSession(s.session)
+
+	if err := s.open(); err != nil {
+		return err
+	}
+
+	handle, _, err := s.findKey()
+	if err != nil {
+		return err
+	}
+
+	s.handle = handle
+
+	return nil
+}
+
+// setup locates the private key and reads its type and public key from
+// the matching public key object.
+func (s *PKCS11Signer) setup() error {
+	handle, id, err := s.findKey()
+	if err != nil {
+		return err
+	}
+
+	s.handle = handle
+
+	attrs, err := s.module.GetAttributeValue(s.session, handle, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, nil),
+	})
+	if err != nil {
+		return errors.Wrap(err, "read key type")
+	}
+
+	keyType, err := bytesToUint(attrs[0].Value)
+	if err != nil {
+		return errors.Wrap(err, "read key type")
+	}
+
+	// the public key is the public object sharing the private key's id,
+	// or its label if it has none
+	template := []*pkcs11.Attribute{pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PUBLIC_KEY)}
+	if len(id) > 0 {
+		template = append(template, pkcs11.NewAttribute(pkcs11.CKA_ID, id))
+	} else {
+		template = append(template, pkcs11.NewAttribute(pkcs11.CKA_LABEL, s.key.KeyLabel))
+	}
+
+	public, err := findPKCS11Object(s.module, s.session, template)
+	if err != nil {
+		return errors.Wrap(err, "find public key")
+	}
+
+	attrs, err = s.module.GetAttributeValue(s.session, public, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, nil),
+		pkcs11.NewAttribute(pkcs11.CKA_EC_POINT, nil),
+	})
+	if err != nil {
+		return errors.Wrap(err, "read public key")
+	}
+
+	params, ecPoint := attrs[0].Value, attrs[1].Value
+
+	switch keyType {
+	case pkcs11.CKK_EC:
+		if !bytes.Equal(params, secp256k1Params) {
+			return errors.Errorf("ec key is not on secp256k1 (params %x)", params)
+		}
+
+		point, err := unwrapECPoint(ecPoint, secp256k1PointSize)
+		if err != nil {
+			return err
+		}
+
+		publicKey, err := secp256k1.ParsePubKey(point)
+		if err != nil {
+			return errors.Wrap(err, "parse secp256k1 public key")
+		}
+
+		s.keyType = ECDSA
+		s.publicKey = publicKey.SerializeCompressed()
+	case ckkECEdwards:
+		point, err := unwrapECPoint(ecPoint, ed25519.PublicKeySize)
+		if err != nil {
+			return err
+		}
+
+		s.keyType = EDDSA
+		s.publicKey = point
+	default:
+		return errors.Errorf("unsupported pkcs11 key type 0x%x", keyType)
+	}
+
+	return nil
+}
+
+// findKey finds the private key by label and/or id, returning its handle
+// and CKA_ID.
+func (s *PKCS11Signer) findKey() (pkcs11.ObjectHandle, []byte, error) {
+	template := []*pkcs11.Attribute{pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PRIVATE_KEY)}
+	if s.key.KeyLabel != "" {
+		template = append(template, pkcs11.NewAttribute(pkcs11.CKA_LABEL, s.key.KeyLabel))
+	}
+
+	if len(s.key.KeyID) > 0 {
+		template = append(template, pkcs11.NewAttribute(pkcs11.CKA_ID, s.key.KeyID))
+	}
+
+	handle, err := findPKCS11Object(s.module, s.session, template)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "find private key")
+	}
+
+	attrs, err := s.module.GetAttributeValue(s.session, handle, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_ID, nil),
+	})
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "read key id")
+	}
+
+	return handle, attrs[0].Value, nil
+}
+
+// loadPKCS11Module loads and initializes the library at path once per
+// process.
+func loadPKCS11Module(path string) (*pkcs11.Ctx, error) {
+	pkcs11ModulesMu.Lock()
+	defer pkcs11ModulesMu.Unlock()
+
+	if module, ok := pkcs11Modules[path]; ok {
+		return module, nil
+	}
+
+	module := pkcs11.New(path)
+	if module == nil {
+		return nil, errors.Errorf("unable to load pkcs11 module %s", path)
+	}
+
+	err := module.Initialize()
+	if err != nil && !errors.Is(err, pkcs11.Error(pkcs11.CKR_CRYPTOKI_ALREADY_INITIALIZED)) {
+		module.Destroy()
+		return nil, errors.Wrapf(err, "initialize pkcs11 module %s", path)
+	}
+
+	pkcs11Modules[path] = module
+
+	return module, nil
+}
+
+// findPKCS11Slot returns the slot holding the token labeled label.
+func findPKCS11Slot(module *pkcs11.Ctx, label string) (uint, error) {
+	slots, err := module.GetSlotList(true)
+	if err != nil {
+		return 0, errors.Wrap(err, "list slots")
+	}
+
+	for _, slot := range slots {
+		info, err := module.GetTokenInfo(slot)
+		if err != nil {
+			return 0, errors.Wrapf(err, "read token info of slot %d", slot)
+		}
+
+		if info.Label == label {
+			return slot, nil
+		}
+	}
+
+	return 0, errors.Errorf("no token labeled %q", label)
+}
+
+// findPKCS11Object returns the only object matching template.
+func findPKCS11Object(module *pkcs11.Ctx, session pkcs11.SessionHandle, template []*pkcs11.Attribute) (
+	pkcs11.ObjectHandle, error,
+) {
+	if err := module.FindObjectsInit(session, template); err != nil {
+		return 0, err
+	}
+
+	// two are enough to tell an ambiguous template
+	handles, _, err := module.FindObjects(session, 2)
+	if errFinal := module.FindObjectsFinal(session); err == nil {
+		err = errFinal
+	}
+
+	switch {
+	case err != nil:
+		return 0, err
+	case len(handles) == 0:
+		return 0, errors.New("no matching object")
+	case len(handles) > 1:
+		return 0, errors.New("more than one matching object, set both keyLabel and keyId")
+	}
+
+	return handles[0], nil
+}
+
+// isPKCS11SessionLost reports whether err means the session must be
+// reopened before signing again.
+func isPKCS11SessionLost(err error) bool {
+	for _, code := range []uint{
+		pkcs11.CKR_SESSION_HANDLE_INVALID,
+		pkcs11.CKR_SESSION_CLOSED,
+		pkcs11.CKR_USER_NOT_LOGGED_IN,
+		pkcs11.CKR_DEVICE_REMOVED,
+		pkcs11.CKR_TOKEN_NOT_PRESENT,
+		pkcs11.CKR_OBJECT_HANDLE_INVALID,
+		pkcs11.CKR_KEY_HANDLE_INVALID,
+	} {
+		if errors.Is(err, pkcs11.Error(code)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// bytesToUint decodes a CK_ULONG attribute, stored in native byte order.
+func bytesToUint(value []byte) (uint, error) {
+	switch len(value) {
+	case 8:
+		return uint(binary.NativeEndian.Uint64(value)), nil
+	case 4:
+		return uint(binary.NativeEndian.Uint32(value)), nil
+	default:
+		return 0, errors.Errorf("invalid CK_ULONG of %d bytes", len(value))
+	}
+}
@@ -0,0 +1,215 @@
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build cgo
+
+package signer
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/miekg/pkcs11"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc/link/internal/config"
+)
+
+const (
+	softHSMTokenLabel = "ibc-test"
+	softHSMPIN        = "1234"
+
+	ckmECEdwardsKeyPairGen = 0x1055
+)
+
+// ed25519Params DER encoding of the Ed25519 OID (1.3.101.112).
+var ed25519Params = []byte{0x06, 0x03, 0x2b, 0x65, 0x70}
+
+// TestPKCS11SignerSoftHSM runs against SoftHSM v2, e.g.
+//
+//	SOFTHSM2_MODULE=/usr/lib/softhsm/libsofthsm2.so go test ./internal/service/signer -run PKCS11
+//
+// It initializes a throwaway token under t.TempDir, leaving any existing
+// SoftHSM tokens alone.
+func TestPKCS11SignerSoftHSM(t *testing.T) {
+	modulePath := os.Getenv("SOFTHSM2_MODULE")
+	if modulePath == "" {
+		t.Skip("SOFTHSM2_MODULE not set")
+	}
+
+	ctx := context.Background()
+	digest := sha256.Sum256([]byte("hello pkcs11"))
+
+	module := initSoftHSMToken(t, modulePath)
+	generateSoftHSMKey(t, module, pkcs11.CKM_EC_KEY_PAIR_GEN, secp256k1Params, "attestor-ecdsa", []byte{0x01})
+	generateSoftHSMKey(t, module, ckmECEdwardsKeyPairGen, ed25519Params, "attestor-eddsa", []byte{0x02})
+
+	t.Setenv("IBC_TEST_PKCS11_PIN", softHSMPIN)
+
+	t.Run("ecdsa", func(t *testing.T) {
+		// ARRANGE
+		s, alias, err := NewSignerFromConfig(ctx, config.SignerConfig{
+			Alias:         "hsm",
+			Type:          config.SignerPKCS11,
+			PKCS11Module:  modulePath,
+			TokenLabel:    softHSMTokenLabel,
+			KeyLabel:      "attestor-ecdsa",
+			PassphraseEnv: "IBC_TEST_PKCS11_PIN",
+		})
+		require.NoError(t, err)
+		t.Cleanup(s.(*PKCS11Signer).Close)
+
+		require.Equal(t, "hsm", alias)
+		require.Equal(t, ECDSA, s.Type())
+		require.False(t, s.IsLocal())
+
+		// several signatures to cover both recovery ids
+		for i := range 8 {
+			// ACT
+			signature, err := s.Sign(ctx, digest[:])
+
+			// ASSERT
+			require.NoError(t, err, "signature %d", i)
+			assertRecoverableSignature(t, s.PublicKey(), digest[:], signature)
+
+			var sig secp256k1.ModNScalar
+			sig.SetByteSlice(signature[32:64])
+			assert.False(t, sig.IsOverHalfOrder(), "s must be low")
+		}
+
+		_, err = s.Sign(ctx, []byte("not a digest"))
+		require.ErrorContains(t, err, "32-byte digests")
+	})
+
+	t.Run("eddsa", func(t *testing.T) {
+		// ARRANGE
+		s, err := NewPKCS11(PKCS11Key{
+			Module:     modulePath,
+			TokenLabel: softHSMTokenLabel,
+			KeyID:      []byte{0x02},
+		}, softHSMPIN)
+		require.NoError(t, err)
+		t.Cleanup(s.Close)
+
+		require.Equal(t, EDDSA, s.Type())
+
+		message := []byte("hello eddsa")
+
+		// ACT
+		signature, err := s.Sign(ctx, message)
+
+		// ASSERT
+		require.NoError(t, err)
+		assert.True(t, ed25519.Verify(s.PublicKey(), message, signature))
+	})
+
+	t.Run("reopensLostSession", func(t *testing.T) {
+		// ARRANGE
+		s, err := NewPKCS11(PKCS11Key{
+			Module:     modulePath,
+			TokenLabel: softHSMTokenLabel,
+			KeyLabel:   "attestor-ecdsa",
+		}, softHSMPIN)
+		require.NoError(t, err)
+		t.Cleanup(s.Close)
+
+		require.NoError(t, s.module.CloseSession(s.session))
+
+		// ACT
+		signature, err := s.Sign(ctx, digest[:])
+
+		// ASSERT
+		require.NoError(t, err)
+		assertRecoverableSignature(t, s.PublicKey(), digest[:], signature)
+	})
+
+	t.Run("unknownToken", func(t *testing.T) {
+		_, err := NewPKCS11(PKCS11Key{
+			Module:     modulePath,
+			TokenLabel: "missing",
+			KeyLabel:   "attestor-ecdsa",
+		}, softHSMPIN)
+		require.ErrorContains(t, err, `no token labeled "missing"`)
+	})
+}
+
+// initSoftHSMToken points SoftHSM at a fresh token directory and
+// initializes a token with softHSMPIN as its user PIN.
+func initSoftHSMToken(t *testing.T, modulePath string) *pkcs11.Ctx {
+	t.Helper()
+
+	dir := t.TempDir()
+	tokens := filepath.Join(dir, "tokens")
+	require.NoError(t, os.Mkdir(tokens, 0o700))
+
+	conf := filepath.Join(dir, "softhsm2.conf")
+	content := fmt.Sprintf("directories.tokendir = %s\nobjectstore.backend = file\nlog.level = ERROR\n", tokens)
+	require.NoError(t, os.WriteFile(conf, []byte(content), 0o600))
+
+	// read by SoftHSM when the module is initialized
+	t.Setenv("SOFTHSM2_CONF", conf)
+
+	module, err := loadPKCS11Module(modulePath)
+	require.NoError(t, err)
+
+	slots, err := module.GetSlotList(true)
+	require.NoError(t, err)
+	require.NotEmpty(t, slots)
+
+	const soPIN = "5678"
+	require.NoError(t, module.InitToken(slots[0], soPIN, softHSMTokenLabel))
+
+	// SoftHSM moves an initialized token to a new slot
+	slot, err := findPKCS11Slot(module, softHSMTokenLabel)
+	require.NoError(t, err)
+
+	session, err := module.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, module.CloseSession(session)) }()
+
+	require.NoError(t, module.Login(session, pkcs11.CKU_SO, soPIN))
+	require.NoError(t, module.InitPIN(session, softHSMPIN))
+	require.NoError(t, module.Logout(session))
+
+	return module
+}
+
+// generateSoftHSMKey generates a key pair on the test token.
+func generateSoftHSMKey(t *testing.T, module *pkcs11.Ctx, mechanism uint, params []byte, label string, id []byte) {
+	t.Helper()
+
+	slot, err := findPKCS11Slot(module, softHSMTokenLabel)
+	require.NoError(t, err)
+
+	session, err := module.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, module.CloseSession(session)) }()
+
+	require.NoError(t, module.Login(session, pkcs11.CKU_USER, softHSMPIN))
+
+	_, _, err = module.GenerateKeyPair(session,
+		[]*pkcs11.Mechanism{pkcs11.NewMechanism(mechanism, nil)},
+		[]*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+			pkcs11.NewAttribute(pkcs11.CKA_VERIFY, true),
+			pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, params),
+			pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+			pkcs11.NewAttribute(pkcs11.CKA_ID, id),
+		},
+		[]*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+			pkcs11.NewAttribute(pkcs11.CKA_SIGN, true),
+			pkcs11.NewAttribute(pkcs11.CKA_PRIVATE, true),
+			pkcs11.NewAttribute(pkcs11.CKA_SENSITIVE, true),
+			pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+			pkcs11.NewAttribute(pkcs11.CKA_ID, id),
+		},
+	)
+	require.NoError(t, err)
+}
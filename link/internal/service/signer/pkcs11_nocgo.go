@@ -0,0 +1,22 @@
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !cgo
+
+package signer
+
+import (
+	"github.com/pkg/errors"
+)
+
+// PKCS11Signer is unavailable without cgo, which PKCS#11 libraries need.
+type PKCS11Signer struct {
+	Signer
+}
+
+// NewPKCS11 fails: PKCS#11 signers need a cgo-enabled build.
+func NewPKCS11(PKCS11Key, string) (*PKCS11Signer, error) {
+	return nil, errors.New("pkcs11 signers require a build with cgo enabled (CGO_ENABLED=1)")
+}
+
+// Close is a no-op.
+func (s *PKCS11Signer) Close() {}
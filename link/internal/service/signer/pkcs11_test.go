@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package signer
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecoverableSignature(t *testing.T) {
+	digest := sha256.Sum256([]byte("hello pkcs11"))
+
+	pk, err := secp256k1.GeneratePrivateKeyFromRand(rand.Reader)
+	require.NoError(t, err)
+
+	publicKey := pk.PubKey().SerializeCompressed()
+
+	signature := ecdsa.Sign(pk, digest[:])
+	r, s := signature.R(), signature.S()
+
+	raw := make([]byte, 64)
+	r.PutBytesUnchecked(raw[:32])
+	s.PutBytesUnchecked(raw[32:])
+
+	highS := make([]byte, 64)
+	copy(highS, raw[:32])
+	new(secp256k1.ModNScalar).NegateVal(&s).PutBytesUnchecked(highS[32:])
+
+	expected, err := recoverableSignature(digest[:], raw, publicKey)
+	require.NoError(t, err)
+	assertRecoverableSignature(t, publicKey, digest[:], expected)
+
+	for name, input := range map[string][]byte{
+		"der":   signature.Serialize(),
+		"highS": highS,
+	} {
+		t.Run(name, func(t *testing.T) {
+			// ACT
+			actual, err := recoverableSignature(digest[:], input, publicKey)
+
+			// ASSERT
+			require.NoError(t, err)
+			assert.Equal(t, expected, actual)
+		})
+	}
+
+	t.Run("otherKey", func(t *testing.T) {
+		// ARRANGE
+		other, err := secp256k1.GeneratePrivateKeyFromRand(rand.Reader)
+		require.NoError(t, err)
+
+		// ACT
+		_, err = recoverableSignature(digest[:], raw, other.PubKey().SerializeCompressed())
+
+		// ASSERT
+		require.ErrorContains(t, err, "does not recover")
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		// ACT
+		_, err := recoverableSignature(digest[:], raw[:63], publicKey)
+
+		// ASSERT
+		require.ErrorContains(t, err, "neither 64 bytes nor DER")
+	})
+}
+
+func TestUnwrapECPoint(t *testing.T) {
+	point := make([]byte, 32)
+	point[0] = 0xaa
+
+	// DER OCTET STRING of point, as PKCS#11 specifies CKA_EC_POINT
+	wrapped := append([]byte{0x04, 0x20}, point...)
+
+	for name, input := range map[string][]byte{"wrapped": wrapped, "bare": point} {
+		t.Run(name, func(t *testing.T) {
+			actual, err := unwrapECPoint(input, 32)
+			require.NoError(t, err)
+			assert.Equal(t, point, actual)
+		})
+	}
+
+	t.Run("wrongSize", func(t *testing.T) {
+		_, err := unwrapECPoint(wrapped, 65)
+		require.ErrorContains(t, err, "must be 65 bytes")
+	})
+}
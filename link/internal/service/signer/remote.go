@@ -3,37 +3,156 @@
 package signer
 
 import (
+	"bytes"
 	"context"
 	"log/slog"
+	"sync"
 	"time"
 
 	"github.com/cosmos/kms/gen/signerservice"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/ibc/link/internal/config"
 	"github.com/cosmos/ibc/link/keyfile"
 )
 
+// ErrRemoteKeyChanged is returned by every Sign once the KMS reports a
+// different public key than the one cached at startup: the address behind
+// the signer would otherwise silently change.
+var ErrRemoteKeyChanged = errors.New("remote signer key changed")
+
+// Remote signer defaults, see RemoteOptions.
+const (
+	DefaultRemoteTimeout          = 5 * time.Second
+	DefaultRemoteRetries          = 3
+	DefaultRemoteRetryBackoff     = 200 * time.Millisecond
+	DefaultRemoteKeyCheckInterval = time.Minute
+	DefaultRemoteBreakerThreshold = 5
+	DefaultRemoteBreakerCooldown  = 30 * time.Second
+
+	maxRemoteRetryBackoff = 5 * time.Second
+)
+
+// RemoteOptions tunes a RemoteSigner. Zero fields take their defaults.
+type RemoteOptions struct {
+	// Timeout the deadline of each KMS request.
+	Timeout time.Duration
+	// Retries how often a sign request failing with a transient error is
+	// retried, doubling RetryBackoff in between. Signing is idempotent, so
+	// retrying a request the KMS may have served is safe. Negative disables
+	// retries.
+	Retries      int
+	RetryBackoff time.Duration
+	// KeyCheckInterval how often the KMS key is re-read and compared with
+	// the public key cached at startup. Negative disables the check.
+	KeyCheckInterval time.Duration
+	// BreakerThreshold consecutive failed sign requests after which calls
+	// fail fast with ErrCircuitOpen for BreakerCooldown.
+	BreakerThreshold int
+	BreakerCooldown  time.Duration
+}
+
+// RemoteOptionsFromConfig reads a remote signer's options from cfg.
+func RemoteOptionsFromConfig(cfg config.SignerConfig) RemoteOptions {
+	var opts RemoteOptions
+
+	if cfg.Timeout != nil {
+		opts.Timeout = *cfg.Timeout
+	}
+
+	if cfg.Retries != nil {
+		opts.Retries = *cfg.Retries
+		if opts.Retries == 0 {
+			opts.Retries = -1
+		}
+	}
+
+	if cfg.KeyCheckInterval != nil {
+		opts.KeyCheckInterval = *cfg.KeyCheckInterval
+		if opts.KeyCheckInterval == 0 {
+			opts.KeyCheckInterval = -1
+		}
+	}
+
+	return opts
+}
+
+func (o RemoteOptions) withDefaults() RemoteOptions {
+	if o.Timeout <= 0 {
+		o.Timeout = DefaultRemoteTimeout
+	}
+
+	switch {
+	case o.Retries == 0:
+		o.Retries = DefaultRemoteRetries
+	case o.Retries < 0:
+		o.Retries = 0
+	}
+
+	if o.RetryBackoff <= 0 {
+		o.RetryBackoff = DefaultRemoteRetryBackoff
+	}
+
+	if o.KeyCheckInterval == 0 {
+		o.KeyCheckInterval = DefaultRemoteKeyCheckInterval
+	}
+
+	if o.BreakerThreshold <= 0 {
+		o.BreakerThreshold = DefaultRemoteBreakerThreshold
+	}
+
+	if o.BreakerCooldown <= 0 {
+		o.BreakerCooldown = DefaultRemoteBreakerCooldown
+	}
+
+	return o
+}
+
 // RemoteSigner wraps KMS remote signer.
 type RemoteSigner struct {
 	client signerservice.SignerServiceClient
 	keyID  string
+	opts   RemoteOptions
 
 	key     *signerservice.Key
 	keyType keyfile.Type
 
+	breaker *circuitBreaker
+
+	mu sync.Mutex
+	// keyErr set once the KMS key no longer matches key, failing every Sign.
+	keyErr error
+
+	stop   context.CancelFunc
+	closer func() error
+
 	logger *slog.Logger
 }
 
 var _ Signer = &RemoteSigner{}
 
-func NewRemote(ctx context.Context, client signerservice.SignerServiceClient, keyID string) (*RemoteSigner, error) {
+// NewRemote fetches keyID's public key from the KMS and, unless disabled,
+// starts checking it stays the same until Close.
+func NewRemote(
+	ctx context.Context,
+	client signerservice.SignerServiceClient,
+	keyID string,
+	opts RemoteOptions,
+) (*RemoteSigner, error) {
+	opts = opts.withDefaults()
+
 	s := &RemoteSigner{
 		client:  client,
 		keyID:   keyID,
+		opts:    opts,
 		key:     nil,
 		keyType: "",
+		breaker: newCircuitBreaker(opts.BreakerThreshold, opts.BreakerCooldown),
 		logger:  slog.With("module", "signer", "source", "remote", "key_id", keyID),
 	}
 
@@ -41,10 +160,17 @@ func NewRemote(ctx context.Context, client signerservice.SignerServiceClient, ke
 		return nil, errors.Wrap(err, "setup failed")
 	}
 
+	watchCtx, stop := context.WithCancel(context.Background())
+	s.stop = stop
+
+	if opts.KeyCheckInterval > 0 {
+		go s.watchKey(watchCtx)
+	}
+
 	return s, nil
 }
 
-func NewRemoteFromURL(ctx context.Context, grpcURL, keyID string) (*RemoteSigner, error) {
+func NewRemoteFromURL(ctx context.Context, grpcURL, keyID string, opts RemoteOptions) (*RemoteSigner, error) {
 	grpcClient, err := newGRPCClientFromURL(grpcURL)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to create grpc client")
@@ -52,7 +178,7 @@ func NewRemoteFromURL(ctx context.Context, grpcURL, keyID string) (*RemoteSigner
 
 	signerClient := signerservice.NewSignerServiceClient(grpcClient)
 
-	s, err := NewRemote(ctx, signerClient, keyID)
+	s, err := NewRemote(ctx, signerClient, keyID, opts)
 	if err != nil {
 		if errClose := grpcClient.Close(); errClose != nil {
 			slog.Error("failed to close grpc client", "err", errClose)
@@ -61,51 +187,193 @@ func NewRemoteFromURL(ctx context.Context, grpcURL, keyID string) (*RemoteSigner
 		return nil, err
 	}
 
+	s.closer = grpcClient.Close
+
 	return s, nil
 }
 
 func (r *RemoteSigner) IsLocal() bool      { return false }
 func (r *RemoteSigner) Type() keyfile.Type { return r.keyType }
 
+// PublicKey returns the public key cached at startup.
 func (r *RemoteSigner) PublicKey() []byte {
 	return r.key.Pubkey
 }
 
+// Sign signs message on the KMS, retrying transient failures. It fails fast
+// while the circuit breaker is open, and for good once the KMS key changed.
 func (r *RemoteSigner) Sign(ctx context.Context, message []byte) ([]byte, error) {
+	if err := r.keyChanged(); err != nil {
+		return nil, err
+	}
+
+	if err := r.breaker.allow(); err != nil {
+		return nil, err
+	}
+
 	r.logger.Debug("Sending sign request", "message", message)
 
+	signature, err := r.signWithRetries(ctx, message)
+	// only an unreachable or overloaded KMS trips the breaker, not one that
+	// answered with an error
+	r.breaker.record(err != nil && retryable(err))
+
+	if err != nil {
+		return nil, errors.Wrap(err, "sign request failed")
+	}
+
+	return signature, nil
+}
+
+func (r *RemoteSigner) signWithRetries(ctx context.Context, message []byte) ([]byte, error) {
+	delay := r.opts.RetryBackoff
+
+	for attempt := 0; ; attempt++ {
+		signature, err := r.signOnce(ctx, message)
+		if err == nil {
+			return signature, nil
+		}
+
+		if attempt == r.opts.Retries || !retryable(err) || ctx.Err() != nil {
+			return nil, err
+		}
+
+		r.logger.Warn("Retrying failed sign request", "attempt", attempt+1, "err", err)
+
+		select {
+		case <-ctx.Done():
+			return nil, err
+		case <-time.After(delay):
+		}
+
+		delay = min(2*delay, maxRemoteRetryBackoff)
+	}
+}
+
+func (r *RemoteSigner) signOnce(ctx context.Context, message []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.opts.Timeout)
+	defer cancel()
+
 	resp, err := r.client.Sign(ctx, &signerservice.SignRequest{
 		KeyId:   r.keyID,
 		Payload: bytesToPayload(message),
 	})
 	if err != nil {
-		return nil, errors.Wrap(err, "sign request failed")
+		return nil, err
 	}
 
 	return resp.Signature, nil
 }
 
+// retryable reports whether err is a transient failure worth retrying.
+func retryable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return true
+	default:
+		return false
+	}
+}
+
+// Close stops the key check and, for signers from NewRemoteFromURL, closes
+// the connection.
+func (r *RemoteSigner) Close() error {
+	r.stop()
+
+	if r.closer == nil {
+		return nil
+	}
+
+	return r.closer()
+}
+
 // fetch key's information from KMS and set fields
 func (r *RemoteSigner) setup(ctx context.Context) error {
-	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	key, err := r.getKey(ctx)
+	if err != nil {
+		return err
+	}
+
+	r.key = key
+
+	r.keyType, err = keyTypeFromProto(key.Scheme)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *RemoteSigner) getKey(ctx context.Context) (*signerservice.Key, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.opts.Timeout)
 	defer cancel()
 
 	resp, err := r.client.GetKey(ctx, &signerservice.GetKeyRequest{Id: r.keyID})
 	switch {
 	case err != nil:
-		return errors.Wrap(err, "get key request failed")
+		return nil, errors.Wrap(err, "get key request failed")
 	case resp.Key == nil:
-		return errors.New("get key response did not include key")
+		return nil, errors.New("get key response did not include key")
 	}
 
-	r.key = resp.Key
+	return resp.Key, nil
+}
+
+// watchKey runs checkKey every KeyCheckInterval until ctx is done or the
+// key changed.
+func (r *RemoteSigner) watchKey(ctx context.Context) {
+	ticker := time.NewTicker(r.opts.KeyCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if r.checkKey(ctx) != nil {
+			return
+		}
+	}
+}
 
-	r.keyType, err = keyTypeFromProto(resp.Key.Scheme)
+// checkKey re-reads the KMS key and, if its public key or scheme differ from
+// the cached ones, fails the signer for good, returning the mismatch. A
+// failed read is only logged: the KMS being down is the breaker's concern.
+func (r *RemoteSigner) checkKey(ctx context.Context) error {
+	key, err := r.getKey(ctx)
 	if err != nil {
-		return err
+		if ctx.Err() == nil {
+			r.logger.Warn("Unable to check remote signer key", "err", err)
+		}
+
+		return nil
 	}
 
-	return nil
+	if bytes.Equal(key.Pubkey, r.key.Pubkey) && key.Scheme == r.key.Scheme {
+		return nil
+	}
+
+	mismatch := errors.Wrapf(
+		ErrRemoteKeyChanged,
+		"key %q public key is now %x (%s), was %x (%s) at startup",
+		r.keyID, key.Pubkey, key.Scheme, r.key.Pubkey, r.key.Scheme,
+	)
+	r.logger.Error("Remote signer key changed, refusing to sign", "err", mismatch)
+
+	r.mu.Lock()
+	r.keyErr = mismatch
+	r.mu.Unlock()
+
+	return mismatch
+}
+
+func (r *RemoteSigner) keyChanged() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.keyErr
 }
 
 func keyTypeFromProto(scheme signerservice.SignatureScheme) (keyfile.Type, error) {
@@ -119,9 +387,25 @@ func keyTypeFromProto(scheme signerservice.SignatureScheme) (keyfile.Type, error
 	}
 }
 
+// remoteConnectParams reconnect to a restarted KMS with exponential backoff,
+// capped so signing resumes soon after it is back.
+var remoteConnectParams = grpc.ConnectParams{
+	Backoff: backoff.Config{
+		BaseDelay:  time.Second,
+		Multiplier: 1.6,
+		Jitter:     0.2,
+		MaxDelay:   15 * time.Second,
+	},
+	MinConnectTimeout: DefaultRemoteTimeout,
+}
+
 // todo: revisit security if needed. we can convert `signer.grpc string` to `signer.grpc{<options>}`
 func newGRPCClientFromURL(url string) (*grpc.ClientConn, error) {
-	return grpc.NewClient(url, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	return grpc.NewClient(
+		url,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithConnectParams(remoteConnectParams),
+	)
 }
 
 func bytesToPayload(message []byte) *signerservice.Payload {
@@ -6,11 +6,14 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/cosmos/kms/gen/signerservice"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/ibc/link/internal/tests/mocks"
 )
@@ -19,6 +22,10 @@ func TestRemote(t *testing.T) {
 	ctx := context.Background()
 	keyID := "test-key"
 	pubKey := []byte("public-key")
+	noKeyCheck := RemoteOptions{KeyCheckInterval: -1}
+	key := &signerservice.Key{Id: keyID, Pubkey: pubKey, Scheme: signerservice.SignatureScheme_ECDSA_SECP256K1ETH}
+	message := []byte("message")
+	unavailable := status.Error(codes.Unavailable, "kms restarting")
 
 	t.Run("happyPath", func(t *testing.T) {
 		// ARRANGE
@@ -38,7 +45,7 @@ func TestRemote(t *testing.T) {
 			Signature: signature,
 		}, nil)
 
-		signer, err := NewRemote(ctx, ts.Client, keyID)
+		signer, err := NewRemote(ctx, ts.Client, keyID, noKeyCheck)
 		require.NoError(t, err)
 
 		// ACT
@@ -59,7 +66,7 @@ func TestRemote(t *testing.T) {
 		ts.OnKeyRequest(keyID, nil, errors.New("key not found"))
 
 		// ACT
-		signer, err := NewRemote(ctx, ts.Client, keyID)
+		signer, err := NewRemote(ctx, ts.Client, keyID, noKeyCheck)
 
 		// ASSERT
 		require.ErrorContains(t, err, "get key request failed")
@@ -80,12 +87,129 @@ func TestRemote(t *testing.T) {
 		}, nil)
 
 		// ACT
-		signer, err := NewRemote(ctx, ts.Client, keyID)
+		signer, err := NewRemote(ctx, ts.Client, keyID, noKeyCheck)
 
 		// ASSERT
 		require.ErrorContains(t, err, "unsupported remote key scheme")
 		assert.Nil(t, signer)
 	})
+
+	t.Run("retriesTransientFailure", func(t *testing.T) {
+		// ARRANGE
+		ts := newRemoteTestSuite(t)
+		ts.OnKeyRequest(keyID, &signerservice.GetKeyResponse{Key: key}, nil)
+		ts.OnSignRequest(keyID, message, nil, unavailable)
+		ts.OnSignRequest(keyID, message, &signerservice.SignResponse{Signature: []byte("signature")}, nil)
+
+		signer, err := NewRemote(ctx, ts.Client, keyID, RemoteOptions{
+			KeyCheckInterval: -1,
+			RetryBackoff:     time.Millisecond,
+		})
+		require.NoError(t, err)
+
+		// ACT
+		signature, err := signer.Sign(ctx, message)
+
+		// ASSERT
+		require.NoError(t, err)
+		assert.Equal(t, []byte("signature"), signature)
+	})
+
+	t.Run("permanentFailureNotRetried", func(t *testing.T) {
+		// ARRANGE
+		ts := newRemoteTestSuite(t)
+		ts.OnKeyRequest(keyID, &signerservice.GetKeyResponse{Key: key}, nil)
+		ts.OnSignRequest(keyID, message, nil, status.Error(codes.InvalidArgument, "bad payload"))
+
+		signer, err := NewRemote(ctx, ts.Client, keyID, noKeyCheck)
+		require.NoError(t, err)
+
+		// ACT
+		_, err = signer.Sign(ctx, message)
+
+		// ASSERT
+		require.ErrorContains(t, err, "bad payload")
+	})
+
+	t.Run("circuitBreakerOpens", func(t *testing.T) {
+		// ARRANGE
+		ts := newRemoteTestSuite(t)
+		ts.OnKeyRequest(keyID, &signerservice.GetKeyResponse{Key: key}, nil)
+		ts.Client.EXPECT().Sign(mock.Anything, mock.Anything).Return(nil, unavailable).Times(2)
+		ts.OnSignRequest(keyID, message, &signerservice.SignResponse{Signature: []byte("signature")}, nil)
+
+		signer, err := NewRemote(ctx, ts.Client, keyID, RemoteOptions{
+			KeyCheckInterval: -1,
+			Retries:          -1,
+			BreakerThreshold: 2,
+			BreakerCooldown:  50 * time.Millisecond,
+		})
+		require.NoError(t, err)
+
+		for range 2 {
+			_, err = signer.Sign(ctx, message)
+			require.ErrorContains(t, err, "kms restarting")
+		}
+
+		// ACT
+		_, errOpen := signer.Sign(ctx, message)
+
+		time.Sleep(60 * time.Millisecond)
+		signature, errTrial := signer.Sign(ctx, message)
+
+		// ASSERT
+		require.ErrorIs(t, errOpen, ErrCircuitOpen, "the KMS must not be called while the breaker is open")
+		require.NoError(t, errTrial, "a trial call goes through after the cooldown")
+		assert.Equal(t, []byte("signature"), signature)
+	})
+
+	t.Run("keyChangeHardFails", func(t *testing.T) {
+		// ARRANGE
+		ts := newRemoteTestSuite(t)
+		ts.OnKeyRequest(keyID, &signerservice.GetKeyResponse{Key: key}, nil)
+		ts.OnKeyRequest(keyID, nil, unavailable)
+		ts.OnKeyRequest(keyID, &signerservice.GetKeyResponse{Key: &signerservice.Key{
+			Id:     keyID,
+			Pubkey: []byte("another-key"),
+			Scheme: signerservice.SignatureScheme_ECDSA_SECP256K1ETH,
+		}}, nil)
+
+		signer, err := NewRemote(ctx, ts.Client, keyID, noKeyCheck)
+		require.NoError(t, err)
+
+		// ACT
+		errUnreachable := signer.checkKey(ctx)
+		errChanged := signer.checkKey(ctx)
+		_, errSign := signer.Sign(ctx, message)
+
+		// ASSERT
+		require.NoError(t, errUnreachable, "an unreachable KMS is not a key change")
+		require.ErrorIs(t, errChanged, ErrRemoteKeyChanged)
+		require.ErrorIs(t, errSign, ErrRemoteKeyChanged)
+		assert.Equal(t, pubKey, signer.PublicKey())
+	})
+
+	t.Run("watchesKey", func(t *testing.T) {
+		// ARRANGE
+		ts := newRemoteTestSuite(t)
+		ts.OnKeyRequest(keyID, &signerservice.GetKeyResponse{Key: key}, nil)
+		ts.Client.EXPECT().GetKey(mock.Anything, mock.Anything).Return(&signerservice.GetKeyResponse{
+			Key: &signerservice.Key{Id: keyID, Pubkey: []byte("another-key"), Scheme: key.Scheme},
+		}, nil).Once()
+
+		// ACT
+		signer, err := NewRemote(ctx, ts.Client, keyID, RemoteOptions{KeyCheckInterval: time.Millisecond})
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, signer.Close()) })
+
+		// ASSERT
+		require.Eventually(t, func() bool {
+			return errors.Is(signer.keyChanged(), ErrRemoteKeyChanged)
+		}, time.Second, time.Millisecond)
+
+		_, err = signer.Sign(ctx, message)
+		require.ErrorIs(t, err, ErrRemoteKeyChanged)
+	})
 }
 
 type remoteTestSuite struct {
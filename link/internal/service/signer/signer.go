@@ -5,11 +5,13 @@ package signer
 import (
 	"context"
 	"encoding/hex"
+	"log/slog"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/pkg/errors"
 
+	"github.com/cosmos/ibc/link/attestor/eddsa"
 	"github.com/cosmos/ibc/link/internal/config"
 	"github.com/cosmos/ibc/link/keyfile"
 )
@@ -46,6 +48,12 @@ func NewSetFromConfig(ctx context.Context, signers config.Signers) (*Set, error)
 	for _, signerConfig := range signers {
 		s, alias, err := NewSignerFromConfig(ctx, signerConfig)
 		if err != nil {
+			// release the key watches and connections of remote signers
+			// already built
+			for _, built := range set.set {
+				Close(built)
+			}
+
 			return nil, err
 		}
 
@@ -66,22 +74,24 @@ func (s *Set) Get(alias string) (Signer, bool) {
 
 func NewSignerFromConfig(ctx context.Context, cfg config.SignerConfig) (signer Signer, alias string, err error) {
 	switch cfg.Type {
-	case config.SignerLocal:
-		path, err := config.ExpandHome(cfg.File)
-		if err != nil {
-			return nil, "", errors.Wrap(err, "expand local signer file")
-		}
-
-		s, err := LocalKeyFromFile(config.KeyFileFallbacks(path)...)
+	case config.SignerLocal, config.SignerMnemonic:
+		s, err := LocalKeyFromConfig(cfg)
 
 		return s, cfg.Alias, err
 	case config.SignerRemote:
-		s, err := NewRemoteFromURL(ctx, cfg.GRPC, cfg.RemoteKeyID)
+		s, err := NewRemoteFromURL(ctx, cfg.GRPC, cfg.RemoteKeyID, RemoteOptionsFromConfig(cfg))
 		if err != nil {
 			return nil, "", errors.Wrap(err, "create remote signer")
 		}
 
 		return s, cfg.Alias, err
+	case config.SignerPKCS11:
+		s, err := NewPKCS11FromConfig(cfg)
+		if err != nil {
+			return nil, "", errors.Wrap(err, "create pkcs11 signer")
+		}
+
+		return s, cfg.Alias, nil
 	default:
 		return nil, "", errors.Errorf("invalid signer type: %s", cfg.Type)
 	}
@@ -104,27 +114,61 @@ func DecodeHex(raw string) ([]byte, error) {
 	return hex.DecodeString(strings.TrimPrefix(raw, "0x"))
 }
 
-// EVMAddressOf derives the EVM address of a configured signer. Only local
-// ECDSA signers resolve; remote signers would need a KMS round trip for
-// their public key and are rejected.
-func EVMAddressOf(cfg config.SignerConfig) (string, error) {
-	if cfg.Type != config.SignerLocal {
-		return "", errors.Errorf("cannot derive an address for remote signer %q", cfg.Alias)
+// EVMAddress derives the EVM address of an ecdsa signer.
+func EVMAddress(s Signer) (string, error) {
+	if s.Type() != keyfile.ECDSA {
+		return "", errors.Errorf("signer is not an ecdsa key, got %s", s.Type())
 	}
 
-	path, err := config.ExpandHome(cfg.File)
-	if err != nil {
-		return "", err
+	return PublicKeyToEVMAddress(s.PublicKey())
+}
+
+// AttestorAddress derives the address a signer attests under: the EVM
+// address of an ecdsa key, or the eddsa attestor address of an ed25519 key.
+func AttestorAddress(s Signer) (string, error) {
+	if s.Type() == keyfile.EDDSA {
+		return eddsa.Address(s.PublicKey())
 	}
 
-	key, err := LocalKeyFromFile(config.KeyFileFallbacks(path)...)
+	return PublicKeyToEVMAddress(s.PublicKey())
+}
+
+// EVMAddressOf derives the EVM address of a configured signer. Remote and
+// pkcs11 signers are asked for their public key, so this dials the KMS or
+// opens the token.
+func EVMAddressOf(ctx context.Context, cfg config.SignerConfig) (string, error) {
+	return addressOf(ctx, cfg, EVMAddress)
+}
+
+// AttestorAddressOf derives the address a configured signer attests under,
+// see AttestorAddress. Like EVMAddressOf, it works for every signer type.
+func AttestorAddressOf(ctx context.Context, cfg config.SignerConfig) (string, error) {
+	return addressOf(ctx, cfg, AttestorAddress)
+}
+
+func addressOf(ctx context.Context, cfg config.SignerConfig, derive func(Signer) (string, error)) (string, error) {
+	s, _, err := NewSignerFromConfig(ctx, cfg)
 	if err != nil {
 		return "", errors.Wrapf(err, "signer %q", cfg.Alias)
 	}
+	defer Close(s)
 
-	if key.Type() != keyfile.ECDSA {
-		return "", errors.Errorf("signer %q is not an ecdsa key", cfg.Alias)
+	address, err := derive(s)
+	if err != nil {
+		return "", errors.Wrapf(err, "signer %q", cfg.Alias)
 	}
 
-	return PublicKeyToEVMAddress(key.PublicKey())
+	return address, nil
+}
+
+// Close releases the connection or session a signer holds, if any.
+func Close(s Signer) {
+	switch closer := s.(type) {
+	case interface{ Close() error }:
+		if err := closer.Close(); err != nil {
+			slog.Warn("Unable to close signer", "err", err)
+		}
+	case interface{ Close() }:
+		closer.Close()
+	}
 }
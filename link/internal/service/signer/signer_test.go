@@ -4,6 +4,8 @@ package signer
 
 import (
 	"context"
+	"encoding/hex"
+	"os"
 	"path/filepath"
 	"testing"
 
@@ -71,6 +73,77 @@ func TestNewSignerFromConfigRequiresExactFilePath(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestNewSignerFromConfigEncrypted(t *testing.T) {
+	key, err := GenerateLocalKey(keyfile.ECDSA)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "keys", "signer.json")
+	require.NoError(t, key.StoreToEncryptedFile(path, []byte("hunter2"), keyfile.Argon2id))
+	t.Chdir(dir)
+
+	// the fallback paths must not mask why the existing file didn't load
+	_, err = LocalKeyFromFile(config.KeyFileFallbacks("signer")...)
+	require.ErrorIs(t, err, keyfile.ErrPassphraseRequired)
+
+	passphraseFile := filepath.Join(dir, "passphrase.txt")
+	require.NoError(t, os.WriteFile(passphraseFile, []byte("hunter2\n"), 0o600))
+	t.Setenv("SIGNER_PASSPHRASE", "hunter2")
+
+	for name, cfg := range map[string]config.SignerConfig{
+		"env":  {Alias: "local", Type: config.SignerLocal, File: "signer", PassphraseEnv: "SIGNER_PASSPHRASE"},
+		"file": {Alias: "local", Type: config.SignerLocal, File: "signer", PassphraseFile: passphraseFile},
+	} {
+		t.Run(name, func(t *testing.T) {
+			loadedSigner, _, err := NewSignerFromConfig(context.Background(), cfg)
+			require.NoError(t, err)
+			require.Equal(t, key.PublicKey(), loadedSigner.PublicKey())
+		})
+	}
+
+	_, _, err = NewSignerFromConfig(context.Background(), config.SignerConfig{
+		Alias: "local", Type: config.SignerLocal, File: "signer", PassphraseEnv: "UNSET_SIGNER_PASSPHRASE",
+	})
+	require.ErrorContains(t, err, "passphrase env UNSET_SIGNER_PASSPHRASE is not set")
+}
+
+func TestNewSignerFromConfigKeystore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keystore.json")
+	privateKey, err := DecodeHex("0x7a28b5ba57c53603b0b07b56bba752f7784bf506fa95edc395f5cf6c7514fe9d")
+	require.NoError(t, err)
+	require.NoError(t, keyfile.StoreKeystore(path, keyfile.ECDSA, privateKey, []byte("hunter2")))
+	t.Setenv("KEYSTORE_PASSPHRASE", "hunter2")
+
+	address, err := EVMAddressOf(context.Background(), config.SignerConfig{
+		Alias: "geth", Type: config.SignerLocal, File: path, PassphraseEnv: "KEYSTORE_PASSPHRASE",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "0x008AeEda4D805471dF9b2A5B0f38A0C3bCBA786b", address)
+}
+
+func TestNewSignerFromConfigMnemonic(t *testing.T) {
+	mnemonicFile := filepath.Join(t.TempDir(), "relayers.mnemonic")
+	require.NoError(t, os.WriteFile(mnemonicFile, []byte("test test test test test test test test test test test junk\n"), 0o600))
+
+	// the Hardhat/Anvil development accounts
+	for index, want := range []string{
+		"0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266",
+		"0x70997970C51812dc3A010C7d01b50e0d17dc79C8",
+	} {
+		address, err := EVMAddressOf(context.Background(), config.SignerConfig{
+			Alias: "relayer", Type: config.SignerMnemonic, MnemonicFile: mnemonicFile, Index: uint32(index),
+		})
+		require.NoError(t, err)
+		require.Equal(t, want, address)
+	}
+
+	eddsa, _, err := NewSignerFromConfig(context.Background(), config.SignerConfig{
+		Alias: "attestor", Type: config.SignerMnemonic, MnemonicFile: mnemonicFile, KeyType: string(keyfile.EDDSA),
+	})
+	require.NoError(t, err)
+	require.Equal(t, keyfile.EDDSA, eddsa.Type())
+}
+
 func TestEVMAddressOf(t *testing.T) {
 	key, err := GenerateLocalKey(keyfile.ECDSA)
 	require.NoError(t, err)
@@ -79,10 +152,48 @@ func TestEVMAddressOf(t *testing.T) {
 	want, err := PublicKeyToEVMAddress(key.PublicKey())
 	require.NoError(t, err)
 
-	got, err := EVMAddressOf(config.SignerConfig{Alias: "d", Type: config.SignerLocal, File: path})
+	got, err := EVMAddressOf(context.Background(), config.SignerConfig{Alias: "d", Type: config.SignerLocal, File: path})
 	require.NoError(t, err)
 	require.Equal(t, want, got)
 
-	_, err = EVMAddressOf(config.SignerConfig{Alias: "kms", Type: config.SignerRemote})
-	require.ErrorContains(t, err, "remote signer")
+	attestorKey, err := GenerateLocalKey(keyfile.EDDSA)
+	require.NoError(t, err)
+	attestorPath := filepath.Join(t.TempDir(), "attestor.json")
+	require.NoError(t, attestorKey.StoreToFile(attestorPath))
+
+	_, err = EVMAddressOf(
+		context.Background(),
+		config.SignerConfig{Alias: "a", Type: config.SignerLocal, File: attestorPath},
+	)
+	require.ErrorContains(t, err, `signer "a": signer is not an ecdsa key`)
+}
+
+func TestAttestorAddressOf(t *testing.T) {
+	dir := t.TempDir()
+
+	for _, keyType := range []keyfile.Type{keyfile.ECDSA, keyfile.EDDSA} {
+		t.Run(string(keyType), func(t *testing.T) {
+			// ARRANGE
+			key, err := GenerateLocalKey(keyType)
+			require.NoError(t, err)
+			path := filepath.Join(dir, string(keyType)+".json")
+			require.NoError(t, key.StoreToFile(path))
+
+			want := "0x" + hex.EncodeToString(key.PublicKey())
+			if keyType == keyfile.ECDSA {
+				want, err = PublicKeyToEVMAddress(key.PublicKey())
+				require.NoError(t, err)
+			}
+
+			// ACT
+			got, err := AttestorAddressOf(
+				context.Background(),
+				config.SignerConfig{Alias: "a", Type: config.SignerLocal, File: path},
+			)
+
+			// ASSERT
+			require.NoError(t, err)
+			require.Equal(t, want, got)
+		})
+	}
 }
@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package signer
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+)
+
+// NewTransactor builds transact opts for contract bindings that sign EVM
+// transactions with s, whatever its backend: the Signer counterpart of
+// bind.NewKeyedTransactorWithChainID.
+func NewTransactor(ctx context.Context, s Signer, chainID *big.Int) (*bind.TransactOpts, error) {
+	address, err := EVMAddress(s)
+	if err != nil {
+		return nil, err
+	}
+
+	from := common.HexToAddress(address)
+	ethSigner := types.LatestSignerForChainID(chainID)
+
+	return &bind.TransactOpts{
+		From:    from,
+		Context: ctx,
+		Signer: func(sender common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			if sender != from {
+				return nil, bind.ErrNotAuthorized
+			}
+
+			signature, err := s.Sign(ctx, ethSigner.Hash(tx).Bytes())
+			if err != nil {
+				return nil, errors.Wrapf(err, "signing tx with address %s", from)
+			}
+
+			return tx.WithSignature(ethSigner, signature)
+		},
+	}, nil
+}
@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package signer
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc/link/keyfile"
+)
+
+func TestNewTransactor(t *testing.T) {
+	ctx := context.Background()
+	chainID := big.NewInt(11155111)
+
+	t.Run("signsAsSigner", func(t *testing.T) {
+		// ARRANGE
+		key, err := GenerateLocalKey(keyfile.ECDSA)
+		require.NoError(t, err)
+
+		address, err := EVMAddress(key)
+		require.NoError(t, err)
+
+		tx := types.NewTx(&types.DynamicFeeTx{ChainID: chainID, Nonce: 7, Gas: 21000})
+
+		// ACT
+		opts, err := NewTransactor(ctx, key, chainID)
+		require.NoError(t, err)
+		signed, err := opts.Signer(opts.From, tx)
+
+		// ASSERT
+		require.NoError(t, err)
+		require.Equal(t, common.HexToAddress(address), opts.From)
+
+		sender, err := types.Sender(types.LatestSignerForChainID(chainID), signed)
+		require.NoError(t, err)
+		require.Equal(t, opts.From, sender)
+	})
+
+	t.Run("rejectsOtherSender", func(t *testing.T) {
+		// ARRANGE
+		key, err := GenerateLocalKey(keyfile.ECDSA)
+		require.NoError(t, err)
+
+		opts, err := NewTransactor(ctx, key, chainID)
+		require.NoError(t, err)
+
+		// ACT
+		other := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+		_, err = opts.Signer(other, types.NewTx(&types.LegacyTx{}))
+
+		// ASSERT
+		require.ErrorIs(t, err, bind.ErrNotAuthorized)
+	})
+
+	t.Run("requiresECDSA", func(t *testing.T) {
+		// ARRANGE
+		key, err := GenerateLocalKey(keyfile.EDDSA)
+		require.NoError(t, err)
+
+		// ACT
+		_, err = NewTransactor(ctx, key, chainID)
+
+		// ASSERT
+		require.ErrorContains(t, err, "not an ecdsa key")
+	})
+}
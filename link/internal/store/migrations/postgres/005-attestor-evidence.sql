@@ -0,0 +1,28 @@
+-- SPDX-License-Identifier: Apache-2.0
+
+-- +migrate Up
+
+create table if not exists attestor_evidence (
+    id                bigserial                PRIMARY KEY,
+    created_at        timestamp with time zone NOT NULL DEFAULT now(),
+    chain_id          text                     NOT NULL,
+    attestor_name     text                     NOT NULL,
+    attestor_address  text                     NOT NULL,
+    kind              text                     NOT NULL,
+    height            bigint                   NOT NULL,
+    data              bytea                    NOT NULL,
+    signature         bytea                    NOT NULL,
+    quorum_data       bytea                    NOT NULL,
+    quorum_signature  bytea                    NOT NULL,
+    cleared_at        timestamp with time zone,
+
+    constraint attestor_evidence_unique
+        unique (chain_id, attestor_address, kind, height, signature)
+);
+
+create index if not exists attestor_evidence_open
+    on attestor_evidence (attestor_address)
+    where cleared_at is null;
+
+-- +migrate Down
+drop table if exists attestor_evidence;
@@ -0,0 +1,28 @@
+-- SPDX-License-Identifier: Apache-2.0
+
+-- +migrate Up
+
+create table if not exists attestor_evidence (
+    id                integer   primary key,
+    created_at        timestamp not null default current_timestamp,
+    chain_id          text      not null,
+    attestor_name     text      not null,
+    attestor_address  text      not null,
+    kind              text      not null,
+    height            integer   not null,
+    data              blob      not null,
+    signature         blob      not null,
+    quorum_data       blob      not null,
+    quorum_signature  blob      not null,
+    cleared_at        timestamp,
+
+    constraint attestor_evidence_unique
+        unique (chain_id, attestor_address, kind, height, signature)
+);
+
+create index if not exists attestor_evidence_open
+    on attestor_evidence (attestor_address)
+    where cleared_at is null;
+
+-- +migrate Down
+drop table if exists attestor_evidence;
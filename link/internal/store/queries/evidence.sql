@@ -0,0 +1,42 @@
+/*
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+-- name: CreateAttestorEvidence :exec
+INSERT INTO attestor_evidence (
+    chain_id,
+    attestor_name,
+    attestor_address,
+    kind,
+    height,
+    data,
+    signature,
+    quorum_data,
+    quorum_signature
+) VALUES (
+    sqlc.arg(chain_id),
+    sqlc.arg(attestor_name),
+    sqlc.arg(attestor_address),
+    sqlc.arg(kind),
+    sqlc.arg(height),
+    sqlc.arg(data),
+    sqlc.arg(signature),
+    sqlc.arg(quorum_data),
+    sqlc.arg(quorum_signature)
+)
+ON CONFLICT (chain_id, attestor_address, kind, height, signature) DO NOTHING;
+
+-- name: ListAttestorEvidence :many
+SELECT * FROM attestor_evidence
+ORDER BY id;
+
+-- name: ListOpenAttestorEvidence :many
+SELECT * FROM attestor_evidence
+WHERE cleared_at IS NULL
+ORDER BY id;
+
+-- name: ClearAttestorEvidence :execrows
+UPDATE attestor_evidence SET
+    cleared_at = CURRENT_TIMESTAMP
+WHERE attestor_address = sqlc.arg(attestor_address)
+AND cleared_at IS NULL;
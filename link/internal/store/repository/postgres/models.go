@@ -8,6 +8,21 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type AttestorEvidence struct {
+	ID              int64
+	CreatedAt       pgtype.Timestamptz
+	ChainID         string
+	AttestorName    string
+	AttestorAddress string
+	Kind            string
+	Height          int64
+	Data            []byte
+	Signature       []byte
+	QuorumData      []byte
+	QuorumSignature []byte
+	ClearedAt       pgtype.Timestamptz
+}
+
 type Packet struct {
 	ID                        int64
 	CreatedAt                 pgtype.Timestamptz
@@ -8,6 +8,21 @@ import (
 	"time"
 )
 
+type AttestorEvidence struct {
+	ID              int64
+	CreatedAt       time.Time
+	ChainID         string
+	AttestorName    string
+	AttestorAddress string
+	Kind            string
+	Height          int64
+	Data            []byte
+	Signature       []byte
+	QuorumData      []byte
+	QuorumSignature []byte
+	ClearedAt       *time.Time
+}
+
 type Packet struct {
 	ID                        int64
 	CreatedAt                 time.Time
@@ -57,6 +57,18 @@ type Repository interface {
 
 	UpdatePacketTimeoutTx(ctx context.Context, key PacketKey, tx PacketTx) error
 	ClearPacketTimeoutTx(ctx context.Context, key PacketKey) error
+
+	// CreateAttestorEvidence records misbehaviour evidence; evidence already
+	// recorded for the same signature is a noop.
+	CreateAttestorEvidence(ctx context.Context, input CreateAttestorEvidence) error
+
+	// ListAttestorEvidence returns evidence oldest first. Cleared evidence is
+	// only included if includeCleared.
+	ListAttestorEvidence(ctx context.Context, includeCleared bool) ([]AttestorEvidence, error)
+
+	// ClearAttestorEvidence marks every uncleared evidence record against
+	// attestorAddress cleared, returning how many it cleared.
+	ClearAttestorEvidence(ctx context.Context, attestorAddress string) (int64, error)
 }
 
 // PacketKey uniquely identifies a packet.
@@ -245,6 +257,75 @@ func (t UpsertPacket) Validate() error {
 	return nil
 }
 
+// EvidenceKind the attestation an evidence record was collected from.
+type EvidenceKind string
+
+// Evidence kinds
+const (
+	EvidenceKindState  EvidenceKind = "STATE"
+	EvidenceKindPacket EvidenceKind = "PACKET"
+)
+
+// AttestorEvidence a validly signed attestation that conflicts with the
+// quorum's attestation of the same kind at the same height, kept as proof
+// the attestor signed a false statement.
+type AttestorEvidence struct {
+	ID        int64
+	CreatedAt time.Time
+
+	// ChainID the chain the attestor attests to.
+	ChainID         string
+	AttestorName    string
+	AttestorAddress string
+
+	Kind   EvidenceKind
+	Height uint64
+
+	// Data and Signature are the attestor's conflicting statement.
+	Data      []byte
+	Signature []byte
+
+	// QuorumData and QuorumSignature are the statement the quorum agreed on
+	// and one quorum member's signature over it.
+	QuorumData      []byte
+	QuorumSignature []byte
+
+	// ClearedAt is set once an operator has cleared the evidence.
+	ClearedAt *time.Time
+}
+
+// CreateAttestorEvidence the fields callers provide when recording evidence.
+type CreateAttestorEvidence struct {
+	ChainID         string
+	AttestorName    string
+	AttestorAddress string
+	Kind            EvidenceKind
+	Height          uint64
+	Data            []byte
+	Signature       []byte
+	QuorumData      []byte
+	QuorumSignature []byte
+}
+
+func (t CreateAttestorEvidence) Validate() error {
+	switch {
+	case t.ChainID == "":
+		return errors.New("chain id is required")
+	case t.AttestorName == "":
+		return errors.New("attestor name is required")
+	case t.AttestorAddress == "":
+		return errors.New("attestor address is required")
+	case t.Kind != EvidenceKindState && t.Kind != EvidenceKindPacket:
+		return errors.New("kind must be STATE or PACKET")
+	case len(t.Data) == 0 || len(t.Signature) == 0:
+		return errors.New("data and signature are required")
+	case len(t.QuorumData) == 0 || len(t.QuorumSignature) == 0:
+		return errors.New("quorum data and signature are required")
+	}
+
+	return nil
+}
+
 // cast db-specific errors to repository errors
 func errNormalize(err error) error {
 	switch {
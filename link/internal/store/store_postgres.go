@@ -400,3 +400,77 @@ func (db *PostgresDB) ClearPacketTimeoutTx(ctx context.Context, key PacketKey) e
 		PacketSequenceNumber: int64(key.Sequence),
 	})
 }
+
+func (db *PostgresDB) CreateAttestorEvidence(ctx context.Context, input CreateAttestorEvidence) error {
+	db.logger.Debug(
+		"CreateAttestorEvidence",
+		"chainID", input.ChainID,
+		"attestor", input.AttestorAddress,
+		"kind", input.Kind,
+		"height", input.Height,
+	)
+
+	if err := input.Validate(); err != nil {
+		return errors.Wrap(err, "invalid evidence")
+	}
+
+	return db.repo.CreateAttestorEvidence(ctx, postgres.CreateAttestorEvidenceParams{
+		ChainID:         input.ChainID,
+		AttestorName:    input.AttestorName,
+		AttestorAddress: input.AttestorAddress,
+		Kind:            string(input.Kind),
+		Height:          int64(input.Height), //nolint:gosec // heights fit in int64
+		Data:            input.Data,
+		Signature:       input.Signature,
+		QuorumData:      input.QuorumData,
+		QuorumSignature: input.QuorumSignature,
+	})
+}
+
+func (db *PostgresDB) ListAttestorEvidence(ctx context.Context, includeCleared bool) ([]AttestorEvidence, error) {
+	db.logger.Debug("ListAttestorEvidence", "includeCleared", includeCleared)
+
+	list := db.repo.ListOpenAttestorEvidence
+	if includeCleared {
+		list = db.repo.ListAttestorEvidence
+	}
+
+	rows, err := list(ctx)
+	if err != nil {
+		return nil, errNormalize(err)
+	}
+
+	evidence := make([]AttestorEvidence, len(rows))
+	for i, row := range rows {
+		evidence[i] = evidenceFromPostgres(row)
+	}
+
+	return evidence, nil
+}
+
+func (db *PostgresDB) ClearAttestorEvidence(ctx context.Context, attestorAddress string) (int64, error) {
+	db.logger.Debug("ClearAttestorEvidence", "attestor", attestorAddress)
+
+	if attestorAddress == "" {
+		return 0, errors.New("attestorAddress is required")
+	}
+
+	return db.repo.ClearAttestorEvidence(ctx, attestorAddress)
+}
+
+func evidenceFromPostgres(row postgres.AttestorEvidence) AttestorEvidence {
+	return AttestorEvidence{
+		ID:              row.ID,
+		CreatedAt:       row.CreatedAt.Time.UTC(),
+		ChainID:         row.ChainID,
+		AttestorName:    row.AttestorName,
+		AttestorAddress: row.AttestorAddress,
+		Kind:            EvidenceKind(row.Kind),
+		Height:          uint64(row.Height), //nolint:gosec // heights fit in int64
+		Data:            row.Data,
+		Signature:       row.Signature,
+		QuorumData:      row.QuorumData,
+		QuorumSignature: row.QuorumSignature,
+		ClearedAt:       pgTimePtr(row.ClearedAt),
+	}
+}
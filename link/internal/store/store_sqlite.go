@@ -448,3 +448,77 @@ func (db *SqliteDB) ClearPacketTimeoutTx(ctx context.Context, key PacketKey) err
 		PacketSequenceNumber: int64(key.Sequence),
 	})
 }
+
+func (db *SqliteDB) CreateAttestorEvidence(ctx context.Context, input CreateAttestorEvidence) error {
+	db.logger.Debug(
+		"CreateAttestorEvidence",
+		"chainID", input.ChainID,
+		"attestor", input.AttestorAddress,
+		"kind", input.Kind,
+		"height", input.Height,
+	)
+
+	if err := input.Validate(); err != nil {
+		return errors.Wrap(err, "invalid evidence")
+	}
+
+	return db.repo.CreateAttestorEvidence(ctx, reposqlite.CreateAttestorEvidenceParams{
+		ChainID:         input.ChainID,
+		AttestorName:    input.AttestorName,
+		AttestorAddress: input.AttestorAddress,
+		Kind:            string(input.Kind),
+		Height:          int64(input.Height), //nolint:gosec // heights fit in int64
+		Data:            input.Data,
+		Signature:       input.Signature,
+		QuorumData:      input.QuorumData,
+		QuorumSignature: input.QuorumSignature,
+	})
+}
+
+func (db *SqliteDB) ListAttestorEvidence(ctx context.Context, includeCleared bool) ([]AttestorEvidence, error) {
+	db.logger.Debug("ListAttestorEvidence", "includeCleared", includeCleared)
+
+	list := db.repo.ListOpenAttestorEvidence
+	if includeCleared {
+		list = db.repo.ListAttestorEvidence
+	}
+
+	rows, err := list(ctx)
+	if err != nil {
+		return nil, errNormalize(err)
+	}
+
+	evidence := make([]AttestorEvidence, len(rows))
+	for i, row := range rows {
+		evidence[i] = evidenceFromSqlite(row)
+	}
+
+	return evidence, nil
+}
+
+func (db *SqliteDB) ClearAttestorEvidence(ctx context.Context, attestorAddress string) (int64, error) {
+	db.logger.Debug("ClearAttestorEvidence", "attestor", attestorAddress)
+
+	if attestorAddress == "" {
+		return 0, errors.New("attestorAddress is required")
+	}
+
+	return db.repo.ClearAttestorEvidence(ctx, attestorAddress)
+}
+
+func evidenceFromSqlite(row reposqlite.AttestorEvidence) AttestorEvidence {
+	return AttestorEvidence{
+		ID:              row.ID,
+		CreatedAt:       row.CreatedAt.UTC(),
+		ChainID:         row.ChainID,
+		AttestorName:    row.AttestorName,
+		AttestorAddress: row.AttestorAddress,
+		Kind:            EvidenceKind(row.Kind),
+		Height:          uint64(row.Height), //nolint:gosec // heights fit in int64
+		Data:            row.Data,
+		Signature:       row.Signature,
+		QuorumData:      row.QuorumData,
+		QuorumSignature: row.QuorumSignature,
+		ClearedAt:       utcTimePtr(row.ClearedAt),
+	}
+}
@@ -454,4 +454,70 @@ func testRepoReadWrite(t *testing.T, s Store) {
 		)
 		assert.Nil(t, fetch().RecvTxHash)
 	})
+
+	t.Run("attestorEvidence", func(t *testing.T) {
+		const attestorAddress = "0x00000000000000000000000000000000000000aa"
+
+		input := CreateAttestorEvidence{
+			ChainID:         chainIDBase,
+			AttestorName:    "attestor-a",
+			AttestorAddress: attestorAddress,
+			Kind:            EvidenceKindState,
+			Height:          100,
+			Data:            []byte("false"),
+			Signature:       []byte("sig-false"),
+			QuorumData:      []byte("true"),
+			QuorumSignature: []byte("sig-true"),
+		}
+
+		// recording the same evidence twice is a noop
+		require.NoError(t, s.CreateAttestorEvidence(ctx, input))
+		require.NoError(t, s.CreateAttestorEvidence(ctx, input))
+
+		packetInput := input
+		packetInput.Kind = EvidenceKindPacket
+		packetInput.Signature = []byte("sig-false-packet")
+		require.NoError(t, s.CreateAttestorEvidence(ctx, packetInput))
+
+		invalid := input
+		invalid.Kind = "UNKNOWN"
+		require.ErrorContains(t, s.CreateAttestorEvidence(ctx, invalid), "kind must be")
+
+		evidence, err := s.ListAttestorEvidence(ctx, false)
+		require.NoError(t, err)
+		require.Len(t, evidence, 2)
+
+		got := evidence[0]
+		assert.NotZero(t, got.ID)
+		assert.False(t, got.CreatedAt.IsZero())
+		assert.Equal(t, chainIDBase, got.ChainID)
+		assert.Equal(t, "attestor-a", got.AttestorName)
+		assert.Equal(t, attestorAddress, got.AttestorAddress)
+		assert.Equal(t, EvidenceKindState, got.Kind)
+		assert.EqualValues(t, 100, got.Height)
+		assert.Equal(t, []byte("false"), got.Data)
+		assert.Equal(t, []byte("sig-false"), got.Signature)
+		assert.Equal(t, []byte("true"), got.QuorumData)
+		assert.Equal(t, []byte("sig-true"), got.QuorumSignature)
+		assert.Nil(t, got.ClearedAt)
+		assert.Equal(t, EvidenceKindPacket, evidence[1].Kind)
+
+		// clearing hides the evidence unless cleared evidence is requested
+		cleared, err := s.ClearAttestorEvidence(ctx, attestorAddress)
+		require.NoError(t, err)
+		assert.EqualValues(t, 2, cleared)
+
+		cleared, err = s.ClearAttestorEvidence(ctx, attestorAddress)
+		require.NoError(t, err)
+		assert.Zero(t, cleared)
+
+		evidence, err = s.ListAttestorEvidence(ctx, false)
+		require.NoError(t, err)
+		assert.Empty(t, evidence)
+
+		evidence, err = s.ListAttestorEvidence(ctx, true)
+		require.NoError(t, err)
+		require.Len(t, evidence, 2)
+		assert.NotNil(t, evidence[0].ClearedAt)
+	})
 }
@@ -39,6 +39,84 @@ func (_m *MockProofGenerator) EXPECT() *MockProofGenerator_Expecter {
 	return &MockProofGenerator_Expecter{mock: &_m.Mock}
 }
 
+// BatchProofs provides a mock function for the type MockProofGenerator
+func (_mock *MockProofGenerator) BatchProofs(ctx context.Context, height uint64, kind v2.ProofKind, packets []types.Packet) (v2.BatchProof, error) {
+	ret := _mock.Called(ctx, height, kind, packets)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BatchProofs")
+	}
+
+	var r0 v2.BatchProof
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uint64, v2.ProofKind, []types.Packet) (v2.BatchProof, error)); ok {
+		return returnFunc(ctx, height, kind, packets)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uint64, v2.ProofKind, []types.Packet) v2.BatchProof); ok {
+		r0 = returnFunc(ctx, height, kind, packets)
+	} else {
+		r0 = ret.Get(0).(v2.BatchProof)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uint64, v2.ProofKind, []types.Packet) error); ok {
+		r1 = returnFunc(ctx, height, kind, packets)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProofGenerator_BatchProofs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchProofs'
+type MockProofGenerator_BatchProofs_Call struct {
+	*mock.Call
+}
+
+// BatchProofs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - height uint64
+//   - kind v2.ProofKind
+//   - packets []types.Packet
+func (_e *MockProofGenerator_Expecter) BatchProofs(ctx any, height any, kind any, packets any) *MockProofGenerator_BatchProofs_Call {
+	return &MockProofGenerator_BatchProofs_Call{Call: _e.mock.On("BatchProofs", ctx, height, kind, packets)}
+}
+
+func (_c *MockProofGenerator_BatchProofs_Call) Run(run func(ctx context.Context, height uint64, kind v2.ProofKind, packets []types.Packet)) *MockProofGenerator_BatchProofs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uint64
+		if args[1] != nil {
+			arg1 = args[1].(uint64)
+		}
+		var arg2 v2.ProofKind
+		if args[2] != nil {
+			arg2 = args[2].(v2.ProofKind)
+		}
+		var arg3 []types.Packet
+		if args[3] != nil {
+			arg3 = args[3].([]types.Packet)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProofGenerator_BatchProofs_Call) Return(batchProof v2.BatchProof, err error) *MockProofGenerator_BatchProofs_Call {
+	_c.Call.Return(batchProof, err)
+	return _c
+}
+
+func (_c *MockProofGenerator_BatchProofs_Call) RunAndReturn(run func(ctx context.Context, height uint64, kind v2.ProofKind, packets []types.Packet) (v2.BatchProof, error)) *MockProofGenerator_BatchProofs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // LatestProvableHeight provides a mock function for the type MockProofGenerator
 func (_mock *MockProofGenerator) LatestProvableHeight(ctx context.Context) (uint64, time.Time, error) {
 	ret := _mock.Called(ctx)
@@ -37,6 +37,72 @@ func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
 	return &MockRepository_Expecter{mock: &_m.Mock}
 }
 
+// ClearAttestorEvidence provides a mock function for the type MockRepository
+func (_mock *MockRepository) ClearAttestorEvidence(ctx context.Context, attestorAddress string) (int64, error) {
+	ret := _mock.Called(ctx, attestorAddress)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClearAttestorEvidence")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return returnFunc(ctx, attestorAddress)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = returnFunc(ctx, attestorAddress)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, attestorAddress)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_ClearAttestorEvidence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClearAttestorEvidence'
+type MockRepository_ClearAttestorEvidence_Call struct {
+	*mock.Call
+}
+
+// ClearAttestorEvidence is a helper method to define mock.On call
+//   - ctx context.Context
+//   - attestorAddress string
+func (_e *MockRepository_Expecter) ClearAttestorEvidence(ctx any, attestorAddress any) *MockRepository_ClearAttestorEvidence_Call {
+	return &MockRepository_ClearAttestorEvidence_Call{Call: _e.mock.On("ClearAttestorEvidence", ctx, attestorAddress)}
+}
+
+func (_c *MockRepository_ClearAttestorEvidence_Call) Run(run func(ctx context.Context, attestorAddress string)) *MockRepository_ClearAttestorEvidence_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_ClearAttestorEvidence_Call) Return(n int64, err error) *MockRepository_ClearAttestorEvidence_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockRepository_ClearAttestorEvidence_Call) RunAndReturn(run func(ctx context.Context, attestorAddress string) (int64, error)) *MockRepository_ClearAttestorEvidence_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ClearPacketAckTx provides a mock function for the type MockRepository
 func (_mock *MockRepository) ClearPacketAckTx(ctx context.Context, key store.PacketKey) error {
 	ret := _mock.Called(ctx, key)
@@ -208,6 +274,63 @@ func (_c *MockRepository_ClearPacketTimeoutTx_Call) RunAndReturn(run func(ctx co
 	return _c
 }
 
+// CreateAttestorEvidence provides a mock function for the type MockRepository
+func (_mock *MockRepository) CreateAttestorEvidence(ctx context.Context, input store.CreateAttestorEvidence) error {
+	ret := _mock.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAttestorEvidence")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, store.CreateAttestorEvidence) error); ok {
+		r0 = returnFunc(ctx, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_CreateAttestorEvidence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAttestorEvidence'
+type MockRepository_CreateAttestorEvidence_Call struct {
+	*mock.Call
+}
+
+// CreateAttestorEvidence is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input store.CreateAttestorEvidence
+func (_e *MockRepository_Expecter) CreateAttestorEvidence(ctx any, input any) *MockRepository_CreateAttestorEvidence_Call {
+	return &MockRepository_CreateAttestorEvidence_Call{Call: _e.mock.On("CreateAttestorEvidence", ctx, input)}
+}
+
+func (_c *MockRepository_CreateAttestorEvidence_Call) Run(run func(ctx context.Context, input store.CreateAttestorEvidence)) *MockRepository_CreateAttestorEvidence_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 store.CreateAttestorEvidence
+		if args[1] != nil {
+			arg1 = args[1].(store.CreateAttestorEvidence)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_CreateAttestorEvidence_Call) Return(err error) *MockRepository_CreateAttestorEvidence_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_CreateAttestorEvidence_Call) RunAndReturn(run func(ctx context.Context, input store.CreateAttestorEvidence) error) *MockRepository_CreateAttestorEvidence_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateRelayRequest provides a mock function for the type MockRepository
 func (_mock *MockRepository) CreateRelayRequest(ctx context.Context, chainID string, txHash string) error {
 	ret := _mock.Called(ctx, chainID, txHash)
@@ -345,6 +468,74 @@ func (_c *MockRepository_GetRelayRequest_Call) RunAndReturn(run func(ctx context
 	return _c
 }
 
+// ListAttestorEvidence provides a mock function for the type MockRepository
+func (_mock *MockRepository) ListAttestorEvidence(ctx context.Context, includeCleared bool) ([]store.AttestorEvidence, error) {
+	ret := _mock.Called(ctx, includeCleared)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAttestorEvidence")
+	}
+
+	var r0 []store.AttestorEvidence
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, bool) ([]store.AttestorEvidence, error)); ok {
+		return returnFunc(ctx, includeCleared)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, bool) []store.AttestorEvidence); ok {
+		r0 = returnFunc(ctx, includeCleared)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]store.AttestorEvidence)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, bool) error); ok {
+		r1 = returnFunc(ctx, includeCleared)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_ListAttestorEvidence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAttestorEvidence'
+type MockRepository_ListAttestorEvidence_Call struct {
+	*mock.Call
+}
+
+// ListAttestorEvidence is a helper method to define mock.On call
+//   - ctx context.Context
+//   - includeCleared bool
+func (_e *MockRepository_Expecter) ListAttestorEvidence(ctx any, includeCleared any) *MockRepository_ListAttestorEvidence_Call {
+	return &MockRepository_ListAttestorEvidence_Call{Call: _e.mock.On("ListAttestorEvidence", ctx, includeCleared)}
+}
+
+func (_c *MockRepository_ListAttestorEvidence_Call) Run(run func(ctx context.Context, includeCleared bool)) *MockRepository_ListAttestorEvidence_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 bool
+		if args[1] != nil {
+			arg1 = args[1].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_ListAttestorEvidence_Call) Return(attestorEvidences []store.AttestorEvidence, err error) *MockRepository_ListAttestorEvidence_Call {
+	_c.Call.Return(attestorEvidences, err)
+	return _c
+}
+
+func (_c *MockRepository_ListAttestorEvidence_Call) RunAndReturn(run func(ctx context.Context, includeCleared bool) ([]store.AttestorEvidence, error)) *MockRepository_ListAttestorEvidence_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListDispatchablePackets provides a mock function for the type MockRepository
 func (_mock *MockRepository) ListDispatchablePackets(ctx context.Context) ([]store.Packet, error) {
 	ret := _mock.Called(ctx)
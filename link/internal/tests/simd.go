@@ -0,0 +1,163 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// SimdNode is a single-node simd chain running as a local process.
+type SimdNode struct {
+	// GRPC is the node's gRPC address.
+	GRPC string
+	// ChainID is the node's chain ID.
+	ChainID string
+	// Mnemonic derives the funded account, at coin type 118.
+	Mnemonic string
+}
+
+const (
+	simdChainID   = "simd-1"
+	simdDenom     = "stake"
+	simdKey       = "deployer"
+	simdStartWait = time.Minute
+	// simdMnemonic is the well-known test mnemonic of the funded account.
+	simdMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+)
+
+// NewSimdNode initialises and starts a single-node simd with one funded
+// account, and waits for it to produce blocks. The binary is simd from PATH
+// unless SIMD_BINARY is set; `make test-simd` builds it from the pinned
+// ibc-go. Automatically stops the node after the test.
+func NewSimdNode(t *testing.T) *SimdNode {
+	t.Helper()
+
+	binary := os.Getenv("SIMD_BINARY")
+	if binary == "" {
+		binary = "simd"
+	}
+	home := t.TempDir()
+
+	t.Logf("Starting simd node: %s", binary)
+	start := time.Now()
+
+	simd := func(stdin string, args ...string) {
+		t.Helper()
+
+		cmd := exec.Command(binary, append(args, "--home", home)...)
+		cmd.Stdin = strings.NewReader(stdin)
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, "simd %s: %s", strings.Join(args, " "), out)
+	}
+	simd("", "init", "test", "--chain-id", simdChainID, "--default-denom", simdDenom)
+	simd(simdMnemonic+"\n", "keys", "add", simdKey, "--recover", "--keyring-backend", "test")
+	simd("", "genesis", "add-genesis-account", simdKey, "100000000000000"+simdDenom, "--keyring-backend", "test")
+	simd("", "genesis", "gentx", simdKey, "1000000000"+simdDenom,
+		"--chain-id", simdChainID, "--keyring-backend", "test")
+	simd("", "genesis", "collect-gentxs")
+
+	grpcAddr, rpcAddr := localAddr(t), localAddr(t)
+	logFile, err := os.Create(filepath.Join(home, "simd.log"))
+	require.NoError(t, err)
+
+	cmd := exec.Command(binary, "start",
+		"--home", home,
+		"--grpc.address", grpcAddr,
+		"--rpc.laddr", "tcp://"+rpcAddr,
+		"--p2p.laddr", "tcp://"+localAddr(t),
+		"--rpc.pprof_laddr", "",
+		"--api.enable=false",
+		"--minimum-gas-prices", "0"+simdDenom,
+	)
+	cmd.Stdout, cmd.Stderr = logFile, logFile
+	require.NoError(t, cmd.Start(), "start simd")
+
+	t.Cleanup(func() {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+		_ = logFile.Close()
+
+		if t.Failed() {
+			if out, readErr := os.ReadFile(logFile.Name()); readErr == nil {
+				t.Logf("simd log:\n%s", out)
+			}
+		}
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), simdStartWait)
+	defer cancel()
+
+	// the first block carries the gentx, so wait for the one after it
+	for height := int64(0); height < 2; {
+		select {
+		case <-ctx.Done():
+			require.FailNow(t, "simd did not produce blocks", "after %s", simdStartWait)
+		case <-time.After(500 * time.Millisecond):
+		}
+
+		height, _ = latestHeight(ctx, rpcAddr)
+	}
+
+	t.Logf("simd node started in %s", time.Since(start))
+
+	return &SimdNode{
+		GRPC:     grpcAddr,
+		ChainID:  simdChainID,
+		Mnemonic: simdMnemonic,
+	}
+}
+
+// latestHeight reads the latest block height from CometBFT's status endpoint.
+func latestHeight(ctx context.Context, rpcAddr string) (int64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://"+rpcAddr+"/status", nil)
+	if err != nil {
+		return 0, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+
+	//nolint:errcheck
+	defer resp.Body.Close()
+
+	var status struct {
+		Result struct {
+			SyncInfo struct {
+				LatestBlockHeight string `json:"latest_block_height"`
+			} `json:"sync_info"`
+		} `json:"result"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&status); err != nil {
+		return 0, fmt.Errorf("decode status: %w", err)
+	}
+
+	return strconv.ParseInt(status.Result.SyncInfo.LatestBlockHeight, 10, 64)
+}
+
+// localAddr reserves a free loopback address for one of the node's listeners.
+func localAddr(t *testing.T) string {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	addr := l.Addr().String()
+	require.NoError(t, l.Close())
+
+	return addr
+}
@@ -11,6 +11,7 @@ import (
 // ENV constants for testing
 const (
 	EnvTestPostgres = "TEST_POSTGRES"
+	EnvTestSimd     = "TEST_SIMD"
 )
 
 func GuardPostgresTests(t *testing.T) {
@@ -23,6 +24,16 @@ func GuardPostgresTests(t *testing.T) {
 	t.Skipf("Postgres tests are disabled. Set env %s=true to enable them.", EnvTestPostgres)
 }
 
+func GuardSimdTests(t *testing.T) {
+	t.Helper()
+
+	if EnvBool(EnvTestSimd) {
+		return
+	}
+
+	t.Skipf("simd tests are disabled. Set env %s=true to enable them.", EnvTestSimd)
+}
+
 func EnvBool(env string) bool {
 	b, _ := strconv.ParseBool(os.Getenv(env))
 
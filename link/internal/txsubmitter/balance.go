@@ -0,0 +1,470 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package txsubmitter
+
+import (
+	"context"
+	"log/slog"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/prometheus/client_golang/prometheus"
+
+	v2 "github.com/cosmos/ibc/link/internal/types/v2"
+)
+
+// DefaultBalanceCheckInterval how often wallet balances are read when no
+// override is configured.
+const DefaultBalanceCheckInterval = time.Minute
+
+// ErrBalanceCritical the wallet's balance is at or below its critical
+// threshold, so its submissions are paused until it is funded.
+var ErrBalanceCritical = errors.New("signer balance critical")
+
+// BalanceLevel how a wallet's balance compares to its thresholds.
+type BalanceLevel int
+
+// Balance levels
+const (
+	// BalanceUnknown the balance has not been read yet.
+	BalanceUnknown BalanceLevel = iota
+	BalanceOK
+	BalanceWarn
+	BalanceCritical
+)
+
+func (l BalanceLevel) String() string {
+	switch l {
+	case BalanceOK:
+		return "ok"
+	case BalanceWarn:
+		return "warn"
+	case BalanceCritical:
+		return "critical"
+	default:
+		return "unknown"
+	}
+}
+
+// BalanceReader is implemented by tx submitters that can read their
+// wallet's balance.
+type BalanceReader interface {
+	Address() string
+	Balance(ctx context.Context) (*big.Int, error)
+}
+
+// Transferrer is implemented by tx submitters that can send native funds
+// from their wallet.
+type Transferrer interface {
+	Transfer(ctx context.Context, to string, amount *big.Int) (*v2.Submission, error)
+}
+
+// BalanceThresholds balance levels of a wallet, in wei. Unset thresholds
+// never trigger.
+type BalanceThresholds struct {
+	Warn     *big.Int
+	Critical *big.Int
+}
+
+// Level the level of balance.
+func (t BalanceThresholds) Level(balance *big.Int) BalanceLevel {
+	switch {
+	case t.Critical != nil && balance.Cmp(t.Critical) <= 0:
+		return BalanceCritical
+	case t.Warn != nil && balance.Cmp(t.Warn) <= 0:
+		return BalanceWarn
+	default:
+		return BalanceOK
+	}
+}
+
+// Treasury the wallet a watched wallet is topped up from.
+type Treasury struct {
+	Alias       string
+	TxSubmitter Transferrer
+
+	// Target the balance a top-up brings the wallet back to.
+	Target *big.Int
+}
+
+// Wallet one (chain, signer) wallet watched by a BalanceMonitor.
+type Wallet struct {
+	ChainID string
+	Alias   string
+	Reader  BalanceReader
+
+	Thresholds    BalanceThresholds
+	CheckInterval time.Duration
+
+	// Treasury tops the wallet up when it falls below the treasury's
+	// target; nil disables top-ups.
+	Treasury *Treasury
+}
+
+// WalletBalance the last observed balance of a wallet.
+type WalletBalance struct {
+	ChainID   string    `json:"chainId"`
+	Signer    string    `json:"signer"`
+	Address   string    `json:"address"`
+	Balance   string    `json:"balance,omitempty"`
+	Level     string    `json:"level"`
+	CheckedAt time.Time `json:"checkedAt,omitzero"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// BalanceMonitor periodically reads the balance of every relayer wallet,
+// reports it through metrics and health, and pauses submissions from
+// wallets at their critical level. Wallets with a treasury are topped back
+// up to its target once they fall below it, one top-up in flight at a
+// time.
+type BalanceMonitor struct {
+	wallets []*watchedWallet
+
+	balance     *prometheus.GaugeVec
+	level       *prometheus.GaugeVec
+	checkErrors *prometheus.CounterVec
+	topUps      *prometheus.CounterVec
+
+	cancel  context.CancelFunc
+	stopped sync.WaitGroup
+
+	logger *slog.Logger
+}
+
+type watchedWallet struct {
+	Wallet
+
+	mu        sync.Mutex
+	balance   *big.Int
+	level     BalanceLevel
+	checkedAt time.Time
+	err       error
+
+	// the top-up in flight, if any
+	topUpTxHash string
+	topUpSentAt time.Time
+
+	logger *slog.Logger
+}
+
+var _ prometheus.Collector = (*BalanceMonitor)(nil)
+
+func NewBalanceMonitor(wallets []Wallet) *BalanceMonitor {
+	walletLabels := []string{"chain_id", "signer"}
+
+	m := &BalanceMonitor{
+		balance: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "ibc_link",
+			Name:      "signer_balance_wei",
+			Help:      "Last observed balance of a relayer wallet, in wei.",
+		}, []string{"chain_id", "signer", "address"}),
+		level: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "ibc_link",
+			Name:      "signer_balance_level",
+			Help:      "Balance level of a relayer wallet: 1 ok, 2 warn, 3 critical.",
+		}, walletLabels),
+		checkErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "ibc_link",
+			Name:      "signer_balance_check_errors_total",
+			Help:      "Failed reads of a relayer wallet's balance.",
+		}, walletLabels),
+		topUps: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "ibc_link",
+			Name:      "signer_topups_total",
+			Help:      "Top-ups of a relayer wallet from its treasury, by result.",
+		}, []string{"chain_id", "signer", "result"}),
+		logger: slog.With("module", "txsubmitter"),
+	}
+
+	for _, wallet := range wallets {
+		if wallet.CheckInterval == 0 {
+			wallet.CheckInterval = DefaultBalanceCheckInterval
+		}
+
+		logger := m.logger.With("chainID", wallet.ChainID, "signer", wallet.Alias, "address", wallet.Reader.Address())
+		m.wallets = append(m.wallets, &watchedWallet{Wallet: wallet, logger: logger})
+	}
+
+	return m
+}
+
+// Start reads every wallet's balance at its check interval, each in its
+// own goroutine, until Stop is called.
+func (m *BalanceMonitor) Start() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancel = cancel
+
+	for _, wallet := range m.wallets {
+		m.stopped.Go(func() {
+			// fire immediately, then at the check interval
+			ticker := time.NewTicker(time.Millisecond)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					ticker.Reset(wallet.CheckInterval)
+					m.check(ctx, wallet)
+				}
+			}
+		})
+	}
+
+	return nil
+}
+
+// Stop cancels the balance checks and blocks until they have exited.
+func (m *BalanceMonitor) Stop() error {
+	if m.cancel == nil {
+		return nil
+	}
+
+	m.cancel()
+	m.stopped.Wait()
+
+	return nil
+}
+
+// Check reads every wallet's balance once, topping wallets up as needed.
+func (m *BalanceMonitor) Check(ctx context.Context) {
+	for _, wallet := range m.wallets {
+		m.check(ctx, wallet)
+	}
+}
+
+// Balances the last observed balance of every watched wallet.
+func (m *BalanceMonitor) Balances() []WalletBalance {
+	balances := make([]WalletBalance, 0, len(m.wallets))
+
+	for _, wallet := range m.wallets {
+		wallet.mu.Lock()
+		balance := WalletBalance{
+			ChainID:   wallet.ChainID,
+			Signer:    wallet.Alias,
+			Address:   wallet.Reader.Address(),
+			Level:     wallet.level.String(),
+			CheckedAt: wallet.checkedAt,
+		}
+		if wallet.balance != nil {
+			balance.Balance = wallet.balance.String()
+		}
+		if wallet.err != nil {
+			balance.Error = wallet.err.Error()
+		}
+		wallet.mu.Unlock()
+
+		balances = append(balances, balance)
+	}
+
+	return balances
+}
+
+// Name names the monitor's health check.
+func (m *BalanceMonitor) Name() string {
+	return "signerBalances"
+}
+
+// Health reports every wallet's balance; the relayer is unhealthy while
+// any wallet is at its critical level.
+func (m *BalanceMonitor) Health(context.Context) (details any, healthy bool) {
+	balances := m.Balances()
+
+	healthy = true
+	for _, balance := range balances {
+		if balance.Level == BalanceCritical.String() {
+			healthy = false
+		}
+	}
+
+	return balances, healthy
+}
+
+func (m *BalanceMonitor) Describe(ch chan<- *prometheus.Desc) {
+	m.balance.Describe(ch)
+	m.level.Describe(ch)
+	m.checkErrors.Describe(ch)
+	m.topUps.Describe(ch)
+}
+
+func (m *BalanceMonitor) Collect(ch chan<- prometheus.Metric) {
+	m.balance.Collect(ch)
+	m.level.Collect(ch)
+	m.checkErrors.Collect(ch)
+	m.topUps.Collect(ch)
+}
+
+// wallet the watched wallet of a (chain, signer) pair.
+func (m *BalanceMonitor) wallet(chainID, alias string) (*watchedWallet, bool) {
+	for _, wallet := range m.wallets {
+		if wallet.ChainID == chainID && wallet.Alias == alias {
+			return wallet, true
+		}
+	}
+
+	return nil, false
+}
+
+func (m *BalanceMonitor) check(ctx context.Context, wallet *watchedWallet) {
+	// settle a previous top-up before reading the balance it may have changed
+	topUpPending := m.topUpPending(ctx, wallet)
+
+	balance, err := wallet.Reader.Balance(ctx)
+	if err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+
+		wallet.logger.Warn("Failed to read signer balance", "err", err)
+		m.checkErrors.WithLabelValues(wallet.ChainID, wallet.Alias).Inc()
+
+		wallet.mu.Lock()
+		wallet.err = err
+		wallet.mu.Unlock()
+
+		return
+	}
+
+	level := wallet.Thresholds.Level(balance)
+
+	wallet.mu.Lock()
+	prevLevel := wallet.level
+	wallet.balance, wallet.level, wallet.checkedAt, wallet.err = balance, level, time.Now(), nil
+	wallet.mu.Unlock()
+
+	balanceWei, _ := new(big.Float).SetInt(balance).Float64()
+	m.balance.WithLabelValues(wallet.ChainID, wallet.Alias, wallet.Reader.Address()).Set(balanceWei)
+	m.level.WithLabelValues(wallet.ChainID, wallet.Alias).Set(float64(level))
+
+	if level != prevLevel {
+		logLevelChange(wallet, prevLevel, level, balance)
+	}
+
+	if wallet.Treasury != nil && !topUpPending && balance.Cmp(wallet.Treasury.Target) < 0 {
+		m.topUp(ctx, wallet, new(big.Int).Sub(wallet.Treasury.Target, balance))
+	}
+}
+
+func logLevelChange(wallet *watchedWallet, prevLevel, level BalanceLevel, balance *big.Int) {
+	attrs := []any{"balance", balance, "level", level, "prevLevel", prevLevel}
+
+	switch level {
+	case BalanceCritical:
+		wallet.logger.Error("Signer balance critical, pausing its submissions", attrs...)
+	case BalanceWarn:
+		wallet.logger.Warn("Signer balance low", attrs...)
+	default:
+		if prevLevel != BalanceUnknown {
+			wallet.logger.Info("Signer balance recovered", attrs...)
+		}
+	}
+}
+
+// topUpPending reports whether the wallet's last top-up is still in
+// flight, forgetting it once it landed, failed or expired.
+func (m *BalanceMonitor) topUpPending(ctx context.Context, wallet *watchedWallet) bool {
+	wallet.mu.Lock()
+	txHash, sentAt := wallet.topUpTxHash, wallet.topUpSentAt
+	wallet.mu.Unlock()
+
+	if txHash == "" {
+		return false
+	}
+
+	checker, ok := wallet.Treasury.TxSubmitter.(RetryChecker)
+	if !ok {
+		// nothing to ask: give the top-up one check interval to land
+		if time.Since(sentAt) < wallet.CheckInterval {
+			return true
+		}
+	} else {
+		retry, expired, err := checker.CheckRetry(ctx, txHash, sentAt)
+		switch {
+		case errors.Is(err, v2.ErrTxNotFound):
+			return true
+		case err != nil:
+			wallet.logger.Warn("Failed to check top-up tx", "txHash", txHash, "err", err)
+			return true
+		case retry:
+			wallet.logger.Warn("Top-up tx did not land", "txHash", txHash, "expired", expired)
+			m.topUps.WithLabelValues(wallet.ChainID, wallet.Alias, "failed").Inc()
+		}
+	}
+
+	wallet.mu.Lock()
+	wallet.topUpTxHash = ""
+	wallet.mu.Unlock()
+
+	return false
+}
+
+func (m *BalanceMonitor) topUp(ctx context.Context, wallet *watchedWallet, amount *big.Int) {
+	treasury := wallet.Treasury
+
+	submission, err := treasury.TxSubmitter.Transfer(ctx, wallet.Reader.Address(), amount)
+	if err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+
+		wallet.logger.Error("Failed to top up signer", "treasury", treasury.Alias, "amount", amount, "err", err)
+		m.topUps.WithLabelValues(wallet.ChainID, wallet.Alias, "failed").Inc()
+
+		return
+	}
+
+	wallet.mu.Lock()
+	wallet.topUpTxHash, wallet.topUpSentAt = submission.TxHash, submission.SubmittedAt
+	wallet.mu.Unlock()
+
+	wallet.logger.Info("Topped up signer", "treasury", treasury.Alias, "amount", amount, "txHash", submission.TxHash)
+	m.topUps.WithLabelValues(wallet.ChainID, wallet.Alias, "sent").Inc()
+}
+
+// balanceGuard pauses a wallet's submissions while its balance is
+// critical, failing them fast instead of letting gas estimation or the
+// node reject them.
+type balanceGuard struct {
+	TxSubmitter
+
+	wallet *watchedWallet
+}
+
+var (
+	_ TxSubmitter  = (*balanceGuard)(nil)
+	_ RetryChecker = (*balanceGuard)(nil)
+)
+
+func (g *balanceGuard) Submit(ctx context.Context, intent v2.TxIntent) (*v2.Submission, error) {
+	if g.Paused() {
+		return nil, errors.Wrapf(ErrBalanceCritical, "signer %q on chain %q", g.wallet.Alias, g.wallet.ChainID)
+	}
+
+	return g.TxSubmitter.Submit(ctx, intent)
+}
+
+// CheckRetry keeps a guarded wallet usable as a Pool member.
+func (g *balanceGuard) CheckRetry(
+	ctx context.Context,
+	txHash string,
+	sentAt time.Time,
+) (retry, expired bool, err error) {
+	if checker, ok := g.TxSubmitter.(RetryChecker); ok {
+		return checker.CheckRetry(ctx, txHash, sentAt)
+	}
+
+	retry, err = g.TxSubmitter.ShouldRetry(ctx, txHash, sentAt)
+
+	return retry, false, err
+}
+
+// Paused reports whether the wallet's balance was last seen critical.
+func (g *balanceGuard) Paused() bool {
+	g.wallet.mu.Lock()
+	defer g.wallet.mu.Unlock()
+
+	return g.wallet.level == BalanceCritical
+}
@@ -0,0 +1,197 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package txsubmitter
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc/link/internal/config"
+	"github.com/cosmos/ibc/link/internal/txsubmitter/evm"
+	v2 "github.com/cosmos/ibc/link/internal/types/v2"
+)
+
+// fakeBalance a BalanceReader with a settable balance.
+type fakeBalance struct {
+	address string
+
+	mu      sync.Mutex
+	balance *big.Int
+	err     error
+}
+
+func (b *fakeBalance) Address() string { return b.address }
+
+func (b *fakeBalance) Balance(context.Context) (*big.Int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.balance, b.err
+}
+
+func (b *fakeBalance) set(balance int64) {
+	b.mu.Lock()
+	b.balance = big.NewInt(balance)
+	b.mu.Unlock()
+}
+
+// fakeTreasury a Transferrer and RetryChecker recording its transfers.
+type fakeTreasury struct {
+	transfers []*big.Int
+
+	// the outcome CheckRetry reports for the last transfer
+	pending, failed bool
+}
+
+func (t *fakeTreasury) Transfer(_ context.Context, _ string, amount *big.Int) (*v2.Submission, error) {
+	t.transfers = append(t.transfers, amount)
+	return &v2.Submission{TxHash: "0xtopup", SubmittedAt: time.Now()}, nil
+}
+
+func (t *fakeTreasury) CheckRetry(context.Context, string, time.Time) (bool, bool, error) {
+	if t.pending {
+		return false, false, v2.ErrTxNotFound
+	}
+
+	return t.failed, false, nil
+}
+
+func newTestMonitor(wallet Wallet) (*BalanceMonitor, *watchedWallet) {
+	wallet.ChainID = "1"
+	wallet.Thresholds = BalanceThresholds{Warn: big.NewInt(100), Critical: big.NewInt(10)}
+
+	monitor := NewBalanceMonitor([]Wallet{wallet})
+	watched, _ := monitor.wallet(wallet.ChainID, wallet.Alias)
+
+	return monitor, watched
+}
+
+func TestBalanceMonitor(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("levels", func(t *testing.T) {
+		// ARRANGE
+		reader := &fakeBalance{address: "0xa"}
+		monitor, wallet := newTestMonitor(Wallet{Alias: "a", Reader: reader})
+		guard := &balanceGuard{TxSubmitter: &fakeWallet{name: "a"}, wallet: wallet}
+
+		for _, tt := range []struct {
+			balance int64
+			level   BalanceLevel
+		}{
+			{balance: 1000, level: BalanceOK},
+			{balance: 100, level: BalanceWarn},
+			{balance: 10, level: BalanceCritical},
+			{balance: 500, level: BalanceOK},
+		} {
+			reader.set(tt.balance)
+
+			// ACT
+			monitor.Check(ctx)
+
+			// ASSERT
+			balances := monitor.Balances()
+			require.Len(t, balances, 1)
+			assert.Equal(t, tt.level.String(), balances[0].Level, "balance %d", tt.balance)
+
+			_, healthy := monitor.Health(ctx)
+			assert.Equal(t, tt.level != BalanceCritical, healthy, "balance %d", tt.balance)
+
+			_, err := guard.Submit(ctx, v2.TxIntent{})
+			if tt.level == BalanceCritical {
+				require.ErrorIs(t, err, ErrBalanceCritical)
+			} else {
+				require.NoError(t, err)
+			}
+
+			level := testutil.ToFloat64(monitor.level.WithLabelValues("1", "a"))
+			assert.Equal(t, float64(tt.level), level)
+		}
+	})
+
+	t.Run("keepsLevelOnReadError", func(t *testing.T) {
+		// ARRANGE
+		reader := &fakeBalance{address: "0xa", balance: big.NewInt(5)}
+		monitor, _ := newTestMonitor(Wallet{Alias: "a", Reader: reader})
+		monitor.Check(ctx)
+
+		reader.err = errors.New("rpc down")
+
+		// ACT
+		monitor.Check(ctx)
+
+		// ASSERT
+		balances := monitor.Balances()
+		assert.Equal(t, BalanceCritical.String(), balances[0].Level)
+		assert.Equal(t, "rpc down", balances[0].Error)
+		assert.Equal(t, 1.0, testutil.ToFloat64(monitor.checkErrors.WithLabelValues("1", "a")))
+	})
+
+	t.Run("topsUpToTarget", func(t *testing.T) {
+		// ARRANGE
+		reader := &fakeBalance{address: "0xa", balance: big.NewInt(80)}
+		treasury := &fakeTreasury{pending: true}
+		monitor, _ := newTestMonitor(Wallet{
+			Alias:    "a",
+			Reader:   reader,
+			Treasury: &Treasury{Alias: "treasury", TxSubmitter: treasury, Target: big.NewInt(1000)},
+		})
+
+		// ACT
+		monitor.Check(ctx)
+		// the first top-up is still in flight
+		monitor.Check(ctx)
+
+		treasury.pending, treasury.failed = false, true
+		monitor.Check(ctx)
+
+		// ASSERT
+		assert.Equal(t, []*big.Int{big.NewInt(920), big.NewInt(920)}, treasury.transfers)
+		assert.Equal(t, 1.0, testutil.ToFloat64(monitor.topUps.WithLabelValues("1", "a", "failed")))
+		assert.Equal(t, 2.0, testutil.ToFloat64(monitor.topUps.WithLabelValues("1", "a", "sent")))
+	})
+
+	t.Run("noTopUpAtTarget", func(t *testing.T) {
+		// ARRANGE
+		reader := &fakeBalance{address: "0xa", balance: big.NewInt(1000)}
+		treasury := &fakeTreasury{}
+		monitor, _ := newTestMonitor(Wallet{
+			Alias:    "a",
+			Reader:   reader,
+			Treasury: &Treasury{Alias: "treasury", TxSubmitter: treasury, Target: big.NewInt(1000)},
+		})
+
+		// ACT
+		monitor.Check(ctx)
+
+		// ASSERT
+		assert.Empty(t, treasury.transfers)
+	})
+
+	t.Run("poolSkipsPausedWallet", func(t *testing.T) {
+		// ARRANGE
+		broke := &fakeBalance{address: "0xa", balance: big.NewInt(1)}
+		monitor, wallet := newTestMonitor(Wallet{Alias: "broke", Reader: broke})
+		monitor.Check(ctx)
+
+		pool, err := NewPool("1", []PoolMember{
+			{Alias: "broke", TxSubmitter: &balanceGuard{TxSubmitter: &fakeWallet{name: "broke"}, wallet: wallet}},
+			{Alias: "funded", TxSubmitter: &fakeWallet{name: "funded"}},
+		}, PoolOptions{Strategy: config.SignerStrategyRoundRobin, WalletError: evm.IsWalletError})
+		require.NoError(t, err)
+
+		// ACT
+		senders := []string{submitFrom(t, pool), submitFrom(t, pool)}
+
+		// ASSERT
+		assert.Equal(t, []string{"funded", "funded"}, senders)
+	})
+}
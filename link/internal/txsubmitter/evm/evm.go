@@ -7,6 +7,8 @@ import (
 	"context"
 	"log/slog"
 	"math/big"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -29,6 +31,40 @@ const DefaultTxSubmissionDelay = 2 * time.Second
 // before ShouldRetry reports it should be cleared and resubmitted.
 const retryExpiry = 2 * time.Minute
 
+// walletErrorFragments fragments of node errors caused by the sending
+// account rather than the tx: its balance or its nonce sequence.
+var walletErrorFragments = []string{
+	"insufficient funds",
+	"insufficient balance",
+	"nonce too low",
+	"nonce too high",
+	"replacement transaction underpriced",
+}
+
+// walletError a submission failure of the sending wallet's signer.
+type walletError struct{ error }
+
+func (e walletError) Unwrap() error { return e.error }
+
+// IsWalletError reports whether a Submit error was caused by the sending
+// wallet, i.e. its signer, balance or nonce sequence, rather than by the tx
+// itself, so another wallet could still submit it.
+func IsWalletError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if errors.As(err, &walletError{}) {
+		return true
+	}
+
+	msg := strings.ToLower(err.Error())
+
+	return slices.ContainsFunc(walletErrorFragments, func(fragment string) bool {
+		return strings.Contains(msg, fragment)
+	})
+}
+
 // ETHClient go-ethereum methods used by the EVM tx submitter.
 type ETHClient interface {
 	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
@@ -38,6 +74,7 @@ type ETHClient interface {
 	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
 	SendTransaction(ctx context.Context, tx *types.Transaction) error
 	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
 }
 
 // TxSubmitter signs and broadcasts transactions on one EVM chain.
@@ -109,7 +146,40 @@ func New(chainID string, eth ETHClient, chainSigner signer.Signer, opts ChainOpt
 	}, nil
 }
 
+// Address the sending wallet's address.
+func (c *TxSubmitter) Address() string {
+	return c.address.String()
+}
+
+// Balance the sending wallet's balance in wei at the latest block.
+func (c *TxSubmitter) Balance(ctx context.Context) (*big.Int, error) {
+	balance, err := c.eth.BalanceAt(ctx, c.address, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting balance of %s", c.address)
+	}
+
+	return balance, nil
+}
+
 func (c *TxSubmitter) Submit(ctx context.Context, intent v2.TxIntent) (*v2.Submission, error) {
+	return c.send(ctx, func(ctx context.Context) (*types.Transaction, error) {
+		return c.newTx(ctx, intent)
+	})
+}
+
+// Transfer sends amount wei from the sending wallet to the to address,
+// serialized with the wallet's relay submissions.
+func (c *TxSubmitter) Transfer(ctx context.Context, to string, amount *big.Int) (*v2.Submission, error) {
+	return c.send(ctx, func(ctx context.Context) (*types.Transaction, error) {
+		return c.newTransferTx(ctx, to, amount)
+	})
+}
+
+// send builds, signs and broadcasts one tx, rate limited per chain.
+func (c *TxSubmitter) send(
+	ctx context.Context,
+	build func(ctx context.Context) (*types.Transaction, error),
+) (*v2.Submission, error) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
@@ -121,14 +191,14 @@ func (c *TxSubmitter) Submit(ctx context.Context, intent v2.TxIntent) (*v2.Submi
 		}
 	}
 
-	tx, err := c.newTx(ctx, intent)
+	tx, err := build(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "creating tx")
 	}
 
 	signature, err := c.signer.Sign(ctx, c.ethSigner.Hash(tx).Bytes())
 	if err != nil {
-		return nil, errors.Wrapf(err, "signing tx with address %s", c.address)
+		return nil, errors.Wrapf(walletError{err}, "signing tx with address %s", c.address)
 	}
 
 	signedTx, err := tx.WithSignature(c.ethSigner, signature)
@@ -141,7 +211,7 @@ func (c *TxSubmitter) Submit(ctx context.Context, intent v2.TxIntent) (*v2.Submi
 	}
 
 	c.lastSubmission = time.Now()
-	c.logger.Info("Submitted tx", "txHash", signedTx.Hash(), "to", intent.To)
+	c.logger.Info("Submitted tx", "txHash", signedTx.Hash(), "to", tx.To(), "value", tx.Value())
 
 	return &v2.Submission{
 		TxHash:         signedTx.Hash().String(),
@@ -157,22 +227,11 @@ func (c *TxSubmitter) newTx(ctx context.Context, intent v2.TxIntent) (*types.Tra
 
 	to := common.HexToAddress(intent.To)
 
-	head, err := c.eth.HeaderByNumber(ctx, nil)
+	gasFeeCap, gasTipCap, err := c.gasFees(ctx)
 	if err != nil {
-		return nil, errors.Wrap(err, "getting latest header")
+		return nil, err
 	}
 
-	if head.BaseFee == nil {
-		return nil, errors.Errorf("chain %s has no base fee; it must support EIP-1559", c.chainID)
-	}
-
-	gasTipCap, err := c.eth.SuggestGasTipCap(ctx)
-	if err != nil {
-		return nil, errors.Wrap(err, "getting suggested gas tip cap")
-	}
-
-	gasFeeCap := new(big.Int).Add(gasTipCap, new(big.Int).Mul(head.BaseFee, big.NewInt(2)))
-
 	code, err := c.eth.PendingCodeAt(ctx, to)
 	if err != nil {
 		return nil, errors.Wrapf(err, "getting code at %s", to)
@@ -195,34 +254,102 @@ func (c *TxSubmitter) newTx(ctx context.Context, intent v2.TxIntent) (*types.Tra
 	return types.NewTx(&types.DynamicFeeTx{
 		To:        &to,
 		Nonce:     nonce,
-		GasFeeCap: applyMultiplier(gasFeeCap, c.feeCapMult),
-		GasTipCap: applyMultiplier(gasTipCap, c.tipCapMult),
+		GasFeeCap: gasFeeCap,
+		GasTipCap: gasTipCap,
 		Gas:       gasLimit,
 		Data:      intent.Data,
 	}), nil
 }
 
+func (c *TxSubmitter) newTransferTx(ctx context.Context, toHex string, amount *big.Int) (*types.Transaction, error) {
+	if !common.IsHexAddress(toHex) {
+		return nil, errors.Errorf("invalid to address %q", toHex)
+	}
+
+	if amount.Sign() <= 0 {
+		return nil, errors.Errorf("transfer amount must be positive, got %s", amount)
+	}
+
+	to := common.HexToAddress(toHex)
+
+	gasFeeCap, gasTipCap, err := c.gasFees(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	gasLimit, err := c.eth.EstimateGas(ctx, ethereum.CallMsg{From: c.address, To: &to, Value: amount})
+	if err != nil {
+		return nil, errors.Wrap(err, "estimating gas")
+	}
+
+	nonce, err := c.eth.PendingNonceAt(ctx, c.address)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting pending nonce for %s", c.address)
+	}
+
+	return types.NewTx(&types.DynamicFeeTx{
+		To:        &to,
+		Nonce:     nonce,
+		GasFeeCap: gasFeeCap,
+		GasTipCap: gasTipCap,
+		Gas:       gasLimit,
+		Value:     amount,
+	}), nil
+}
+
+// gasFees the fee cap and tip cap for a tx, multipliers applied.
+func (c *TxSubmitter) gasFees(ctx context.Context) (gasFeeCap, gasTipCap *big.Int, err error) {
+	head, err := c.eth.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "getting latest header")
+	}
+
+	if head.BaseFee == nil {
+		return nil, nil, errors.Errorf("chain %s has no base fee; it must support EIP-1559", c.chainID)
+	}
+
+	gasTipCap, err = c.eth.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "getting suggested gas tip cap")
+	}
+
+	gasFeeCap = new(big.Int).Add(gasTipCap, new(big.Int).Mul(head.BaseFee, big.NewInt(2)))
+
+	return applyMultiplier(gasFeeCap, c.feeCapMult), applyMultiplier(gasTipCap, c.tipCapMult), nil
+}
+
 func (c *TxSubmitter) ShouldRetry(ctx context.Context, txHash string, sentAt time.Time) (bool, error) {
+	retry, _, err := c.CheckRetry(ctx, txHash, sentAt)
+	return retry, err
+}
+
+// CheckRetry is ShouldRetry also reporting whether a tx to retry expired
+// unmined rather than failed on chain.
+func (c *TxSubmitter) CheckRetry(
+	ctx context.Context,
+	txHash string,
+	sentAt time.Time,
+) (retry, expired bool, err error) {
 	receipt, err := c.eth.TransactionReceipt(ctx, common.HexToHash(txHash))
 	switch {
 	case errors.Is(err, ethereum.NotFound):
 		latest, errHeader := c.eth.HeaderByNumber(ctx, nil)
 		if errHeader != nil {
-			return false, errors.Wrap(errHeader, "getting latest header")
+			return false, false, errors.Wrap(errHeader, "getting latest header")
 		}
 
 		expiresAt := sentAt.UTC().Add(retryExpiry)
 		if expiresAt.Before(time.Unix(int64(latest.Time), 0)) {
-			return true, nil
+			return true, true, nil
 		}
 
-		return false, v2.ErrTxNotFound
+		return false, false, v2.ErrTxNotFound
 	case err != nil:
-		return false, errors.Wrapf(err, "getting receipt for tx %s", txHash)
+		return false, false, errors.Wrapf(err, "getting receipt for tx %s", txHash)
 	case receipt.Status != types.ReceiptStatusSuccessful:
-		return true, nil
+		return true, false, nil
 	default:
-		return false, nil
+		return false, false, nil
 	}
 }
 
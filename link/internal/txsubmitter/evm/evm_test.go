@@ -9,6 +9,7 @@ import (
 	"time"
 
 	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/pkg/errors"
@@ -111,6 +112,64 @@ func TestSubmit(t *testing.T) {
 	})
 }
 
+func TestTransfer(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("sendsValue", func(t *testing.T) {
+		// ARRANGE
+		txSubmitter, eth, _ := newTestTxSubmitter(t, ChainOptions{TxSubmissionDelay: time.Millisecond})
+		amount := big.NewInt(5e17)
+
+		eth.EXPECT().HeaderByNumber(ctx, (*big.Int)(nil)).Return(&types.Header{BaseFee: big.NewInt(100)}, nil).Once()
+		eth.EXPECT().SuggestGasTipCap(ctx).Return(big.NewInt(10), nil).Once()
+		eth.EXPECT().
+			EstimateGas(ctx, mock.MatchedBy(func(call ethereum.CallMsg) bool { return call.Value.Cmp(amount) == 0 })).
+			Return(21000, nil).
+			Once()
+		eth.EXPECT().PendingNonceAt(ctx, mock.Anything).Return(3, nil).Once()
+
+		var sent *types.Transaction
+		eth.EXPECT().SendTransaction(ctx, mock.Anything).Run(func(_ context.Context, tx *types.Transaction) {
+			sent = tx
+		}).Return(nil).Once()
+
+		// ACT
+		sub, err := txSubmitter.Transfer(ctx, toAddress, amount)
+
+		// ASSERT
+		require.NoError(t, err)
+		require.NotNil(t, sent)
+
+		assert.Equal(t, amount, sent.Value())
+		assert.Empty(t, sent.Data())
+		assert.Equal(t, toAddress, sent.To().String())
+		assert.Equal(t, uint64(3), sent.Nonce())
+		assert.Equal(t, sent.Hash().String(), sub.TxHash)
+	})
+
+	t.Run("rejectsNonPositiveAmount", func(t *testing.T) {
+		txSubmitter, _, _ := newTestTxSubmitter(t, ChainOptions{TxSubmissionDelay: time.Millisecond})
+
+		_, err := txSubmitter.Transfer(ctx, toAddress, big.NewInt(0))
+
+		require.ErrorContains(t, err, "amount must be positive")
+	})
+}
+
+func TestBalance(t *testing.T) {
+	txSubmitter, eth, _ := newTestTxSubmitter(t, ChainOptions{})
+
+	eth.EXPECT().
+		BalanceAt(mock.Anything, common.HexToAddress(txSubmitter.Address()), (*big.Int)(nil)).
+		Return(big.NewInt(42), nil).
+		Once()
+
+	balance, err := txSubmitter.Balance(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(42), balance)
+}
+
 func TestShouldRetry(t *testing.T) {
 	ctx := context.Background()
 	txHash := "0x60016c34c02278856c81a41ce857ac4bb837a2f4a13c95207e08cbc9e8f2b706"
@@ -178,3 +237,20 @@ func TestShouldRetry(t *testing.T) {
 		require.ErrorContains(t, err, "rpc down")
 	})
 }
+
+func TestIsWalletError(t *testing.T) {
+	for name, tc := range map[string]struct {
+		err      error
+		expected bool
+	}{
+		"insufficientFunds": {errors.New("insufficient funds for gas * price + value"), true},
+		"nonceTooLow":       {errors.Wrap(errors.New("nonce too low"), "sending tx 0x01"), true},
+		"signer":            {errors.Wrap(walletError{errors.New("hsm unreachable")}, "signing tx"), true},
+		"reverted":          {errors.New("execution reverted"), false},
+		"nil":               {nil, false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, IsWalletError(tc.err))
+		})
+	}
+}
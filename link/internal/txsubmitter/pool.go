@@ -0,0 +1,236 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package txsubmitter
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	lru "github.com/hashicorp/golang-lru/v2"
+
+	"github.com/cosmos/ibc/link/internal/config"
+	v2 "github.com/cosmos/ibc/link/internal/types/v2"
+)
+
+const (
+	// DefaultSignerCooldown how long a pool wallet is taken out of rotation
+	// when no override is configured.
+	DefaultSignerCooldown = time.Minute
+
+	// maxCooldownDoublings caps a repeatedly failing wallet's cooldown at
+	// 16x the configured one.
+	maxCooldownDoublings = 4
+
+	// sentTxCacheSize recent txs a pool remembers the sending wallet of.
+	sentTxCacheSize = 4096
+)
+
+// PoolMember one wallet of a Pool: a signer alias and its tx submitter.
+type PoolMember struct {
+	Alias       string
+	TxSubmitter TxSubmitter
+}
+
+// PoolOptions Pool settings.
+type PoolOptions struct {
+	Strategy config.SignerStrategy
+	Cooldown time.Duration
+
+	// WalletError reports whether a submission error was caused by the
+	// sending wallet, e.g. it ran out of funds, rather than by the tx.
+	WalletError func(error) bool
+}
+
+// Pauser is implemented by tx submitters that can be paused, e.g. while
+// their wallet's balance is critical.
+type Pauser interface {
+	Paused() bool
+}
+
+// Pool spreads submissions on one chain across several wallets, so one
+// account's nonce sequence no longer caps a route's throughput. Each
+// submission goes to the wallet with the fewest in-flight submissions
+// (least-pending) or to the next one in turn (round-robin). A wallet whose
+// submission fails for a wallet reason, or whose tx expires unmined (as
+// told by a RetryChecker), is taken out of rotation for a cooldown that
+// doubles while it keeps failing. Paused wallets (see Pauser) are skipped
+// too. When every wallet is out, the one due back first keeps submitting.
+type Pool struct {
+	opts PoolOptions
+
+	mu      sync.Mutex
+	members []*poolMember
+	next    int
+
+	// sent the wallet each recent tx was sent from
+	sent *lru.Cache[string, *poolMember]
+
+	logger *slog.Logger
+}
+
+type poolMember struct {
+	PoolMember
+
+	pending      int
+	failures     int
+	benchedUntil time.Time
+}
+
+var _ TxSubmitter = (*Pool)(nil)
+
+func NewPool(chainID string, members []PoolMember, opts PoolOptions) (*Pool, error) {
+	if len(members) == 0 {
+		return nil, errors.Errorf("signer pool for chain %q has no members", chainID)
+	}
+
+	if opts.Strategy == "" {
+		opts.Strategy = config.SignerStrategyLeastPending
+	}
+
+	if opts.Cooldown == 0 {
+		opts.Cooldown = DefaultSignerCooldown
+	}
+
+	sent, err := lru.New[string, *poolMember](sentTxCacheSize)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating sent tx cache")
+	}
+
+	poolMembers := make([]*poolMember, 0, len(members))
+	for _, member := range members {
+		poolMembers = append(poolMembers, &poolMember{PoolMember: member})
+	}
+
+	return &Pool{
+		opts:    opts,
+		members: poolMembers,
+		sent:    sent,
+		logger:  slog.With("module", "txsubmitter", "chainID", chainID, "strategy", opts.Strategy),
+	}, nil
+}
+
+func (p *Pool) Submit(ctx context.Context, intent v2.TxIntent) (*v2.Submission, error) {
+	member := p.acquire()
+	defer p.release(member)
+
+	submission, err := member.TxSubmitter.Submit(ctx, intent)
+	if err != nil {
+		if p.opts.WalletError != nil && p.opts.WalletError(err) {
+			p.bench(member, err)
+		}
+
+		return nil, errors.Wrapf(err, "submitting with signer %q", member.Alias)
+	}
+
+	p.mu.Lock()
+	member.failures = 0
+	p.mu.Unlock()
+
+	p.sent.Add(submission.TxHash, member)
+
+	return submission, nil
+}
+
+// ShouldRetry asks the wallet that sent txHash, or any wallet for a tx sent
+// before a restart. A known wallet whose tx expired unmined is stuck and
+// benched.
+func (p *Pool) ShouldRetry(ctx context.Context, txHash string, sentAt time.Time) (bool, error) {
+	member, known := p.sent.Get(txHash)
+	if !known {
+		return p.members[0].TxSubmitter.ShouldRetry(ctx, txHash, sentAt)
+	}
+
+	checker, ok := member.TxSubmitter.(RetryChecker)
+	if !ok {
+		return member.TxSubmitter.ShouldRetry(ctx, txHash, sentAt)
+	}
+
+	retry, expired, err := checker.CheckRetry(ctx, txHash, sentAt)
+	if err != nil {
+		return retry, err
+	}
+
+	if expired {
+		p.bench(member, errors.Errorf("tx %s expired unmined", txHash))
+	}
+
+	p.sent.Remove(txHash)
+
+	return retry, nil
+}
+
+// acquire picks the wallet for a submission and counts it in flight.
+func (p *Pool) acquire() *poolMember {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	available := func(m *poolMember) bool {
+		if pauser, ok := m.TxSubmitter.(Pauser); ok && pauser.Paused() {
+			return false
+		}
+
+		return !m.benchedUntil.After(now)
+	}
+
+	if !slices.ContainsFunc(p.members, available) {
+		// every wallet is benched: fall back to the one due back first
+		// rather than stalling the route
+		first := slices.MinFunc(p.members, func(a, b *poolMember) int {
+			return a.benchedUntil.Compare(b.benchedUntil)
+		})
+		available = func(m *poolMember) bool { return m == first }
+	}
+
+	// scan from the round-robin cursor so least-pending ties rotate too
+	best := -1
+	for i := range p.members {
+		idx := (p.next + i) % len(p.members)
+		member := p.members[idx]
+
+		if !available(member) {
+			continue
+		}
+
+		if best == -1 || (p.opts.Strategy == config.SignerStrategyLeastPending &&
+			member.pending < p.members[best].pending) {
+			best = idx
+		}
+
+		if p.opts.Strategy == config.SignerStrategyRoundRobin {
+			break
+		}
+	}
+
+	p.next = best + 1
+	member := p.members[best]
+	member.pending++
+
+	return member
+}
+
+func (p *Pool) release(member *poolMember) {
+	p.mu.Lock()
+	member.pending--
+	p.mu.Unlock()
+}
+
+// bench takes member out of rotation for its cooldown.
+func (p *Pool) bench(member *poolMember, cause error) {
+	p.mu.Lock()
+	cooldown := p.opts.Cooldown << min(member.failures, maxCooldownDoublings)
+	member.failures++
+	member.benchedUntil = time.Now().Add(cooldown)
+	p.mu.Unlock()
+
+	p.logger.Warn(
+		"Taking signer out of rotation",
+		"signer", member.Alias,
+		"cooldown", cooldown,
+		"err", cause,
+	)
+}
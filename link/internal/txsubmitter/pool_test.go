@@ -0,0 +1,206 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package txsubmitter
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc/link/internal/config"
+	"github.com/cosmos/ibc/link/internal/txsubmitter/evm"
+	v2 "github.com/cosmos/ibc/link/internal/types/v2"
+)
+
+// fakeWallet a TxSubmitter and RetryChecker for one pool wallet.
+type fakeWallet struct {
+	name string
+
+	mu      sync.Mutex
+	submits int
+	err     error
+	block   chan struct{}
+	expired bool
+}
+
+func (w *fakeWallet) Submit(context.Context, v2.TxIntent) (*v2.Submission, error) {
+	w.mu.Lock()
+	w.submits++
+	err, block := w.err, w.block
+	w.mu.Unlock()
+
+	if block != nil {
+		<-block
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &v2.Submission{TxHash: w.name, SubmittedAt: time.Now(), RelayerAddress: w.name}, nil
+}
+
+func (w *fakeWallet) ShouldRetry(ctx context.Context, txHash string, sentAt time.Time) (bool, error) {
+	retry, _, err := w.CheckRetry(ctx, txHash, sentAt)
+	return retry, err
+}
+
+func (w *fakeWallet) CheckRetry(context.Context, string, time.Time) (bool, bool, error) {
+	return w.expired, w.expired, nil
+}
+
+func newTestPool(t *testing.T, strategy config.SignerStrategy, wallets ...*fakeWallet) *Pool {
+	t.Helper()
+
+	members := make([]PoolMember, 0, len(wallets))
+	for _, w := range wallets {
+		members = append(members, PoolMember{Alias: w.name, TxSubmitter: w})
+	}
+
+	pool, err := NewPool("1", members, PoolOptions{
+		Strategy:    strategy,
+		Cooldown:    time.Hour,
+		WalletError: evm.IsWalletError,
+	})
+	require.NoError(t, err)
+
+	return pool
+}
+
+func submitFrom(t *testing.T, pool *Pool) string {
+	t.Helper()
+
+	submission, err := pool.Submit(context.Background(), v2.TxIntent{})
+	require.NoError(t, err)
+
+	return submission.RelayerAddress
+}
+
+func TestPool(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("roundRobin", func(t *testing.T) {
+		pool := newTestPool(t, config.SignerStrategyRoundRobin,
+			&fakeWallet{name: "a"}, &fakeWallet{name: "b"}, &fakeWallet{name: "c"})
+
+		var senders []string
+		for range 4 {
+			senders = append(senders, submitFrom(t, pool))
+		}
+
+		assert.Equal(t, []string{"a", "b", "c", "a"}, senders)
+	})
+
+	t.Run("leastPending", func(t *testing.T) {
+		// ARRANGE
+		busy := &fakeWallet{name: "busy", block: make(chan struct{})}
+		idle := &fakeWallet{name: "idle"}
+		pool := newTestPool(t, config.SignerStrategyLeastPending, busy, idle)
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			_, _ = pool.Submit(ctx, v2.TxIntent{})
+		}()
+
+		require.Eventually(t, func() bool {
+			busy.mu.Lock()
+			defer busy.mu.Unlock()
+			return busy.submits == 1
+		}, time.Second, time.Millisecond)
+
+		// ACT
+		// while busy has a submission in flight, idle takes every one
+		senders := []string{submitFrom(t, pool), submitFrom(t, pool)}
+
+		// ASSERT
+		assert.Equal(t, []string{"idle", "idle"}, senders)
+
+		close(busy.block)
+		<-done
+	})
+
+	t.Run("benchesWalletErrors", func(t *testing.T) {
+		// ARRANGE
+		broke := &fakeWallet{name: "broke", err: errors.New("insufficient funds for gas * price + value")}
+		funded := &fakeWallet{name: "funded"}
+		pool := newTestPool(t, config.SignerStrategyRoundRobin, broke, funded)
+
+		// ACT
+		_, err := pool.Submit(ctx, v2.TxIntent{})
+
+		// ASSERT
+		require.ErrorContains(t, err, `signer "broke"`)
+
+		for range 3 {
+			assert.Equal(t, "funded", submitFrom(t, pool))
+		}
+	})
+
+	t.Run("keepsWalletOnTxErrors", func(t *testing.T) {
+		// ARRANGE
+		reverting := &fakeWallet{name: "a", err: errors.New("estimating gas: execution reverted")}
+		pool := newTestPool(t, config.SignerStrategyRoundRobin, reverting, &fakeWallet{name: "b"})
+
+		_, err := pool.Submit(ctx, v2.TxIntent{})
+		require.Error(t, err)
+
+		reverting.mu.Lock()
+		reverting.err = nil
+		reverting.mu.Unlock()
+
+		// ACT
+		senders := []string{submitFrom(t, pool), submitFrom(t, pool)}
+
+		// ASSERT
+		assert.Equal(t, []string{"b", "a"}, senders)
+	})
+
+	t.Run("benchesStuckWallet", func(t *testing.T) {
+		// ARRANGE
+		stuck := &fakeWallet{name: "stuck"}
+		pool := newTestPool(t, config.SignerStrategyRoundRobin, stuck, &fakeWallet{name: "ok"})
+
+		require.Equal(t, "stuck", submitFrom(t, pool))
+		stuck.expired = true
+
+		// ACT
+		retry, err := pool.ShouldRetry(ctx, "stuck", time.Now())
+
+		// ASSERT
+		require.NoError(t, err)
+		assert.True(t, retry)
+
+		for range 3 {
+			assert.Equal(t, "ok", submitFrom(t, pool))
+		}
+	})
+
+	t.Run("allBenchedFallsBack", func(t *testing.T) {
+		// ARRANGE
+		a := &fakeWallet{name: "a", err: errors.New("nonce too low")}
+		b := &fakeWallet{name: "b", err: errors.New("nonce too low")}
+		pool := newTestPool(t, config.SignerStrategyRoundRobin, a, b)
+
+		for range 2 {
+			_, err := pool.Submit(ctx, v2.TxIntent{})
+			require.Error(t, err)
+		}
+
+		a.mu.Lock()
+		a.err = nil
+		a.mu.Unlock()
+
+		// ACT
+		// a was benched first, so it is due back first
+		sender := submitFrom(t, pool)
+
+		// ASSERT
+		assert.Equal(t, "a", sender)
+	})
+}
@@ -5,6 +5,7 @@ package txsubmitter
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	"github.com/cockroachdb/errors"
@@ -26,13 +27,27 @@ type TxSubmitter interface {
 	ShouldRetry(ctx context.Context, txHash string, sentAt time.Time) (bool, error)
 }
 
-var _ TxSubmitter = (*evm.TxSubmitter)(nil)
+// RetryChecker is implemented by tx submitters that can tell a tx to retry
+// that expired unmined, meaning its sender is stuck, from one that failed
+// on chain.
+type RetryChecker interface {
+	CheckRetry(ctx context.Context, txHash string, sentAt time.Time) (retry, expired bool, err error)
+}
+
+var (
+	_ TxSubmitter   = (*evm.TxSubmitter)(nil)
+	_ RetryChecker  = (*evm.TxSubmitter)(nil)
+	_ BalanceReader = (*evm.TxSubmitter)(nil)
+	_ Transferrer   = (*evm.TxSubmitter)(nil)
+)
 
 // Set holds one tx submitter per (chain, signer) pair relayed by
 // the configured routes. A chain may carry several signers when different
-// clients on it are relayed by different routes.
+// clients on it are relayed by different routes. A signer pool is held
+// under its sorted, comma-joined aliases, see config.SignerAliases.
 type Set struct {
 	txSubmitters map[config.ChainSignerPair]TxSubmitter
+	balances     *BalanceMonitor
 }
 
 func NewSet(txSubmitters map[config.ChainSignerPair]TxSubmitter) *Set {
@@ -48,44 +63,178 @@ func (s *Set) Get(chainID, signerAlias string) (TxSubmitter, bool) {
 	return txSubmitter, ok
 }
 
+// Balances the monitor of the set's wallet balances; nil for a set not
+// built from config.
+func (s *Set) Balances() *BalanceMonitor {
+	return s.balances
+}
+
 // NewFromConfig builds one tx submitter per (chain, signer) pair relayed by
 // the configured routes. Routes naming the same pair share a tx submitter; a
 // chain carries several when different clients on it are relayed with
-// different signers.
+// different signers. Each signer pool gets a Pool over its wallets' tx
+// submitters, which it shares with the routes submitting with them directly.
+//
+// Every wallet's balance is watched by the set's BalanceMonitor, which
+// pauses the wallet's submissions while its balance is critical.
 func NewFromConfig(cfg config.Config, signers *signer.Set) (*Set, error) {
 	pairs := config.RelayerChainSignerPairs(cfg)
 
-	txSubmitters := make(map[config.ChainSignerPair]TxSubmitter, len(pairs))
+	wallets := make(map[config.ChainSignerPair]*evm.TxSubmitter, len(pairs))
 
 	for _, pair := range pairs {
-		chain, ok := cfg.Chain(pair.ChainID)
-		if !ok || chain.Type() != config.ChainTypeEVM {
-			return nil, errors.Errorf("chain %q is not a configured evm chain", pair.ChainID)
+		txSubmitter, err := newEVMTxSubmitter(cfg, signers, pair)
+		if err != nil {
+			return nil, err
 		}
 
-		chainSigner, ok := signers.Get(pair.SignerAlias)
-		if !ok {
-			return nil, errors.Errorf("unknown signer %q for chain %q", pair.SignerAlias, pair.ChainID)
+		wallets[pair] = txSubmitter
+	}
+
+	balances, err := newBalanceMonitor(cfg, signers, pairs, wallets)
+	if err != nil {
+		return nil, err
+	}
+
+	txSubmitters := make(map[config.ChainSignerPair]TxSubmitter, len(pairs))
+	for _, pair := range pairs {
+		wallet, _ := balances.wallet(pair.ChainID, pair.SignerAlias)
+		txSubmitters[pair] = &balanceGuard{TxSubmitter: wallets[pair], wallet: wallet}
+	}
+
+	if err := addPools(cfg, txSubmitters); err != nil {
+		return nil, err
+	}
+
+	set := NewSet(txSubmitters)
+	set.balances = balances
+
+	return set, nil
+}
+
+func newEVMTxSubmitter(cfg config.Config, signers *signer.Set, pair config.ChainSignerPair) (*evm.TxSubmitter, error) {
+	chain, ok := cfg.Chain(pair.ChainID)
+	if !ok || chain.Type() != config.ChainTypeEVM {
+		return nil, errors.Errorf("chain %q is not a configured evm chain", pair.ChainID)
+	}
+
+	chainSigner, ok := signers.Get(pair.SignerAlias)
+	if !ok {
+		return nil, errors.Errorf("unknown signer %q for chain %q", pair.SignerAlias, pair.ChainID)
+	}
+
+	opts := evm.ChainOptions{TxSubmissionDelay: evm.DefaultTxSubmissionDelay}
+	if override, ok := cfg.Relayer.ChainOverride(pair.ChainID); ok {
+		if override.TxSubmissionDelay != nil {
+			opts.TxSubmissionDelay = *override.TxSubmissionDelay
+		}
+		if override.EVM != nil {
+			opts.GasFeeCapMultiplier = override.EVM.GasFeeCapMultiplier
+			opts.GasTipCapMultiplier = override.EVM.GasTipCapMultiplier
 		}
+	}
+
+	txSubmitter, err := evm.NewFromRPC(pair.ChainID, chain.EVM.RPC, chainSigner, opts)
+	if err != nil {
+		return nil, errors.Wrapf(err, "creating tx submitter for chain %q", pair.ChainID)
+	}
 
-		opts := evm.ChainOptions{TxSubmissionDelay: evm.DefaultTxSubmissionDelay}
-		if override, ok := cfg.Relayer.ChainOverride(pair.ChainID); ok {
-			if override.TxSubmissionDelay != nil {
-				opts.TxSubmissionDelay = *override.TxSubmissionDelay
+	return txSubmitter, nil
+}
+
+// newBalanceMonitor watches every relayer wallet, plus the treasury of each
+// chain with top-ups configured. A treasury that also relays shares its tx
+// submitter, keeping its nonces serialized; it is never topped up itself.
+func newBalanceMonitor(
+	cfg config.Config,
+	signers *signer.Set,
+	pairs []config.ChainSignerPair,
+	wallets map[config.ChainSignerPair]*evm.TxSubmitter,
+) (*BalanceMonitor, error) {
+	watched := slices.Clone(pairs)
+	treasuries := make(map[string]*Treasury)
+
+	relayed := func(chainID string) bool {
+		return slices.ContainsFunc(pairs, func(pair config.ChainSignerPair) bool { return pair.ChainID == chainID })
+	}
+
+	for _, override := range cfg.Relayer.ChainOverrides {
+		if override.Balance == nil || override.Balance.TopUp == nil || !relayed(override.ChainID) {
+			continue
+		}
+
+		topUp := override.Balance.TopUp
+		pair := config.ChainSignerPair{ChainID: override.ChainID, SignerAlias: topUp.Treasury}
+
+		if _, ok := wallets[pair]; !ok {
+			txSubmitter, err := newEVMTxSubmitter(cfg, signers, pair)
+			if err != nil {
+				return nil, errors.Wrap(err, "creating treasury tx submitter")
 			}
-			if override.EVM != nil {
-				opts.GasFeeCapMultiplier = override.EVM.GasFeeCapMultiplier
-				opts.GasTipCapMultiplier = override.EVM.GasTipCapMultiplier
+
+			wallets[pair] = txSubmitter
+			watched = append(watched, pair)
+		}
+
+		target, _ := topUp.Target.Int()
+		treasuries[override.ChainID] = &Treasury{Alias: topUp.Treasury, TxSubmitter: wallets[pair], Target: target}
+	}
+
+	monitored := make([]Wallet, 0, len(watched))
+	for _, pair := range watched {
+		wallet := Wallet{ChainID: pair.ChainID, Alias: pair.SignerAlias, Reader: wallets[pair]}
+
+		if override, ok := cfg.Relayer.ChainOverride(pair.ChainID); ok && override.Balance != nil {
+			wallet.Thresholds.Warn, _ = override.Balance.Warn.Int()
+			wallet.Thresholds.Critical, _ = override.Balance.Critical.Int()
+			if override.Balance.CheckInterval != nil {
+				wallet.CheckInterval = *override.Balance.CheckInterval
 			}
 		}
 
-		txSubmitter, err := evm.NewFromRPC(pair.ChainID, chain.EVM.RPC, chainSigner, opts)
-		if err != nil {
-			return nil, errors.Wrapf(err, "creating tx submitter for chain %q", pair.ChainID)
+		if treasury, ok := treasuries[pair.ChainID]; ok && treasury.Alias != pair.SignerAlias {
+			wallet.Treasury = treasury
 		}
 
-		txSubmitters[pair] = txSubmitter
+		monitored = append(monitored, wallet)
+	}
+
+	return NewBalanceMonitor(monitored), nil
+}
+
+// addPools adds a Pool for every client end submitting with a signer pool.
+func addPools(cfg config.Config, txSubmitters map[config.ChainSignerPair]TxSubmitter) error {
+	for _, conn := range cfg.Relayer.Connections {
+		for _, end := range []config.ClientEnd{conn.ClientA, conn.ClientB} {
+			key := config.ChainSignerPair{ChainID: end.ChainID, SignerAlias: end.Signer.String()}
+			if _, exists := txSubmitters[key]; exists || len(end.Signer) < 2 {
+				continue
+			}
+
+			members := make([]PoolMember, 0, len(end.Signer))
+			for _, alias := range end.Signer {
+				members = append(members, PoolMember{
+					Alias:       alias,
+					TxSubmitter: txSubmitters[config.ChainSignerPair{ChainID: end.ChainID, SignerAlias: alias}],
+				})
+			}
+
+			opts := PoolOptions{WalletError: evm.IsWalletError}
+			if override, ok := cfg.Relayer.ChainOverride(end.ChainID); ok {
+				opts.Strategy = override.SignerStrategy
+				if override.SignerCooldown != nil {
+					opts.Cooldown = *override.SignerCooldown
+				}
+			}
+
+			pool, err := NewPool(end.ChainID, members, opts)
+			if err != nil {
+				return err
+			}
+
+			txSubmitters[key] = pool
+		}
 	}
 
-	return NewSet(txSubmitters), nil
+	return nil
 }
@@ -68,6 +68,13 @@ type ClientUpdate struct {
 	StateProof []byte
 }
 
+// BatchProof a state proof and per-packet proofs sharing one height.
+type BatchProof struct {
+	Height       uint64
+	StateProof   []byte
+	PacketProofs [][]byte
+}
+
 // RelayTx one transaction ready to submit, targeting To with calldata Data.
 type RelayTx struct {
 	To   []byte
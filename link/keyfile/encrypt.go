@@ -0,0 +1,211 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package keyfile
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/scrypt"
+)
+
+// KDF identifies the passphrase key derivation function of an encrypted
+// key file.
+type KDF string
+
+const (
+	// Scrypt derives the encryption key with scrypt.
+	Scrypt KDF = "scrypt"
+	// Argon2id derives the encryption key with Argon2id.
+	Argon2id KDF = "argon2id"
+)
+
+const (
+	cipherAES256GCM = "aes-256-gcm"
+	derivedKeyLen   = 32
+	saltLen         = 32
+
+	// scrypt parameters match the Ethereum keystore "standard" profile
+	scryptN = 1 << 18
+	scryptR = 8
+	scryptP = 1
+
+	// argon2id parameters follow the RFC 9106 second recommended option
+	argon2Time    = 3
+	argon2Memory  = 64 * 1024
+	argon2Threads = 4
+
+	// upper bounds on parameters read from disk, so a tampered file cannot
+	// make loading it allocate unbounded memory or run unbounded passes:
+	// scrypt takes 128·N·r bytes and p times the work, argon2id Memory KiB
+	// over Time passes
+	maxScryptN      = 1 << 22
+	maxScryptR      = 32
+	maxScryptP      = 16
+	maxArgon2Memory = 4 * 1024 * 1024
+	maxArgon2Time   = 64
+)
+
+// ParseKDF validates a key derivation function name.
+func ParseKDF(raw string) (KDF, error) {
+	switch KDF(raw) {
+	case Scrypt, Argon2id:
+		return KDF(raw), nil
+	default:
+		return "", fmt.Errorf("invalid kdf: %s", raw)
+	}
+}
+
+type kdfParams struct {
+	// scrypt
+	N int `json:"n,omitempty"`
+	R int `json:"r,omitempty"`
+	P int `json:"p,omitempty"`
+
+	// argon2id
+	Time    uint32 `json:"time,omitempty"`
+	Memory  uint32 `json:"memory,omitempty"`
+	Threads uint8  `json:"threads,omitempty"`
+}
+
+// sealedKey is the crypto section of an encrypted key file.
+type sealedKey struct {
+	Cipher     string    `json:"cipher"`
+	KDF        KDF       `json:"kdf"`
+	KDFParams  kdfParams `json:"kdfParams"`
+	Salt       string    `json:"salt"`
+	Nonce      string    `json:"nonce"`
+	Ciphertext string    `json:"ciphertext"`
+}
+
+func encrypt(keyType Type, privateKey, passphrase []byte, kdf KDF) (credential, error) {
+	if _, err := ParseType(string(keyType)); err != nil {
+		return credential{}, err
+	}
+	if len(passphrase) == 0 {
+		return credential{}, errors.New("passphrase must not be empty")
+	}
+
+	sealed := sealedKey{Cipher: cipherAES256GCM, KDF: kdf}
+	switch kdf {
+	case Scrypt:
+		sealed.KDFParams = kdfParams{N: scryptN, R: scryptR, P: scryptP}
+	case Argon2id:
+		sealed.KDFParams = kdfParams{Time: argon2Time, Memory: argon2Memory, Threads: argon2Threads}
+	default:
+		return credential{}, fmt.Errorf("invalid kdf: %s", kdf)
+	}
+
+	salt := make([]byte, saltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return credential{}, fmt.Errorf("generate salt: %w", err)
+	}
+
+	aead, err := sealed.aead(passphrase, salt)
+	if err != nil {
+		return credential{}, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return credential{}, fmt.Errorf("generate nonce: %w", err)
+	}
+
+	sealed.Salt = base64.StdEncoding.EncodeToString(salt)
+	sealed.Nonce = base64.StdEncoding.EncodeToString(nonce)
+	sealed.Ciphertext = base64.StdEncoding.EncodeToString(aead.Seal(nil, nonce, privateKey, additionalData(keyType)))
+
+	return credential{Version: VersionEncrypted, Type: keyType, Crypto: &sealed}, nil
+}
+
+// open decrypts the private key sealed for keyType under passphrase.
+func (s *sealedKey) open(keyType Type, passphrase []byte) ([]byte, error) {
+	if s.Cipher != cipherAES256GCM {
+		return nil, fmt.Errorf("unsupported key file cipher: %s", s.Cipher)
+	}
+
+	salt, err := base64.StdEncoding.DecodeString(s.Salt)
+	if err != nil {
+		return nil, fmt.Errorf("decode salt: %w", err)
+	}
+	nonce, err := base64.StdEncoding.DecodeString(s.Nonce)
+	if err != nil {
+		return nil, fmt.Errorf("decode nonce: %w", err)
+	}
+	ciphertext, err := base64.StdEncoding.DecodeString(s.Ciphertext)
+	if err != nil {
+		return nil, fmt.Errorf("decode ciphertext: %w", err)
+	}
+
+	aead, err := s.aead(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != aead.NonceSize() {
+		return nil, fmt.Errorf("invalid nonce length: %d", len(nonce))
+	}
+
+	privateKey, err := aead.Open(nil, nonce, ciphertext, additionalData(keyType))
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+
+	return privateKey, nil
+}
+
+// aead derives the AES-256-GCM key from passphrase and salt.
+func (s *sealedKey) aead(passphrase, salt []byte) (cipher.AEAD, error) {
+	var (
+		key []byte
+		err error
+	)
+
+	params := s.KDFParams
+	switch s.KDF {
+	case Scrypt:
+		switch {
+		case params.N > maxScryptN:
+			return nil, fmt.Errorf("scrypt n exceeds %d: %d", maxScryptN, params.N)
+		case params.R > maxScryptR:
+			return nil, fmt.Errorf("scrypt r exceeds %d: %d", maxScryptR, params.R)
+		case params.P > maxScryptP:
+			return nil, fmt.Errorf("scrypt p exceeds %d: %d", maxScryptP, params.P)
+		}
+		key, err = scrypt.Key(passphrase, salt, params.N, params.R, params.P, derivedKeyLen)
+		if err != nil {
+			return nil, fmt.Errorf("derive key: %w", err)
+		}
+	case Argon2id:
+		switch {
+		case params.Time < 1:
+			return nil, errors.New("argon2id time must be at least 1")
+		case params.Threads < 1:
+			return nil, errors.New("argon2id threads must be at least 1")
+		case params.Time > maxArgon2Time:
+			return nil, fmt.Errorf("argon2id time exceeds %d: %d", maxArgon2Time, params.Time)
+		case params.Memory > maxArgon2Memory:
+			return nil, fmt.Errorf("argon2id memory exceeds %d KiB: %d", maxArgon2Memory, params.Memory)
+		}
+		key = argon2.IDKey(passphrase, salt, params.Time, params.Memory, params.Threads, derivedKeyLen)
+	default:
+		return nil, fmt.Errorf("invalid kdf: %s", s.KDF)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// additionalData binds the ciphertext to the format version and key type,
+// so neither can be swapped without failing authentication.
+func additionalData(keyType Type) []byte {
+	return fmt.Appendf(nil, "keyfile/v%d/%s", VersionEncrypted, keyType)
+}
@@ -22,35 +22,173 @@ const (
 	ECDSA Type = "ecdsa"
 )
 
+// Format versions. Plaintext files predate versioning and carry no version
+// field; they still load as VersionPlaintext. Ethereum V3 keystores carry
+// version 3 and are detected by it.
+const (
+	VersionPlaintext = 1
+	VersionEncrypted = 2
+	VersionKeystore  = 3
+)
+
+// Format identifies the on-disk format of a key file.
+type Format string
+
+const (
+	// FormatKeyfile is this package's own format, plaintext or encrypted.
+	FormatKeyfile Format = "keyfile"
+	// FormatKeystore is an Ethereum V3 keystore as written by geth or
+	// Foundry; always encrypted, always ECDSA.
+	FormatKeystore Format = "keystore"
+)
+
+var (
+	// ErrPassphraseRequired is returned when loading an encrypted key file
+	// without a passphrase.
+	ErrPassphraseRequired = errors.New("key file is encrypted: passphrase required")
+	// ErrDecrypt is returned when an encrypted key file does not open with
+	// the given passphrase.
+	ErrDecrypt = errors.New("decrypt key file: wrong passphrase or corrupted file")
+)
+
+// Passphrase supplies the passphrase of an encrypted key file. It is only
+// called when the file is encrypted.
+type Passphrase func() ([]byte, error)
+
 type credential struct {
-	Type       Type   `json:"type"`
-	PrivateKey string `json:"privateKeyBase64"`
+	Version    int        `json:"version,omitempty"`
+	Type       Type       `json:"type"`
+	PrivateKey string     `json:"privateKeyBase64,omitempty"`
+	Crypto     *sealedKey `json:"crypto,omitempty"`
+
+	// keystore is the raw JSON of an Ethereum V3 keystore
+	keystore []byte
+}
+
+// Info describes a key file without decrypting it.
+type Info struct {
+	Type      Type
+	Format    Format
+	Encrypted bool
+	KDF       KDF
 }
 
-// Store writes a new local signer file with owner-only permissions.
+// Store writes a new plaintext local signer file with owner-only
+// permissions.
 func Store(path string, keyType Type, privateKey []byte) error {
 	if _, err := ParseType(string(keyType)); err != nil {
 		return err
 	}
-	data, err := json.Marshal(credential{
+	return write(path, credential{
+		Type:       keyType,
+		PrivateKey: base64.StdEncoding.EncodeToString(privateKey),
+	}, false)
+}
+
+// StoreEncrypted writes a new local signer file with the private key
+// sealed under passphrase, using kdf to derive the encryption key.
+func StoreEncrypted(path string, keyType Type, privateKey, passphrase []byte, kdf KDF) error {
+	stored, err := encrypt(keyType, privateKey, passphrase, kdf)
+	if err != nil {
+		return err
+	}
+	return write(path, stored, false)
+}
+
+// Encrypt rewrites the plaintext key file at path in place, sealing the
+// private key under passphrase.
+func Encrypt(path string, passphrase []byte, kdf KDF) error {
+	stored, err := read(path)
+	if err != nil {
+		return err
+	}
+	if stored.encrypted() {
+		return fmt.Errorf("key file is already encrypted: %s", path)
+	}
+	keyType, privateKey, err := stored.open(nil)
+	if err != nil {
+		return err
+	}
+	sealed, err := encrypt(keyType, privateKey, passphrase, kdf)
+	if err != nil {
+		return err
+	}
+	return write(path, sealed, true)
+}
+
+// Decrypt rewrites the encrypted key file at path in place as plaintext.
+func Decrypt(path string, passphrase []byte) error {
+	stored, err := read(path)
+	if err != nil {
+		return err
+	}
+	switch {
+	case stored.keystore != nil:
+		return fmt.Errorf("cannot decrypt a V3 keystore in place: %s", path)
+	case stored.Crypto == nil:
+		return fmt.Errorf("key file is not encrypted: %s", path)
+	}
+	keyType, privateKey, err := stored.open(func() ([]byte, error) { return passphrase, nil })
+	if err != nil {
+		return err
+	}
+	return write(path, credential{
 		Type:       keyType,
 		PrivateKey: base64.StdEncoding.EncodeToString(privateKey),
-	})
+	}, true)
+}
+
+// write encodes stored to path with owner-only permissions. Unless replace
+// is set the file must not exist yet; replacing goes through a temporary
+// file so a failed write never leaves a truncated key behind.
+func write(path string, stored credential, replace bool) error {
+	data, err := json.Marshal(stored)
 	if err != nil {
 		return fmt.Errorf("encode local signer: %w", err)
 	}
+	return writeData(path, data, replace)
+}
+
+func writeData(path string, data []byte, replace bool) error {
 	if mkdirErr := os.MkdirAll(filepath.Dir(path), 0o700); mkdirErr != nil {
 		return fmt.Errorf("create local signer directory: %w", mkdirErr)
 	}
 
-	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	var (
+		file *os.File
+		err  error
+	)
+	if replace {
+		// a unique name, so a temporary file left by a crash never blocks
+		// later writes
+		file, err = os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	} else {
+		file, err = os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	}
 	if err != nil {
 		return err
 	}
+	target := file.Name()
 	if _, err := file.Write(data); err != nil {
-		return errors.Join(err, file.Close())
+		return errors.Join(err, file.Close(), removeTemp(target, replace))
+	}
+	if err := file.Close(); err != nil {
+		return errors.Join(err, removeTemp(target, replace))
+	}
+	if !replace {
+		return nil
+	}
+	if err := os.Rename(target, path); err != nil {
+		return errors.Join(err, removeTemp(target, replace))
+	}
+	return nil
+}
+
+func removeTemp(path string, replace bool) error {
+	if !replace {
+		return nil
 	}
-	return file.Close()
+	return os.Remove(path)
 }
 
 // ParseType validates a local signer key type.
@@ -63,23 +201,103 @@ func ParseType(raw string) (Type, error) {
 	}
 }
 
-// Load reads one explicitly named local signer file.
+// Load reads one explicitly named plaintext local signer file. Encrypted
+// files return ErrPassphraseRequired; use LoadWithPassphrase for those.
 func Load(path string) (Type, []byte, error) {
-	data, err := os.ReadFile(path)
+	return LoadWithPassphrase(path, nil)
+}
+
+// LoadWithPassphrase reads one explicitly named local signer file, calling
+// passphrase if it is encrypted.
+func LoadWithPassphrase(path string, passphrase Passphrase) (Type, []byte, error) {
+	stored, err := read(path)
 	if err != nil {
 		return "", nil, err
 	}
+	return stored.open(passphrase)
+}
+
+// Inspect reports a key file's type and whether it is encrypted, without
+// needing its passphrase.
+func Inspect(path string) (Info, error) {
+	stored, err := read(path)
+	if err != nil {
+		return Info{}, err
+	}
+	if stored.keystore != nil {
+		return inspectKeystore(stored.keystore)
+	}
+	info := Info{Type: stored.Type, Format: FormatKeyfile, Encrypted: stored.encrypted()}
+	if stored.Crypto != nil {
+		info.KDF = stored.Crypto.KDF
+	}
+	return info, nil
+}
+
+func read(path string) (credential, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return credential{}, err
+	}
+	var header struct {
+		Version int `json:"version"`
+	}
+	if unmarshalErr := json.Unmarshal(data, &header); unmarshalErr != nil {
+		return credential{}, unmarshalErr
+	}
+	if header.Version == VersionKeystore {
+		return credential{Version: VersionKeystore, Type: ECDSA, keystore: data}, nil
+	}
 	var stored credential
 	if unmarshalErr := json.Unmarshal(data, &stored); unmarshalErr != nil {
-		return "", nil, unmarshalErr
+		return credential{}, unmarshalErr
+	}
+	if _, err := ParseType(string(stored.Type)); err != nil {
+		return credential{}, err
 	}
-	keyType, err := ParseType(string(stored.Type))
+	switch stored.Version {
+	case 0, VersionPlaintext:
+		if stored.Crypto != nil {
+			return credential{}, fmt.Errorf("plaintext key file carries encrypted key material")
+		}
+	case VersionEncrypted:
+		if stored.Crypto == nil {
+			return credential{}, fmt.Errorf("encrypted key file is missing its crypto section")
+		}
+	default:
+		return credential{}, fmt.Errorf("unsupported key file version: %d", stored.Version)
+	}
+	return stored, nil
+}
+
+func (c credential) encrypted() bool {
+	return c.Crypto != nil || c.keystore != nil
+}
+
+// open returns the key type and private key of a validated credential.
+func (c credential) open(passphrase Passphrase) (Type, []byte, error) {
+	if !c.encrypted() {
+		privateKey, err := base64.StdEncoding.DecodeString(c.PrivateKey)
+		if err != nil {
+			return "", nil, err
+		}
+		return c.Type, privateKey, nil
+	}
+	if passphrase == nil {
+		return "", nil, ErrPassphraseRequired
+	}
+	secret, err := passphrase()
 	if err != nil {
-		return "", nil, err
+		return "", nil, fmt.Errorf("read passphrase: %w", err)
+	}
+	var privateKey []byte
+	if c.keystore != nil {
+		privateKey, err = openKeystore(c.keystore, secret)
+	} else {
+		privateKey, err = c.Crypto.open(c.Type, secret)
 	}
-	privateKey, err := base64.StdEncoding.DecodeString(stored.PrivateKey)
 	if err != nil {
 		return "", nil, err
 	}
-	return keyType, privateKey, nil
+	return c.Type, privateKey, nil
 }
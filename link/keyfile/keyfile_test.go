@@ -3,8 +3,11 @@
 package keyfile_test
 
 import (
+	"encoding/hex"
+	"encoding/json"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -41,6 +44,18 @@ func TestSignerFileContract(t *testing.T) {
 	require.Error(t, keyfile.Store(path, keyfile.ECDSA, []byte{4, 5, 6}))
 }
 
+func TestLoadAcceptsVersionedPlaintext(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "signer.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"version":1,"type":"ecdsa","privateKeyBase64":"AQID"}`), 0o600))
+
+	keyType, privateKey, err := keyfile.Load(path)
+	require.NoError(t, err)
+	require.Equal(t, keyfile.ECDSA, keyType)
+	require.Equal(t, []byte{1, 2, 3}, privateKey)
+}
+
 func TestParseTypeRejectsUnknownType(t *testing.T) {
 	t.Parallel()
 
@@ -55,6 +70,8 @@ func TestLoadRejectsMalformedCredential(t *testing.T) {
 		"invalid JSON":   `{`,
 		"invalid type":   `{"type":"rsa","privateKeyBase64":"AQID"}`,
 		"invalid base64": `{"type":"ecdsa","privateKeyBase64":"%%%"}`,
+		"future version": `{"version":3,"type":"ecdsa","privateKeyBase64":"AQID"}`,
+		"missing crypto": `{"version":2,"type":"ecdsa"}`,
 	}
 	for name, credential := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -67,3 +84,210 @@ func TestLoadRejectsMalformedCredential(t *testing.T) {
 		})
 	}
 }
+
+func TestEncryptedSignerFile(t *testing.T) {
+	t.Parallel()
+
+	for _, kdf := range []keyfile.KDF{keyfile.Scrypt, keyfile.Argon2id} {
+		t.Run(string(kdf), func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), "keys", "signer.json")
+			require.NoError(t, keyfile.StoreEncrypted(path, keyfile.ECDSA, []byte{1, 2, 3}, []byte("hunter2"), kdf))
+
+			data, err := os.ReadFile(path)
+			require.NoError(t, err)
+			require.NotContains(t, string(data), "privateKeyBase64")
+
+			fileInfo, err := os.Stat(path)
+			require.NoError(t, err)
+			require.Equal(t, os.FileMode(0o600), fileInfo.Mode().Perm())
+
+			info, err := keyfile.Inspect(path)
+			require.NoError(t, err)
+			require.Equal(
+				t, keyfile.Info{Type: keyfile.ECDSA, Format: keyfile.FormatKeyfile, Encrypted: true, KDF: kdf}, info,
+			)
+
+			_, _, err = keyfile.Load(path)
+			require.ErrorIs(t, err, keyfile.ErrPassphraseRequired)
+
+			_, _, err = keyfile.LoadWithPassphrase(path, passphrase("wrong"))
+			require.ErrorIs(t, err, keyfile.ErrDecrypt)
+
+			keyType, privateKey, err := keyfile.LoadWithPassphrase(path, passphrase("hunter2"))
+			require.NoError(t, err)
+			require.Equal(t, keyfile.ECDSA, keyType)
+			require.Equal(t, []byte{1, 2, 3}, privateKey)
+		})
+	}
+}
+
+func TestEncryptedSignerFileBindsKeyType(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "signer.json")
+	require.NoError(
+		t, keyfile.StoreEncrypted(path, keyfile.ECDSA, []byte{1, 2, 3}, []byte("hunter2"), keyfile.Argon2id),
+	)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	tampered := strings.Replace(string(data), `"type":"ecdsa"`, `"type":"eddsa"`, 1)
+	require.NoError(t, os.WriteFile(path, []byte(tampered), 0o600))
+
+	_, _, err = keyfile.LoadWithPassphrase(path, passphrase("hunter2"))
+	require.ErrorIs(t, err, keyfile.ErrDecrypt)
+}
+
+func TestEncryptDecryptInPlace(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "signer.json")
+	require.NoError(t, keyfile.Store(path, keyfile.EDDSA, []byte{1, 2, 3}))
+
+	require.ErrorContains(t, keyfile.Decrypt(path, []byte("hunter2")), "not encrypted")
+	require.NoError(t, keyfile.Encrypt(path, []byte("hunter2"), keyfile.Argon2id))
+	require.ErrorContains(t, keyfile.Encrypt(path, []byte("hunter2"), keyfile.Argon2id), "already encrypted")
+
+	keyType, privateKey, err := keyfile.LoadWithPassphrase(path, passphrase("hunter2"))
+	require.NoError(t, err)
+	require.Equal(t, keyfile.EDDSA, keyType)
+	require.Equal(t, []byte{1, 2, 3}, privateKey)
+
+	require.ErrorIs(t, keyfile.Decrypt(path, []byte("wrong")), keyfile.ErrDecrypt)
+	require.NoError(t, keyfile.Decrypt(path, []byte("hunter2")))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	//nolint:testifylint // decrypting must restore the exact plaintext format
+	require.Equal(t, `{"type":"eddsa","privateKeyBase64":"AQID"}`, string(data))
+
+	temps, err := filepath.Glob(path + ".*.tmp")
+	require.NoError(t, err)
+	require.Empty(t, temps)
+}
+
+func TestEncryptIgnoresStaleTempFile(t *testing.T) {
+	t.Parallel()
+
+	// a crash mid-write left a temporary file behind
+	path := filepath.Join(t.TempDir(), "signer.json")
+	require.NoError(t, keyfile.Store(path, keyfile.ECDSA, []byte{1, 2, 3}))
+	require.NoError(t, os.WriteFile(path+".tmp", []byte("partial"), 0o600))
+
+	require.NoError(t, keyfile.Encrypt(path, []byte("hunter2"), keyfile.Scrypt))
+	require.NoError(t, keyfile.Decrypt(path, []byte("hunter2")))
+	keyType, privateKey, err := keyfile.Load(path)
+	require.NoError(t, err)
+	require.Equal(t, keyfile.ECDSA, keyType)
+	require.Equal(t, []byte{1, 2, 3}, privateKey)
+}
+
+func TestLoadRejectsUnboundedKDFParams(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		kdf     keyfile.KDF
+		param   string
+		value   int
+		message string
+	}{
+		{keyfile.Scrypt, "n", 1 << 30, "scrypt n exceeds"},
+		{keyfile.Scrypt, "r", 1 << 20, "scrypt r exceeds"},
+		{keyfile.Scrypt, "p", 1 << 20, "scrypt p exceeds"},
+		{keyfile.Argon2id, "time", 1 << 30, "argon2id time exceeds"},
+		{keyfile.Argon2id, "memory", 1 << 30, "argon2id memory exceeds"},
+	} {
+		t.Run(string(tc.kdf)+"/"+tc.param, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), "signer.json")
+			require.NoError(t, keyfile.StoreEncrypted(path, keyfile.ECDSA, []byte{1}, []byte("hunter2"), tc.kdf))
+
+			data, err := os.ReadFile(path)
+			require.NoError(t, err)
+			var doc map[string]any
+			require.NoError(t, json.Unmarshal(data, &doc))
+			doc["crypto"].(map[string]any)["kdfParams"].(map[string]any)[tc.param] = tc.value
+			data, err = json.Marshal(doc)
+			require.NoError(t, err)
+			require.NoError(t, os.WriteFile(path, data, 0o600))
+
+			_, _, err = keyfile.LoadWithPassphrase(path, passphrase("hunter2"))
+			require.ErrorContains(t, err, tc.message)
+		})
+	}
+}
+
+func TestStoreEncryptedRejectsEmptyPassphrase(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "signer.json")
+	err := keyfile.StoreEncrypted(path, keyfile.ECDSA, []byte{1}, nil, keyfile.Scrypt)
+	require.EqualError(t, err, "passphrase must not be empty")
+}
+
+func passphrase(secret string) keyfile.Passphrase {
+	return func() ([]byte, error) { return []byte(secret), nil }
+}
+
+// wikiKeystore is the Ethereum wiki's pbkdf2 V3 keystore test vector.
+const wikiKeystore = `{"crypto":{"cipher":"aes-128-ctr","cipherparams":{"iv":"6087dab2f9fdbbfaddc31a909735c1e6"},` +
+	`"ciphertext":"5318b4d5bcd28de64ee5559e671353e16f075ecae9f99c7a79a38af5f869aa46","kdf":"pbkdf2",` +
+	`"kdfparams":{"c":262144,"dklen":32,"prf":"hmac-sha256",` +
+	`"salt":"ae3cd4e7013836a3df6bd7241b12db061dbe2c6785853cce422d148a624ce0bd"},` +
+	`"mac":"517ead924a9d0dc3124507e3393d175ce3ff7c1e96529c6c555ce9e51205e9b2"},` +
+	`"id":"3198bc9c-6672-5ab3-d995-4942343ae5b6","version":3}`
+
+func TestLoadKeystore(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "UTC--2016-01-01T00-00-00.000000000Z--008aeeda4d805471df9b2a5b0f38a0c3bcba786b")
+	require.NoError(t, os.WriteFile(path, []byte(wikiKeystore), 0o600))
+
+	info, err := keyfile.Inspect(path)
+	require.NoError(t, err)
+	require.Equal(
+		t, keyfile.Info{Type: keyfile.ECDSA, Format: keyfile.FormatKeystore, Encrypted: true, KDF: "pbkdf2"}, info,
+	)
+
+	_, _, err = keyfile.Load(path)
+	require.ErrorIs(t, err, keyfile.ErrPassphraseRequired)
+
+	_, _, err = keyfile.LoadWithPassphrase(path, passphrase("wrong"))
+	require.ErrorIs(t, err, keyfile.ErrDecrypt)
+
+	keyType, privateKey, err := keyfile.LoadWithPassphrase(path, passphrase("testpassword"))
+	require.NoError(t, err)
+	require.Equal(t, keyfile.ECDSA, keyType)
+	require.Equal(t, "7a28b5ba57c53603b0b07b56bba752f7784bf506fa95edc395f5cf6c7514fe9d", hex.EncodeToString(privateKey))
+
+	require.ErrorContains(t, keyfile.Encrypt(path, []byte("x"), keyfile.Argon2id), "already encrypted")
+	require.ErrorContains(t, keyfile.Decrypt(path, []byte("testpassword")), "V3 keystore")
+}
+
+func TestStoreKeystore(t *testing.T) {
+	t.Parallel()
+
+	privateKey, err := hex.DecodeString("7a28b5ba57c53603b0b07b56bba752f7784bf506fa95edc395f5cf6c7514fe9d")
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "keystore.json")
+	err = keyfile.StoreKeystore(path, keyfile.EDDSA, privateKey, []byte("hunter2"))
+	require.ErrorContains(t, err, "only hold ecdsa keys")
+	require.NoError(t, keyfile.StoreKeystore(path, keyfile.ECDSA, privateKey, []byte("hunter2")))
+
+	fileInfo, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o600), fileInfo.Mode().Perm())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"address":"008aeeda4d805471df9b2a5b0f38a0c3bcba786b"`)
+
+	keyType, loaded, err := keyfile.LoadWithPassphrase(path, passphrase("hunter2"))
+	require.NoError(t, err)
+	require.Equal(t, keyfile.ECDSA, keyType)
+	require.Equal(t, privateKey, loaded)
+}
@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: Apache-2.0
+
+package keyfile
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+)
+
+// ExportKeystore encodes an ECDSA private key as an Ethereum V3 keystore
+// sealed under passphrase, with geth's standard scrypt parameters.
+func ExportKeystore(keyType Type, privateKey, passphrase []byte) ([]byte, error) {
+	if keyType != ECDSA {
+		return nil, fmt.Errorf("V3 keystores only hold ecdsa keys, got %s", keyType)
+	}
+	if len(passphrase) == 0 {
+		return nil, errors.New("passphrase must not be empty")
+	}
+
+	key, err := crypto.ToECDSA(privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("decode ecdsa key: %w", err)
+	}
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, fmt.Errorf("generate keystore id: %w", err)
+	}
+
+	return keystore.EncryptKey(
+		&keystore.Key{Id: id, Address: crypto.PubkeyToAddress(key.PublicKey), PrivateKey: key},
+		string(passphrase),
+		keystore.StandardScryptN,
+		keystore.StandardScryptP,
+	)
+}
+
+// StoreKeystore writes a new Ethereum V3 keystore file with owner-only
+// permissions; see ExportKeystore.
+func StoreKeystore(path string, keyType Type, privateKey, passphrase []byte) error {
+	data, err := ExportKeystore(keyType, privateKey, passphrase)
+	if err != nil {
+		return err
+	}
+	return writeData(path, data, false)
+}
+
+func openKeystore(data, passphrase []byte) ([]byte, error) {
+	key, err := keystore.DecryptKey(data, string(passphrase))
+	switch {
+	case errors.Is(err, keystore.ErrDecrypt):
+		return nil, ErrDecrypt
+	case err != nil:
+		return nil, fmt.Errorf("decrypt keystore: %w", err)
+	}
+	return crypto.FromECDSA(key.PrivateKey), nil
+}
+
+func inspectKeystore(data []byte) (Info, error) {
+	var stored struct {
+		Crypto struct {
+			KDF KDF `json:"kdf"`
+		} `json:"crypto"`
+	}
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return Info{}, err
+	}
+	return Info{Type: ECDSA, Format: FormatKeystore, Encrypted: true, KDF: stored.Crypto.KDF}, nil
+}